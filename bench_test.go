@@ -18,7 +18,11 @@ import (
 	"encoding/binary"
 	"math/rand"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -35,7 +39,7 @@ func generateIPv4String() string {
 func generateBytes(n int) (b []byte) {
 	b = make([]byte, n)
 	for i := 0; i < n; i++ {
-		b[i] = byte(rand.Intn(255))
+		b[i] = byte(rand.Intn(256))
 	}
 	return
 }
@@ -58,6 +62,7 @@ func BenchmarkIPv4ParseOnly(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		ips = append(ips, generateIPv4String())
 	}
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -75,6 +80,7 @@ func BenchmarkIPv4ParseCountry(b *testing.B) {
 		ips = append(ips, generateIPv4String())
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -95,6 +101,7 @@ func BenchmarkIPv4NoParseCountry(b *testing.B) {
 		ipbs = append(ipbs, ipb)
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -113,6 +120,7 @@ func BenchmarkIPv4ParseCity(b *testing.B) {
 		ips = append(ips, generateIPv4String())
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -134,6 +142,7 @@ func BenchmarkIPv4NoParseCityRecord(b *testing.B) {
 		ipbs = append(ipbs, ipb)
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -154,6 +163,7 @@ func BenchmarkIPv4NoParseCityNoRecord(b *testing.B) {
 		ipbs = append(ipbs, ipb)
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
@@ -161,3 +171,190 @@ func BenchmarkIPv4NoParseCityNoRecord(b *testing.B) {
 	}
 	b.StopTimer()
 }
+
+var (
+	findV6Once sync.Once
+	// the IPv6 databases are optional
+	geoIPCountryV6 string
+	geoIPCityV6    string
+)
+
+// findV6 finds the IPv6 country and city databases, if there are any.
+func findV6() {
+	findV6Once.Do(func() {
+		for _, p := range paths {
+			filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+				if !strings.HasSuffix(path, ".dat") {
+					return nil
+				}
+				gd, err := Open(path)
+				if err != nil {
+					return nil
+				}
+				defer gd.Delete()
+				if gd.IsIPv6Database() && gd.IsCityDatabase() {
+					geoIPCityV6 = path
+				} else if gd.IsIPv6Database() && gd.IsCountryDatabase() {
+					geoIPCountryV6 = path
+				}
+				return nil
+			})
+		}
+	})
+}
+
+func generateIPs(n, size int) []net.IP {
+	ips := make([]net.IP, n)
+	for i := range ips {
+		ips[i] = net.IP(generateBytes(size))
+	}
+	return ips
+}
+
+// backends are the implementations compared by BenchmarkResolvers: the
+// cgo bindings and the Pool and Cache built on them. They run on the
+// installed databases; there is no pure Go reader and no fixture database.
+var backends = []struct {
+	name string
+	open func(filename string) (NetworkResolver, func(), error)
+}{
+	{"cgo", func(filename string) (NetworkResolver, func(), error) {
+		gi, err := Open(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		return gi, gi.Delete, nil
+	}},
+	{"pool", func(filename string) (NetworkResolver, func(), error) {
+		p, err := OpenPool(filename, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return p, p.Delete, nil
+	}},
+	{"cache", func(filename string) (NetworkResolver, func(), error) {
+		gi, err := Open(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewCache(gi, 10000), gi.Delete, nil
+	}},
+}
+
+// BenchmarkResolvers runs serial and parallel lookups of random addresses
+// against every backend and database.
+func BenchmarkResolvers(b *testing.B) {
+	findV6()
+	dbs := []struct {
+		name, filename string
+		size           int
+	}{
+		{"country", geoIPCountry, net.IPv4len},
+		{"city", geoIPCity, net.IPv4len},
+		{"countryv6", geoIPCountryV6, net.IPv6len},
+		{"cityv6", geoIPCityV6, net.IPv6len},
+	}
+	for _, db := range dbs {
+		for _, backend := range backends {
+			b.Run(db.name+"/"+backend.name, func(b *testing.B) {
+				if len(db.filename) == 0 {
+					b.Skip("no database available")
+				}
+				res, closeFn, err := backend.open(db.filename)
+				if err != nil {
+					b.Fatalf("opening %v: %v", db.filename, err)
+				}
+				defer closeFn()
+				ips := generateIPs(1<<16, db.size)
+				b.Run("serial", func(b *testing.B) {
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						res.LookupNetwork(ips[i%len(ips)])
+					}
+				})
+				b.Run("parallel", func(b *testing.B) {
+					b.ReportAllocs()
+					b.RunParallel(func(pb *testing.PB) {
+						i := rand.Intn(len(ips))
+						for pb.Next() {
+							res.LookupNetwork(ips[i%len(ips)])
+							i++
+						}
+					})
+				})
+			})
+		}
+	}
+}
+
+func BenchmarkIPv4ParallelCountry(b *testing.B) {
+	gi, gicity := getDBv4()
+	defer gi.Delete()
+	defer gicity.Delete()
+	ips := generateIPs(1<<16, net.IPv4len)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := rand.Intn(len(ips))
+		for pb.Next() {
+			gi.CountryCodeByIPv4(ips[i%len(ips)])
+			i++
+		}
+	})
+}
+
+func BenchmarkIPv4ParallelCityRecord(b *testing.B) {
+	gi, gicity := getDBv4()
+	defer gi.Delete()
+	defer gicity.Delete()
+	ips := generateIPs(1<<16, net.IPv4len)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := rand.Intn(len(ips))
+		for pb.Next() {
+			gicity.RecordByIPv4(ips[i%len(ips)])
+			i++
+		}
+	})
+}
+
+func BenchmarkIPv6Country(b *testing.B) {
+	findV6()
+	if len(geoIPCountryV6) == 0 {
+		b.Skip("no IPv6 country database available")
+	}
+	gi, err := Open(geoIPCountryV6)
+	if err != nil {
+		b.Fatalf("Open(%v) failed", geoIPCountryV6)
+	}
+	defer gi.Delete()
+	ips := generateIPs(1<<16, net.IPv6len)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gi.CountryCodeByIPv6(ips[i%len(ips)])
+	}
+}
+
+func BenchmarkIPv6CityRecord(b *testing.B) {
+	findV6()
+	if len(geoIPCityV6) == 0 {
+		b.Skip("no IPv6 city database available")
+	}
+	gi, err := Open(geoIPCityV6)
+	if err != nil {
+		b.Fatalf("Open(%v) failed", geoIPCityV6)
+	}
+	defer gi.Delete()
+	ips := generateIPs(1<<16, net.IPv6len)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gi.RecordByIPv6(ips[i%len(ips)])
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"container/list"
+	"net"
+	"sort"
+	"sync"
+)
+
+// CacheStats are the counters of a Cache.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+// Cache is a NetworkResolver that caches the results of another one.
+// Results are stored per network rather than per address, so a single
+// entry answers every address of a database entry, and entries without
+// a record are cached too. When it is full the least recently used
+// entry is evicted. A Cache is safe for concurrent use.
+//
+// If the database is a ReloadingResolver, such as a Pool, the cache is
+// emptied whenever the database reloads. Other databases can be replaced
+// with Reload.
+type Cache struct {
+	mu         sync.Mutex
+	db         NetworkResolver
+	dbReloads  uint64 // the Reloads of db the entries were looked up in
+	size       int
+	generation uint64
+	lru        *list.List               // of *cacheEntry, most recently used first
+	entries    map[string]*list.Element // keyed by cacheKey
+	prefixes   map[int]int              // number of entries per prefix length
+	lengths    []int                    // keys of prefixes, longest first
+	stats      CacheStats
+}
+
+type cacheEntry struct {
+	key     string
+	ones    int
+	network *net.IPNet
+	gir     *GeoIPRecord
+}
+
+// ReloadingResolver is a NetworkResolver whose database can be reloaded
+// in place. Reloads counts the reloads so far.
+type ReloadingResolver interface {
+	NetworkResolver
+	Reloads() uint64
+}
+
+// NewCache returns a cache of at most size networks in front of db.
+func NewCache(db NetworkResolver, size int) *Cache {
+	if size < 1 {
+		size = 1
+	}
+	return &Cache{
+		db:       db,
+		size:     size,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		prefixes: make(map[int]int),
+	}
+}
+
+func (c *Cache) Lookup(ip net.IP) *GeoIPRecord {
+	gir, _ := c.LookupNetwork(ip)
+	return gir
+}
+
+// LookupNetwork returns the cached result for the network that contains
+// ip, or looks it up in the database. The records returned are shared
+// and must not be modified.
+func (c *Cache) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	c.checkReloads()
+	for _, ones := range c.lengths {
+		if e, ok := c.entries[cacheKey(ip16, ones)]; ok {
+			c.lru.MoveToFront(e)
+			c.stats.Hits++
+			entry := e.Value.(*cacheEntry)
+			c.mu.Unlock()
+			return entry.gir, entry.network
+		}
+	}
+	c.stats.Misses++
+	db, generation := c.db, c.generation
+	c.mu.Unlock()
+
+	gir, network := db.LookupNetwork(ip)
+	if network == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkReloads()
+	// do not store results of a database that was replaced or reloaded meanwhile
+	if generation == c.generation {
+		c.add(gir, network)
+	}
+	return gir, network
+}
+
+// checkReloads empties the cache if the database reloaded. It must be
+// called with c.mu held.
+func (c *Cache) checkReloads() {
+	db, ok := c.db.(ReloadingResolver)
+	if !ok {
+		return
+	}
+	if reloads := db.Reloads(); reloads != c.dbReloads {
+		c.reset()
+		c.dbReloads = reloads
+	}
+}
+
+func (c *Cache) add(gir *GeoIPRecord, network *net.IPNet) {
+	ones := prefixLen(network)
+	key := cacheKey(network.IP.To16(), ones)
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	for c.lru.Len() >= c.size {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, ones: ones, network: network, gir: gir})
+	if c.prefixes[ones]++; c.prefixes[ones] == 1 {
+		c.lengths = append(c.lengths, ones)
+		sort.Sort(sort.Reverse(sort.IntSlice(c.lengths)))
+	}
+}
+
+func (c *Cache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*cacheEntry)
+	delete(c.entries, entry.key)
+	if c.prefixes[entry.ones]--; c.prefixes[entry.ones] == 0 {
+		delete(c.prefixes, entry.ones)
+		for i, ones := range c.lengths {
+			if ones == entry.ones {
+				c.lengths = append(c.lengths[:i], c.lengths[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// cacheKey identifies the network of ip, an IPv6 or IPv4-mapped address,
+// with prefix length ones.
+func cacheKey(ip net.IP, ones int) string {
+	return string(append(ip.Mask(net.CIDRMask(ones, 8*net.IPv6len)), byte(ones)))
+}
+
+// Stats returns the counters of the cache.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Entries = c.lru.Len()
+	return s
+}
+
+// Reload replaces the database of the cache with db, for example after
+// opening a newer version of the database file, and empties the cache.
+// It returns the previous database, which lookups that started before
+// Reload may still be using.
+func (c *Cache) Reload(db NetworkResolver) (old NetworkResolver) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old, c.db = c.db, db
+	c.dbReloads = 0
+	if db, ok := db.(ReloadingResolver); ok {
+		c.dbReloads = db.Reloads()
+	}
+	c.reset()
+	return old
+}
+
+// reset empties the cache. It must be called with c.mu held.
+func (c *Cache) reset() {
+	c.generation++
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+	c.prefixes = make(map[int]int)
+	c.lengths = nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+)
+
+// networkResolver answers from a list of networks, the first match wins,
+// and counts its lookups.
+type networkResolver struct {
+	networks []string
+	country  string
+	lookups  int
+}
+
+func (r *networkResolver) Lookup(ip net.IP) *GeoIPRecord {
+	gir, _ := r.LookupNetwork(ip)
+	return gir
+}
+
+func (r *networkResolver) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	r.lookups++
+	for _, s := range r.networks {
+		_, n, _ := net.ParseCIDR(s)
+		if n.Contains(ip) {
+			return &GeoIPRecord{CountryCode: r.country}, n
+		}
+	}
+	_, n, _ := net.ParseCIDR("0.0.0.0/1")
+	return nil, n
+}
+
+func TestCache(t *testing.T) {
+	db := &networkResolver{networks: []string{"192.0.2.0/24", "198.51.100.0/22"}, country: "ZA"}
+	c := NewCache(db, 2)
+	tests := []struct {
+		ip      string
+		found   bool
+		lookups int
+	}{
+		{"192.0.2.1", true, 1},
+		{"192.0.2.200", true, 1},
+		{"::ffff:192.0.2.7", true, 1},
+		{"198.51.101.1", true, 2},
+		{"198.51.103.255", true, 2},
+		{"10.0.0.1", false, 3}, // evicts 192.0.2.0/24
+		{"10.1.0.1", false, 3},
+		{"198.51.100.9", true, 3},
+		{"192.0.2.1", true, 4},
+		{"2001:db8::1", false, 5},
+	}
+	for _, test := range tests {
+		gir := c.Lookup(net.ParseIP(test.ip))
+		if (gir != nil) != test.found || db.lookups != test.lookups {
+			t.Fatalf("%v: got %v after %v lookups", test.ip, gir, db.lookups)
+		}
+	}
+	if s := c.Stats(); s.Hits != 5 || s.Misses != 5 || s.Evictions != 3 || s.Entries != 2 {
+		t.Fatalf("%+v", s)
+	}
+	_, n := c.LookupNetwork(net.ParseIP("198.51.102.3"))
+	if n.String() != "198.51.100.0/22" {
+		t.Fatalf("network %v", n)
+	}
+
+	newDB := &networkResolver{networks: []string{"192.0.2.0/24"}, country: "NL"}
+	if old := c.Reload(newDB); old != db {
+		t.Fatal("wrong old database")
+	}
+	if s := c.Stats(); s.Entries != 0 {
+		t.Fatalf("%+v", s)
+	}
+	if gir := c.Lookup(net.ParseIP("192.0.2.1")); gir.CountryCode != "NL" || newDB.lookups != 1 {
+		t.Fatalf("%v after %v lookups", gir, newDB.lookups)
+	}
+}
+
+func TestMultiLookupNetwork(t *testing.T) {
+	if gir, n := (Multi{}).LookupNetwork(net.ParseIP("192.0.2.1")); gir != nil || n != nil {
+		t.Fatalf("%v %v", gir, n)
+	}
+}
+
+// v4Resolver is an IPv4 database, like GeoIP it has no network for
+// IPv6 addresses.
+type v4Resolver struct {
+	networkResolver
+}
+
+func (r *v4Resolver) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	if ip.To4() == nil {
+		return nil, nil
+	}
+	return r.networkResolver.LookupNetwork(ip)
+}
+
+// multiNetwork merges resolvers like Multi does with databases.
+type multiNetwork []NetworkResolver
+
+func (m multiNetwork) Lookup(ip net.IP) *GeoIPRecord {
+	gir, _ := m.LookupNetwork(ip)
+	return gir
+}
+
+func (m multiNetwork) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	return lookupNetworks(m, ip)
+}
+
+func TestCacheMixedFamilies(t *testing.T) {
+	v4 := &v4Resolver{networkResolver{networks: []string{"192.0.2.0/24"}, country: "ZA"}}
+	v6 := &networkResolver{networks: []string{"2001:db8::/32", "192.0.0.0/16"}, country: "NL"}
+	c := NewCache(multiNetwork{v4, v6}, 8)
+	tests := []struct {
+		ip, country, network string
+	}{
+		{"192.0.2.1", "ZA", "192.0.2.0/24"},
+		{"192.0.2.2", "ZA", "192.0.2.0/24"},
+		{"2001:db8::1", "NL", "2001:db8::/32"},
+		{"2001:db8:1::1", "NL", "2001:db8::/32"},
+	}
+	for _, test := range tests {
+		gir, n := c.LookupNetwork(net.ParseIP(test.ip))
+		if gir == nil || gir.CountryCode != test.country || n.String() != test.network {
+			t.Fatalf("%v: got %v %v", test.ip, gir, n)
+		}
+	}
+	if v4.lookups != 1 || v6.lookups != 2 {
+		t.Fatalf("%v %v lookups", v4.lookups, v6.lookups)
+	}
+}
+
+func TestPrefixLen(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.0.0.0/8")
+	_, v6, _ := net.ParseCIDR("::ffff:10.0.0.0/104")
+	if prefixLen(v4) != 104 || prefixLen(v6) != 104 {
+		t.Fatalf("%v %v", prefixLen(v4), prefixLen(v6))
+	}
+}
+
+// prefixResolver returns the /24 network of every address.
+type prefixResolver struct{}
+
+func (prefixResolver) Lookup(ip net.IP) *GeoIPRecord {
+	gir, _ := prefixResolver{}.LookupNetwork(ip)
+	return gir
+}
+
+func (prefixResolver) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	mask := net.CIDRMask(24, 32)
+	n := &net.IPNet{IP: ip.To4().Mask(mask), Mask: mask}
+	return &GeoIPRecord{City: n.String()}, n
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := NewCache(prefixResolver{}, 16)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				ip := net.IPv4(10, 0, byte(i%32), byte(g))
+				if gir := c.Lookup(ip); gir.City != fmt.Sprintf("10.0.%v.0/24", i%32) {
+					t.Errorf("%v: %v", ip, gir.City)
+					return
+				}
+				if i%100 == 0 {
+					c.Reload(prefixResolver{})
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if s := c.Stats(); s.Hits+s.Misses != 8000 || s.Entries > 16 {
+		t.Fatalf("%+v", s)
+	}
+}
+
+// reloadingResolver is a networkResolver that can be reloaded in place.
+type reloadingResolver struct {
+	networkResolver
+	reloads uint64
+}
+
+func (r *reloadingResolver) Reloads() uint64 {
+	return r.reloads
+}
+
+func TestCacheReloads(t *testing.T) {
+	db := &reloadingResolver{networkResolver: networkResolver{networks: []string{"192.0.2.0/24"}, country: "ZA"}}
+	c := NewCache(db, 8)
+	for i := 0; i < 2; i++ {
+		if gir := c.Lookup(net.ParseIP("192.0.2.1")); gir.CountryCode != "ZA" || db.lookups != 1 {
+			t.Fatalf("%v after %v lookups", gir, db.lookups)
+		}
+	}
+	db.country = "NL"
+	db.reloads++
+	if gir := c.Lookup(net.ParseIP("192.0.2.1")); gir.CountryCode != "NL" || db.lookups != 2 {
+		t.Fatalf("%v after %v lookups", gir, db.lookups)
+	}
+	if s := c.Stats(); s.Entries != 1 || s.Hits != 1 {
+		t.Fatalf("%+v", s)
+	}
+}
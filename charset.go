@@ -0,0 +1,87 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Charset is the encoding libGeoIP uses for the strings it returns.
+// The strings returned by this package are always UTF-8; the charset only
+// selects which names libGeoIP returns, for example the UTF-8 country
+// names have accents where the Latin-1 ones are plain ASCII.
+type Charset int
+
+// The values are those of GEOIP_CHARSET_ISO_8859_1 and GEOIP_CHARSET_UTF8.
+const (
+	CharsetLatin1 Charset = 0
+	CharsetUTF8   Charset = 1
+)
+
+func (c Charset) String() string {
+	switch c {
+	case CharsetLatin1:
+		return "ISO-8859-1"
+	case CharsetUTF8:
+		return "UTF-8"
+	}
+	return "unknown"
+}
+
+// ParseCharset returns the charset with the given name, "ISO-8859-1" or
+// "latin1" for CharsetLatin1 and "UTF-8" or "utf8" for CharsetUTF8.
+func ParseCharset(name string) (Charset, error) {
+	switch strings.ToLower(name) {
+	case "iso-8859-1", "latin1":
+		return CharsetLatin1, nil
+	case "utf-8", "utf8":
+		return CharsetUTF8, nil
+	}
+	return 0, fmt.Errorf("unknown charset %q", name)
+}
+
+// Set sets c to the charset with the given name, so that a Charset can
+// be used as a flag.
+func (c *Charset) Set(name string) error {
+	charset, err := ParseCharset(name)
+	if err != nil {
+		return err
+	}
+	*c = charset
+	return nil
+}
+
+// Options are the options of OpenWithOptions and the other functions
+// that open databases.
+type Options struct {
+	Charset Charset
+}
+
+// toUTF8 converts a string returned by libGeoIP in charset to UTF-8.
+// Strings that are not valid UTF-8 in the UTF-8 charset come from parts
+// of libGeoIP that ignore the charset, so they are treated as Latin-1.
+func toUTF8(s string, charset Charset) string {
+	if charset == CharsetUTF8 && utf8.ValidString(s) {
+		return s
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return latin1toUTF8([]byte(s))
+		}
+	}
+	return s
+}
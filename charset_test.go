@@ -0,0 +1,55 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "testing"
+
+func TestToUTF8(t *testing.T) {
+	tests := []struct {
+		in      string
+		charset Charset
+		want    string
+	}{
+		{"Cape Town", CharsetLatin1, "Cape Town"},
+		{"Le Kremlin-bic\xeatre", CharsetLatin1, "Le Kremlin-bicêtre"},
+		{"Le Kremlin-bicêtre", CharsetUTF8, "Le Kremlin-bicêtre"},
+		// Latin-1 from a part of libGeoIP that ignores the charset
+		{"Le Kremlin-bic\xeatre", CharsetUTF8, "Le Kremlin-bicêtre"},
+		{"K\xf8benhavn", CharsetUTF8, "København"},
+		{"", CharsetUTF8, ""},
+	}
+	for _, test := range tests {
+		if got := toUTF8(test.in, test.charset); got != test.want {
+			t.Fatalf("%q in %v: got %q, want %q", test.in, test.charset, got, test.want)
+		}
+	}
+}
+
+func TestParseCharset(t *testing.T) {
+	for name, want := range map[string]Charset{
+		"UTF-8":      CharsetUTF8,
+		"utf8":       CharsetUTF8,
+		"ISO-8859-1": CharsetLatin1,
+		"latin1":     CharsetLatin1,
+	} {
+		var c Charset
+		if err := c.Set(name); err != nil || c != want {
+			t.Fatalf("%v: got %v, %v", name, c, err)
+		}
+	}
+	if _, err := ParseCharset("EBCDIC"); err == nil {
+		t.Fatal("no error")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/jdevilliers/geoip"
+)
+
+var diffCommand = &command{
+	name:  "diff",
+	args:  "[-country] [-summary] old.dat new.dat",
+	short: "report the ranges that changed between two databases",
+}
+
+var (
+	diffCountry = diffCommand.flag.Bool("country", false, "only report ranges that moved to another country")
+	diffSummary = diffCommand.flag.Bool("summary", false, "only print the per country summary")
+)
+
+func init() {
+	diffCommand.run = runDiff
+}
+
+var diffMarks = map[geoip.ChangeKind]string{
+	geoip.Added:   "+",
+	geoip.Removed: "-",
+	geoip.Changed: "~",
+}
+
+func runDiff(args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	oldDB, err := open(args[0])
+	if err != nil {
+		return err
+	}
+	defer oldDB.Delete()
+	newDB, err := open(args[1])
+	if err != nil {
+		return err
+	}
+	defer newDB.Delete()
+
+	diff := geoip.Diff
+	if *diffCountry {
+		diff = func(oldDB, newDB *geoip.GeoIP) (*geoip.DatabaseDiff, error) {
+			return geoip.DiffFunc(oldDB, newDB, geoip.SameCountry)
+		}
+	}
+	d, err := diff(oldDB, newDB)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if !*diffSummary {
+		for _, c := range d.Changes {
+			fmt.Fprintf(w, "%v %v-%v %v -> %v\n", diffMarks[c.Kind], c.Start, c.End, formatRecord(c.Before), formatRecord(c.After))
+		}
+		fmt.Fprintln(w)
+	}
+	codes := make([]string, 0, len(d.Countries))
+	for code := range d.Countries {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "country\tadded\tremoved\tgained\tlost\tupdated\t")
+	for _, code := range codes {
+		c := d.Countries[code]
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\t\n", code, c.Added, c.Removed, c.Gained, c.Lost, c.Updated)
+	}
+	fmt.Fprintf(tw, "total\t%v\t\t\t\t\t\n", len(d.Changes))
+	return tw.Flush()
+}
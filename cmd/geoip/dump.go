@@ -0,0 +1,80 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jdevilliers/geoip"
+)
+
+var dumpCommand = &command{
+	name:  "dump",
+	args:  "[-cidr] [-json] file",
+	short: "print every range of a database",
+}
+
+var (
+	dumpCIDR = dumpCommand.flag.Bool("cidr", false, "print CIDR networks instead of address ranges")
+	dumpJSON = dumpCommand.flag.Bool("json", false, "print one JSON object per range")
+)
+
+func init() {
+	dumpCommand.run = runDump
+}
+
+type dumpRange struct {
+	Start    string             `json:"start"`
+	End      string             `json:"end"`
+	Networks []string           `json:"networks"`
+	Record   *geoip.GeoIPRecord `json:"record"`
+}
+
+func runDump(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	gi, err := open(args[0])
+	if err != nil {
+		return err
+	}
+	defer gi.Delete()
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+	return gi.Walk(func(r *geoip.Range) error {
+		if *dumpJSON {
+			d := &dumpRange{Start: r.Start.String(), End: r.End.String(), Record: r.Record}
+			for _, n := range r.Networks() {
+				d.Networks = append(d.Networks, n.String())
+			}
+			return enc.Encode(d)
+		}
+		if !*dumpCIDR {
+			_, err := fmt.Fprintf(w, "%v-%v\t%v\n", r.Start, r.End, formatRecord(r.Record))
+			return err
+		}
+		for _, n := range r.Networks() {
+			if _, err := fmt.Fprintf(w, "%v\t%v\n", n, formatRecord(r.Record)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/enrich"
+)
+
+var enrichCommand = &command{
+	name:  "enrich",
+	args:  "-db file [-db file]... [-field n | -regexp re | -json key] [-fields list] [-prefix p]",
+	short: "add geo fields to log lines read from stdin",
+}
+
+var (
+	enrichDBs     stringList
+	enrichField   = enrichCommand.flag.Int("field", 0, "`index` of the whitespace separated field with the client address")
+	enrichRegexp  = enrichCommand.flag.String("regexp", "", "`pattern` matching the client address, in the first or \"ip\" submatch")
+	enrichJSON    = enrichCommand.flag.String("json", "", "read JSON lines and take the client address from `key`")
+	enrichFields  = enrichCommand.flag.String("fields", strings.Join(enrich.DefaultFields, ","), "comma separated `fields` to add")
+	enrichPrefix  = enrichCommand.flag.String("prefix", "geo_", "`prefix` of the added fields")
+	enrichWorkers = enrichCommand.flag.Int("workers", runtime.NumCPU(), "`number` of concurrent lookups")
+)
+
+func init() {
+	enrichCommand.flag.Var(&enrichDBs, "db", "database `file`, can be repeated")
+	enrichCommand.run = runEnrich
+}
+
+func runEnrich(args []string) error {
+	if len(args) != 0 || len(enrichDBs) == 0 {
+		return errUsage
+	}
+	e := &enrich.Enricher{
+		Field:   *enrichField,
+		JSONKey: *enrichJSON,
+		Fields:  strings.Split(*enrichFields, ","),
+		Prefix:  *enrichPrefix,
+		Workers: *enrichWorkers,
+	}
+	if len(*enrichRegexp) > 0 {
+		re, err := regexp.Compile(*enrichRegexp)
+		if err != nil {
+			return err
+		}
+		e.Pattern = re
+	}
+	dbs, err := geoip.OpenMultiWithOptions(&options, enrichDBs...)
+	if err != nil {
+		return err
+	}
+	defer dbs.Delete()
+	e.Resolver = dbs
+	return e.Run(os.Stdout, os.Stdin)
+}
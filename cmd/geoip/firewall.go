@@ -0,0 +1,67 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/jdevilliers/geoip/firewall"
+)
+
+var firewallCommand = &command{
+	name:  "firewall",
+	args:  "[-format cidr|ipset|nftables|iptables|ip6tables] [-name name] [-target target] -db file [-db file]... country...",
+	short: "print the networks of countries as firewall rules",
+}
+
+var (
+	firewallDBs    stringList
+	firewallFormat = firewallCommand.flag.String("format", "cidr", "output `format`, load iptables and ip6tables output with iptables-restore --noflush")
+	firewallName   = firewallCommand.flag.String("name", "", "set or chain `name` (default geo_ followed by the countries)")
+	firewallTarget = firewallCommand.flag.String("target", "DROP", "iptables `target`")
+)
+
+func init() {
+	firewallCommand.flag.Var(&firewallDBs, "db", "country or city database `file`, can be repeated to include IPv6")
+	firewallCommand.run = runFirewall
+}
+
+func runFirewall(args []string) error {
+	if len(args) == 0 || len(firewallDBs) == 0 {
+		return errUsage
+	}
+	format, err := firewall.ParseFormat(*firewallFormat)
+	if err != nil {
+		return err
+	}
+	rules := &firewall.Rules{Name: *firewallName, Target: *firewallTarget}
+	if len(rules.Name) == 0 {
+		rules.Name = "geo_" + strings.ToLower(strings.Join(args, "_"))
+	}
+	for _, filename := range firewallDBs {
+		gi, err := open(filename)
+		if err != nil {
+			return err
+		}
+		nets, err := gi.CountryNetworks(args...)
+		gi.Delete()
+		if err != nil {
+			return err
+		}
+		rules.Networks = append(rules.Networks, nets...)
+	}
+	return rules.Write(os.Stdout, format)
+}
@@ -0,0 +1,60 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+var infoCommand = &command{
+	name:  "info",
+	args:  "file...",
+	short: "print the edition and build date of databases",
+}
+
+func init() {
+	infoCommand.run = runInfo
+}
+
+func runInfo(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	for i, filename := range args {
+		gi, err := open(filename)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintf(tw, "file:\t%v\n", filename)
+		fmt.Fprintf(tw, "info:\t%v\n", gi.DatabaseInfo())
+		fmt.Fprintf(tw, "edition:\t%v (%v)\n", gi.DatabaseEdition(), gi.DatabaseDescription())
+		if t, err := gi.DatabaseCreateTime(); err == nil {
+			fmt.Fprintf(tw, "built:\t%v\n", t.Format("2006-01-02"))
+		}
+		family := "IPv4"
+		if gi.IsIPv6Database() {
+			family = "IPv6"
+		}
+		fmt.Fprintf(tw, "family:\t%v\n", family)
+		gi.Delete()
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,194 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+)
+
+var lookupCommand = &command{
+	name:  "lookup",
+	args:  "[-db file]... [-json] [ip|host]...",
+	short: "look up addresses given as arguments or read from stdin",
+}
+
+var (
+	lookupDBs  stringList
+	lookupJSON = lookupCommand.flag.Bool("json", false, "print one JSON object per address")
+)
+
+func init() {
+	lookupCommand.flag.Var(&lookupDBs, "db", "database `file` to use, can be repeated (default is the libGeoIP country database)")
+	lookupCommand.run = runLookup
+}
+
+type lookupResult struct {
+	IP    string `json:"ip"`
+	Host  string `json:"host,omitempty"`
+	Found bool   `json:"found"`
+	*geoip.GeoIPRecord
+}
+
+func runLookup(args []string) error {
+	dbs, err := geoip.OpenMultiWithOptions(&options, lookupDBs...)
+	if err != nil {
+		return err
+	}
+	if len(dbs) == 0 {
+		gi, err := geoip.NewWithOptions(&options)
+		if err != nil {
+			return err
+		}
+		dbs = append(dbs, gi)
+	}
+	defer dbs.Delete()
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+	failed := 0
+	lookup := func(arg string) error {
+		results, err := lookupAddr(dbs, arg)
+		if err != nil {
+			// keep going, but exit with an error at the end
+			w.Flush()
+			fmt.Fprintf(os.Stderr, "geoip lookup: %v\n", err)
+			failed++
+			return nil
+		}
+		for _, res := range results {
+			if *lookupJSON {
+				if err := enc.Encode(res); err != nil {
+					return err
+				}
+				continue
+			}
+			name := res.IP
+			if len(res.Host) > 0 {
+				name = res.Host + " (" + res.IP + ")"
+			}
+			fmt.Fprintf(w, "%v\t%v\n", name, formatRecord(res.GeoIPRecord))
+		}
+		return nil
+	}
+
+	if err := lookupArgs(args, lookup); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%v lookups failed", failed)
+	}
+	return nil
+}
+
+// lookupArgs calls lookup for every argument, or for every line of
+// stdin if there are none.
+func lookupArgs(args []string, lookup func(arg string) error) error {
+	if len(args) > 0 {
+		for _, arg := range args {
+			if err := lookup(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	r := bufio.NewReader(os.Stdin)
+	for {
+		line, err := r.ReadString('\n')
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			if err := lookup(line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lookupAddr looks up an address or every address of a host name.
+func lookupAddr(dbs geoip.Multi, arg string) ([]*lookupResult, error) {
+	if ip := net.ParseIP(arg); ip != nil {
+		return []*lookupResult{lookupIP(dbs, ip)}, nil
+	}
+	ips, err := net.LookupIP(arg)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*lookupResult, len(ips))
+	for i, ip := range ips {
+		results[i] = lookupIP(dbs, ip)
+		results[i].Host = arg
+	}
+	return results, nil
+}
+
+// lookupIP looks ip up in a single database with the lookups of its
+// edition, or merges the records of several databases with geoip.Multi.
+func lookupIP(dbs geoip.Multi, ip net.IP) *lookupResult {
+	var gir *geoip.GeoIPRecord
+	if len(dbs) == 1 {
+		gir = lookupRecord(dbs[0], ip)
+	} else {
+		gir = dbs.Lookup(ip)
+	}
+	return &lookupResult{IP: ip.String(), Found: gir != nil, GeoIPRecord: gir}
+}
+
+func lookupRecord(gi *geoip.GeoIP, ip net.IP) *geoip.GeoIPRecord {
+	if !gi.IsCityDatabase() && !gi.IsCountryDatabase() {
+		// organization and ASN databases have no record lookups
+		return gi.Lookup(ip)
+	}
+	if ip.To4() != nil {
+		if gi.IsIPv6Database() {
+			return nil
+		}
+		if gi.IsCityDatabase() {
+			return gi.RecordByIPv4(ip)
+		}
+		code := gi.CountryCodeByIPv4(ip)
+		if len(code) == 0 {
+			return nil
+		}
+		return &geoip.GeoIPRecord{
+			CountryCode:  code,
+			CountryCode3: gi.CountryCode3ByIPv4(ip),
+			CountryName:  gi.CountryNameByIPv4(ip),
+		}
+	}
+	if !gi.IsIPv6Database() {
+		return nil
+	}
+	if gi.IsCityDatabase() {
+		return gi.RecordByIPv6(ip)
+	}
+	code := gi.CountryCodeByIPv6(ip)
+	if len(code) == 0 {
+		return nil
+	}
+	return &geoip.GeoIPRecord{CountryCode: code}
+}
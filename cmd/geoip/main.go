@@ -0,0 +1,140 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command geoip queries and compares GeoIP databases.
+//
+// Usage:
+//
+//	geoip [-charset name] <command> [arguments]
+//
+// Run geoip without arguments for the list of commands. The -charset flag
+// selects the names libGeoIP returns, ISO-8859-1 or UTF-8.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+)
+
+type command struct {
+	name  string
+	args  string
+	short string
+	flag  flag.FlagSet
+	run   func(args []string) error
+}
+
+var commands = []*command{
+	lookupCommand,
+	infoCommand,
+	dumpCommand,
+	enrichCommand,
+	diffCommand,
+	firewallCommand,
+	mapCommand,
+	reportCommand,
+}
+
+var errUsage = errors.New("usage")
+
+// options are the options the databases are opened with.
+var options geoip.Options
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: geoip [-charset name] <command> [arguments]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8v %v\n", c.name, c.short)
+	}
+	os.Exit(2)
+}
+
+func main() {
+	flag.Var(&options.Charset, "charset", "`name` of the charset of libGeoIP, ISO-8859-1 or UTF-8")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+	}
+	for _, c := range commands {
+		if c.name != flag.Arg(0) {
+			continue
+		}
+		c.flag.Init(c.name, flag.ExitOnError)
+		c.flag.Usage = func() {
+			fmt.Fprintf(os.Stderr, "usage: geoip %v %v\n", c.name, c.args)
+			c.flag.PrintDefaults()
+			os.Exit(2)
+		}
+		c.flag.Parse(flag.Args()[1:])
+		err := c.run(c.flag.Args())
+		if err == errUsage {
+			c.flag.Usage()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "geoip %v: %v\n", c.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+	fmt.Fprintf(os.Stderr, "geoip: unknown command %q\n", flag.Arg(0))
+	usage()
+}
+
+func open(filename string) (*geoip.GeoIP, error) {
+	gi, err := geoip.OpenWithOptions(filename, &options)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	return gi, nil
+}
+
+// stringList is a flag that can be given more than once.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+// formatRecord returns the non-empty fields of gir, or "-" if there is no record.
+func formatRecord(gir *geoip.GeoIPRecord) string {
+	if gir == nil {
+		return "-"
+	}
+	var fields []string
+	for _, f := range []string{gir.CountryCode, gir.Region, gir.City, gir.PostalCode} {
+		if len(f) > 0 {
+			fields = append(fields, f)
+		}
+	}
+	if gir.Latitude != 0 || gir.Longitude != 0 {
+		fields = append(fields, fmt.Sprintf("%.4f,%.4f", gir.Latitude, gir.Longitude))
+	}
+	if gir.ASNumber != 0 {
+		fields = append(fields, fmt.Sprintf("AS%v", gir.ASNumber))
+	}
+	if len(gir.Organization) > 0 {
+		fields = append(fields, gir.Organization)
+	}
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,56 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/jdevilliers/geoip/geomap"
+)
+
+var mapCommand = &command{
+	name:  "map",
+	args:  "[-format nginx|haproxy] [-key country|continent|asn] file",
+	short: "print a database as an nginx geo or HAProxy map file",
+}
+
+var (
+	mapFormat = mapCommand.flag.String("format", "nginx", "output `format`")
+	mapKey    = mapCommand.flag.String("key", "country", "record `field` used as value")
+)
+
+func init() {
+	mapCommand.run = runMap
+}
+
+func runMap(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	format, err := geomap.ParseFormat(*mapFormat)
+	if err != nil {
+		return err
+	}
+	key, err := geomap.ParseKey(*mapKey)
+	if err != nil {
+		return err
+	}
+	gi, err := open(args[0])
+	if err != nil {
+		return err
+	}
+	defer gi.Delete()
+	return geomap.Write(os.Stdout, gi, format, key)
+}
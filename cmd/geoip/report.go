@@ -0,0 +1,91 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/enrich"
+	"github.com/jdevilliers/geoip/report"
+)
+
+var reportCommand = &command{
+	name:  "report",
+	args:  "-db file [-db file]... [-field n | -regexp re | -json key] [-format text|csv|html] [-top n] [log...]",
+	short: "summarize log files by country, city, continent and ASN",
+}
+
+var (
+	reportDBs    stringList
+	reportField  = reportCommand.flag.Int("field", 0, "`index` of the whitespace separated field with the client address")
+	reportRegexp = reportCommand.flag.String("regexp", "", "`pattern` matching the client address, in the first or \"ip\" submatch")
+	reportJSON   = reportCommand.flag.String("json", "", "read JSON lines and take the client address from `key`")
+	reportFormat = reportCommand.flag.String("format", "text", "output `format`")
+	reportTop    = reportCommand.flag.Int("top", 10, "`number` of entries per table, 0 for all")
+)
+
+func init() {
+	reportCommand.flag.Var(&reportDBs, "db", "database `file`, can be repeated")
+	reportCommand.run = runReport
+}
+
+func runReport(args []string) error {
+	if len(reportDBs) == 0 {
+		return errUsage
+	}
+	write := map[string]func(r *report.Report) error{
+		"text": func(r *report.Report) error { return r.WriteText(os.Stdout, *reportTop) },
+		"csv":  func(r *report.Report) error { return r.WriteCSV(os.Stdout, *reportTop) },
+		"html": func(r *report.Report) error { return r.WriteHTML(os.Stdout, *reportTop) },
+	}[*reportFormat]
+	if write == nil {
+		return fmt.Errorf("unknown format %q", *reportFormat)
+	}
+	e := &enrich.Enricher{Field: *reportField, JSONKey: *reportJSON}
+	if len(*reportRegexp) > 0 {
+		re, err := regexp.Compile(*reportRegexp)
+		if err != nil {
+			return err
+		}
+		e.Pattern = re
+	}
+	dbs, err := geoip.OpenMultiWithOptions(&options, reportDBs...)
+	if err != nil {
+		return err
+	}
+	defer dbs.Delete()
+
+	r := report.New(dbs)
+	if len(args) == 0 {
+		if err := r.Read(os.Stdin, e.Address); err != nil {
+			return err
+		}
+	}
+	for _, filename := range args {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		err = r.Read(f, e.Address)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return write(r)
+}
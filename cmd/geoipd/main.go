@@ -0,0 +1,95 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command geoipd serves GeoIP lookups as JSON over HTTP.
+//
+// Usage:
+//
+//	geoipd [-http addr] [-cache n] [-charset name] [-trusted-proxy cidr]... -db file [-db file]...
+//
+// See package geohttp for the endpoints.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/geohttp"
+)
+
+// stringList is a flag that can be given more than once.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+var (
+	httpAddr = flag.String("http", ":8080", "listen `address`")
+	maxBatch = flag.Int("max-batch", geohttp.DefaultMaxBatch, "maximum number of addresses in a batched lookup")
+	cache    = flag.Int("cache", 0, "cache the results of up to `n` networks")
+	opts     geoip.Options
+	dbs      stringList
+	proxies  stringList
+)
+
+func main() {
+	flag.Var(&opts.Charset, "charset", "`name` of the charset of libGeoIP, ISO-8859-1 or UTF-8")
+	flag.Var(&dbs, "db", "database `file` to serve, can be repeated")
+	flag.Var(&proxies, "trusted-proxy", "`network` of a proxy whose forwarding headers are trusted, can be repeated")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: geoipd [flags] -db file [-db file]...\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if len(dbs) == 0 || flag.NArg() > 0 {
+		flag.Usage()
+	}
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves until the server fails. It returns instead of exiting so
+// that the databases are deleted.
+func run() error {
+	trusted, err := geohttp.ParseTrustedProxies(proxies...)
+	if err != nil {
+		return err
+	}
+	m, err := geoip.OpenMultiWithOptions(&opts, dbs...)
+	if err != nil {
+		return err
+	}
+	defer m.Delete()
+	h := geohttp.NewHandler(m...)
+	h.Proxies = trusted
+	h.MaxBatch = *maxBatch
+	if *cache > 0 {
+		h.Resolver = geoip.NewCache(m, *cache)
+	}
+	log.Printf("serving %v databases on %v", len(m), *httpAddr)
+	return http.ListenAndServe(*httpAddr, h)
+}
@@ -0,0 +1,123 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "strings"
+
+// Country describes a country of ISO 3166-1, or one of the codes that
+// GeoIP databases use for addresses without a country: "AP" (Asia/Pacific),
+// "EU" (Europe), "A1" (anonymous proxy), "A2" (satellite provider),
+// "O1" (other) and "--" (unknown). Those have no numeric code.
+type Country struct {
+	Alpha2       string
+	Alpha3       string
+	Numeric      int
+	Name         string // the ISO 3166-1 short name, for example "Korea, Republic of"
+	OfficialName string // empty if it is the same as Name
+	CommonName   string // for example "South Korea", empty if there is none
+	Continent    string // the continent code of libGeoIP, empty for the special codes
+	ID           int    // the GeoIP id, see CodeByID; "O1" also has id 255
+}
+
+var specialCountries = []Country{
+	{Alpha2: "--", Alpha3: "--", Name: "N/A", ID: 0},
+	{Alpha2: "AP", Alpha3: "AP", Name: "Asia/Pacific Region", Continent: "AS", ID: 1},
+	{Alpha2: "EU", Alpha3: "EU", Name: "Europe", Continent: "EU", ID: 2},
+	{Alpha2: "A1", Alpha3: "A1", Name: "Anonymous Proxy", ID: 244},
+	{Alpha2: "A2", Alpha3: "A2", Name: "Satellite Provider", ID: 245},
+	{Alpha2: "O1", Alpha3: "O1", Name: "Other", ID: 246},
+}
+
+var continentNames = map[string]string{
+	"AF": "Africa",
+	"AN": "Antarctica",
+	"AS": "Asia",
+	"EU": "Europe",
+	"NA": "North America",
+	"OC": "Oceania",
+	"SA": "South America",
+}
+
+var (
+	countriesByCode    = make(map[string]*Country)
+	countriesByNumeric = make(map[int]*Country)
+	countriesByName    = make(map[string]*Country)
+	countriesByID      [len(geoipCodes)]*Country
+)
+
+func init() {
+	for i := range specialCountries {
+		c := &specialCountries[i]
+		countriesByCode[c.Alpha2] = c
+		countriesByName[strings.ToLower(c.Name)] = c
+	}
+	for i := range countries {
+		c := &countries[i]
+		countriesByCode[c.Alpha2] = c
+		countriesByCode[c.Alpha3] = c
+		countriesByNumeric[c.Numeric] = c
+		for _, name := range []string{c.Name, c.OfficialName, c.CommonName} {
+			if len(name) > 0 {
+				countriesByName[strings.ToLower(name)] = c
+			}
+		}
+	}
+	for id, code := range geoipCodes {
+		countriesByID[id] = countriesByCode[code]
+	}
+}
+
+// Countries returns the countries of ISO 3166-1 sorted by alpha-2 code.
+// The special GeoIP codes are not included. The slice must not be modified.
+func Countries() []Country {
+	return countries
+}
+
+// CountryByCode returns the country with the given alpha-2 or alpha-3
+// code, or nil. The code is case-insensitive.
+func CountryByCode(code string) *Country {
+	return countriesByCode[strings.ToUpper(code)]
+}
+
+// CountryByNumeric returns the country with the ISO 3166-1 numeric code n, or nil.
+func CountryByNumeric(n int) *Country {
+	return countriesByNumeric[n]
+}
+
+// CountryByName returns the country whose short, official or common name
+// is name, ignoring case, or nil.
+func CountryByName(name string) *Country {
+	return countriesByName[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// CountryByID returns the country with the GeoIP id, as returned by the
+// country lookups of libGeoIP, or nil if id is out of range.
+func CountryByID(id int) *Country {
+	if id < 0 || id >= len(countriesByID) {
+		return nil
+	}
+	return countriesByID[id]
+}
+
+// ContinentName returns the English name of a continent code, for
+// example "North America" for "NA", or "" if the code is unknown.
+func ContinentName(code string) string {
+	return continentNames[strings.ToUpper(code)]
+}
+
+// ContinentCodes returns the continent codes used by GeoIP databases.
+func ContinentCodes() []string {
+	return []string{"AF", "AN", "AS", "EU", "NA", "OC", "SA"}
+}
@@ -0,0 +1,70 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+// CountryInfo is practical data about a country. Fields are empty where
+// they do not apply, for example Antarctica has no currency or calling code.
+type CountryInfo struct {
+	Currency    string   // ISO 4217 code of the currency in use
+	CallingCode int      // international calling code, shared by several countries in some cases
+	Languages   []string // ISO 639-1 codes, or ISO 639-3 if there is none, of the official languages
+	EU          bool     // member of the European Union
+	EEA         bool     // member of the European Economic Area
+	Schengen    bool     // member of the Schengen Area
+}
+
+// CountryInfoByCode returns the info of the country with the given
+// alpha-2 or alpha-3 code, or nil.
+func CountryInfoByCode(code string) *CountryInfo {
+	c := CountryByCode(code)
+	if c == nil {
+		return nil
+	}
+	return c.Info()
+}
+
+// Info returns the info of c, or nil for the special GeoIP codes.
+func (c *Country) Info() *CountryInfo {
+	return countryInfo[c.Alpha2]
+}
+
+// Flag returns the flag emoji of c, made of the regional indicator symbols
+// of its alpha-2 code, or "" for the special GeoIP codes.
+func (c *Country) Flag() string {
+	if c.Numeric == 0 {
+		return ""
+	}
+	return string([]rune{
+		rune(c.Alpha2[0]) - 'A' + 0x1F1E6,
+		rune(c.Alpha2[1]) - 'A' + 0x1F1E6,
+	})
+}
+
+// Country returns the country of the record, or nil if it has none.
+func (gir *GeoIPRecord) Country() *Country {
+	if gir == nil || len(gir.CountryCode) == 0 {
+		return nil
+	}
+	return CountryByCode(gir.CountryCode)
+}
+
+// CountryInfo returns the info of the country of the record, or nil.
+func (gir *GeoIPRecord) CountryInfo() *CountryInfo {
+	c := gir.Country()
+	if c == nil {
+		return nil
+	}
+	return c.Info()
+}
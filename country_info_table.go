@@ -0,0 +1,270 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+// countryInfo is keyed by alpha-2 code. The currencies are from CLDR, the
+// calling codes from libphonenumber and the languages from the
+// mledoze/countries project, with the most likely language of CLDR first.
+var countryInfo = map[string]*CountryInfo{
+	"AD": {Currency: "EUR", CallingCode: 376, Languages: []string{"ca"}},
+	"AE": {Currency: "AED", CallingCode: 971, Languages: []string{"ar"}},
+	"AF": {Currency: "AFN", CallingCode: 93, Languages: []string{"fa", "ps", "tk"}},
+	"AG": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"AI": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"AL": {Currency: "ALL", CallingCode: 355, Languages: []string{"sq"}},
+	"AM": {Currency: "AMD", CallingCode: 374, Languages: []string{"hy", "ru"}},
+	"AO": {Currency: "AOA", CallingCode: 244, Languages: []string{"pt"}},
+	"AQ": {},
+	"AR": {Currency: "ARS", CallingCode: 54, Languages: []string{"es", "gn"}},
+	"AS": {Currency: "USD", CallingCode: 1, Languages: []string{"sm", "en"}},
+	"AT": {Currency: "EUR", CallingCode: 43, Languages: []string{"de"}, EU: true, EEA: true, Schengen: true},
+	"AU": {Currency: "AUD", CallingCode: 61, Languages: []string{"en"}},
+	"AW": {Currency: "AWG", CallingCode: 297, Languages: []string{"nl", "pap"}},
+	"AX": {Currency: "EUR", CallingCode: 358, Languages: []string{"sv"}},
+	"AZ": {Currency: "AZN", CallingCode: 994, Languages: []string{"az", "ru"}},
+	"BA": {Currency: "BAM", CallingCode: 387, Languages: []string{"bs", "hr", "sr"}},
+	"BB": {Currency: "BBD", CallingCode: 1, Languages: []string{"en"}},
+	"BD": {Currency: "BDT", CallingCode: 880, Languages: []string{"bn"}},
+	"BE": {Currency: "EUR", CallingCode: 32, Languages: []string{"nl", "de", "fr"}, EU: true, EEA: true, Schengen: true},
+	"BF": {Currency: "XOF", CallingCode: 226, Languages: []string{"fr"}},
+	"BG": {Currency: "BGN", CallingCode: 359, Languages: []string{"bg"}, EU: true, EEA: true, Schengen: true},
+	"BH": {Currency: "BHD", CallingCode: 973, Languages: []string{"ar"}},
+	"BI": {Currency: "BIF", CallingCode: 257, Languages: []string{"rn", "fr"}},
+	"BJ": {Currency: "XOF", CallingCode: 229, Languages: []string{"fr"}},
+	"BL": {Currency: "EUR", CallingCode: 590, Languages: []string{"fr"}},
+	"BM": {Currency: "BMD", CallingCode: 1, Languages: []string{"en"}},
+	"BN": {Currency: "BND", CallingCode: 673, Languages: []string{"ms"}},
+	"BO": {Currency: "BOB", CallingCode: 591, Languages: []string{"es", "ay", "gn", "qu"}},
+	"BQ": {Currency: "USD", CallingCode: 599, Languages: []string{"en", "nl"}},
+	"BR": {Currency: "BRL", CallingCode: 55, Languages: []string{"pt"}},
+	"BS": {Currency: "BSD", CallingCode: 1, Languages: []string{"en"}},
+	"BT": {Currency: "BTN", CallingCode: 975, Languages: []string{"dz"}},
+	"BV": {Currency: "NOK", Languages: []string{"no"}},
+	"BW": {Currency: "BWP", CallingCode: 267, Languages: []string{"en", "tn"}},
+	"BY": {Currency: "BYN", CallingCode: 375, Languages: []string{"be", "ru"}},
+	"BZ": {Currency: "BZD", CallingCode: 501, Languages: []string{"en", "bjz", "es"}},
+	"CA": {Currency: "CAD", CallingCode: 1, Languages: []string{"en", "fr"}},
+	"CC": {Currency: "AUD", CallingCode: 61, Languages: []string{"en"}},
+	"CD": {Currency: "CDF", CallingCode: 243, Languages: []string{"sw", "fr", "kg", "ln", "lua"}},
+	"CF": {Currency: "XAF", CallingCode: 236, Languages: []string{"fr", "sg"}},
+	"CG": {Currency: "XAF", CallingCode: 242, Languages: []string{"fr", "kg", "ln"}},
+	"CH": {Currency: "CHF", CallingCode: 41, Languages: []string{"de", "fr", "it", "rm"}, Schengen: true},
+	"CI": {Currency: "XOF", CallingCode: 225, Languages: []string{"fr"}},
+	"CK": {Currency: "NZD", CallingCode: 682, Languages: []string{"en", "rar"}},
+	"CL": {Currency: "CLP", CallingCode: 56, Languages: []string{"es"}},
+	"CM": {Currency: "XAF", CallingCode: 237, Languages: []string{"fr", "en"}},
+	"CN": {Currency: "CNY", CallingCode: 86, Languages: []string{"zh"}},
+	"CO": {Currency: "COP", CallingCode: 57, Languages: []string{"es"}},
+	"CR": {Currency: "CRC", CallingCode: 506, Languages: []string{"es"}},
+	"CU": {Currency: "CUP", CallingCode: 53, Languages: []string{"es"}},
+	"CV": {Currency: "CVE", CallingCode: 238, Languages: []string{"pt"}},
+	"CW": {Currency: "ANG", CallingCode: 599, Languages: []string{"pap", "en", "nl"}},
+	"CX": {Currency: "AUD", CallingCode: 61, Languages: []string{"en"}},
+	"CY": {Currency: "EUR", CallingCode: 357, Languages: []string{"el", "tr"}, EU: true, EEA: true},
+	"CZ": {Currency: "CZK", CallingCode: 420, Languages: []string{"cs", "sk"}, EU: true, EEA: true, Schengen: true},
+	"DE": {Currency: "EUR", CallingCode: 49, Languages: []string{"de"}, EU: true, EEA: true, Schengen: true},
+	"DJ": {Currency: "DJF", CallingCode: 253, Languages: []string{"ar", "fr"}},
+	"DK": {Currency: "DKK", CallingCode: 45, Languages: []string{"da"}, EU: true, EEA: true, Schengen: true},
+	"DM": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"DO": {Currency: "DOP", CallingCode: 1, Languages: []string{"es"}},
+	"DZ": {Currency: "DZD", CallingCode: 213, Languages: []string{"ar"}},
+	"EC": {Currency: "USD", CallingCode: 593, Languages: []string{"es"}},
+	"EE": {Currency: "EUR", CallingCode: 372, Languages: []string{"et"}, EU: true, EEA: true, Schengen: true},
+	"EG": {Currency: "EGP", CallingCode: 20, Languages: []string{"ar"}},
+	"EH": {Currency: "MAD", CallingCode: 212, Languages: []string{"ber", "es", "mey"}},
+	"ER": {Currency: "ERN", CallingCode: 291, Languages: []string{"ti", "ar", "en"}},
+	"ES": {Currency: "EUR", CallingCode: 34, Languages: []string{"es", "ca", "eu", "gl", "oc"}, EU: true, EEA: true, Schengen: true},
+	"ET": {Currency: "ETB", CallingCode: 251, Languages: []string{"am"}},
+	"FI": {Currency: "EUR", CallingCode: 358, Languages: []string{"fi", "sv"}, EU: true, EEA: true, Schengen: true},
+	"FJ": {Currency: "FJD", CallingCode: 679, Languages: []string{"en", "fj", "hif"}},
+	"FK": {Currency: "FKP", CallingCode: 500, Languages: []string{"en"}},
+	"FM": {Currency: "USD", CallingCode: 691, Languages: []string{"en"}},
+	"FO": {Currency: "DKK", CallingCode: 298, Languages: []string{"fo", "da"}},
+	"FR": {Currency: "EUR", CallingCode: 33, Languages: []string{"fr"}, EU: true, EEA: true, Schengen: true},
+	"GA": {Currency: "XAF", CallingCode: 241, Languages: []string{"fr"}},
+	"GB": {Currency: "GBP", CallingCode: 44, Languages: []string{"en"}},
+	"GD": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"GE": {Currency: "GEL", CallingCode: 995, Languages: []string{"ka"}},
+	"GF": {Currency: "EUR", CallingCode: 594, Languages: []string{"fr"}},
+	"GG": {Currency: "GBP", CallingCode: 44, Languages: []string{"en", "fr", "nfr"}},
+	"GH": {Currency: "GHS", CallingCode: 233, Languages: []string{"en"}},
+	"GI": {Currency: "GIP", CallingCode: 350, Languages: []string{"en"}},
+	"GL": {Currency: "DKK", CallingCode: 299, Languages: []string{"kl"}},
+	"GM": {Currency: "GMD", CallingCode: 220, Languages: []string{"en"}},
+	"GN": {Currency: "GNF", CallingCode: 224, Languages: []string{"fr"}},
+	"GP": {Currency: "EUR", CallingCode: 590, Languages: []string{"fr"}},
+	"GQ": {Currency: "XAF", CallingCode: 240, Languages: []string{"es", "fr", "pt"}},
+	"GR": {Currency: "EUR", CallingCode: 30, Languages: []string{"el"}, EU: true, EEA: true, Schengen: true},
+	"GS": {Currency: "GBP", CallingCode: 500, Languages: []string{"en"}},
+	"GT": {Currency: "GTQ", CallingCode: 502, Languages: []string{"es"}},
+	"GU": {Currency: "USD", CallingCode: 1, Languages: []string{"en", "ch", "es"}},
+	"GW": {Currency: "XOF", CallingCode: 245, Languages: []string{"pt"}},
+	"GY": {Currency: "GYD", CallingCode: 592, Languages: []string{"en"}},
+	"HK": {Currency: "HKD", CallingCode: 852, Languages: []string{"zh", "en"}},
+	"HM": {Currency: "AUD", Languages: []string{"en"}},
+	"HN": {Currency: "HNL", CallingCode: 504, Languages: []string{"es"}},
+	"HR": {Currency: "HRK", CallingCode: 385, Languages: []string{"hr"}, EU: true, EEA: true, Schengen: true},
+	"HT": {Currency: "HTG", CallingCode: 509, Languages: []string{"ht", "fr"}},
+	"HU": {Currency: "HUF", CallingCode: 36, Languages: []string{"hu"}, EU: true, EEA: true, Schengen: true},
+	"ID": {Currency: "IDR", CallingCode: 62, Languages: []string{"id"}},
+	"IE": {Currency: "EUR", CallingCode: 353, Languages: []string{"en", "ga"}, EU: true, EEA: true},
+	"IL": {Currency: "ILS", CallingCode: 972, Languages: []string{"he", "ar"}},
+	"IM": {Currency: "GBP", CallingCode: 44, Languages: []string{"en", "gv"}},
+	"IN": {Currency: "INR", CallingCode: 91, Languages: []string{"hi", "en", "ta"}},
+	"IO": {Currency: "USD", CallingCode: 246, Languages: []string{"en"}},
+	"IQ": {Currency: "IQD", CallingCode: 964, Languages: []string{"ar", "arc", "ckb"}},
+	"IR": {Currency: "IRR", CallingCode: 98, Languages: []string{"fa"}},
+	"IS": {Currency: "ISK", CallingCode: 354, Languages: []string{"is"}, EEA: true, Schengen: true},
+	"IT": {Currency: "EUR", CallingCode: 39, Languages: []string{"it", "de", "sc"}, EU: true, EEA: true, Schengen: true},
+	"JE": {Currency: "GBP", CallingCode: 44, Languages: []string{"en", "fr", "nrf"}},
+	"JM": {Currency: "JMD", CallingCode: 1, Languages: []string{"en", "jam"}},
+	"JO": {Currency: "JOD", CallingCode: 962, Languages: []string{"ar"}},
+	"JP": {Currency: "JPY", CallingCode: 81, Languages: []string{"ja"}},
+	"KE": {Currency: "KES", CallingCode: 254, Languages: []string{"sw", "en"}},
+	"KG": {Currency: "KGS", CallingCode: 996, Languages: []string{"ky", "ru"}},
+	"KH": {Currency: "KHR", CallingCode: 855, Languages: []string{"km"}},
+	"KI": {Currency: "AUD", CallingCode: 686, Languages: []string{"en", "gil"}},
+	"KM": {Currency: "KMF", CallingCode: 269, Languages: []string{"ar", "fr", "zdj"}},
+	"KN": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"KP": {Currency: "KPW", CallingCode: 850, Languages: []string{"ko"}},
+	"KR": {Currency: "KRW", CallingCode: 82, Languages: []string{"ko"}},
+	"KW": {Currency: "KWD", CallingCode: 965, Languages: []string{"ar"}},
+	"KY": {Currency: "KYD", CallingCode: 1, Languages: []string{"en"}},
+	"KZ": {Currency: "KZT", CallingCode: 7, Languages: []string{"ru", "kk"}},
+	"LA": {Currency: "LAK", CallingCode: 856, Languages: []string{"lo"}},
+	"LB": {Currency: "LBP", CallingCode: 961, Languages: []string{"ar", "fr"}},
+	"LC": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"LI": {Currency: "CHF", CallingCode: 423, Languages: []string{"de"}, EEA: true, Schengen: true},
+	"LK": {Currency: "LKR", CallingCode: 94, Languages: []string{"si", "ta"}},
+	"LR": {Currency: "LRD", CallingCode: 231, Languages: []string{"en"}},
+	"LS": {Currency: "ZAR", CallingCode: 266, Languages: []string{"st", "en"}},
+	"LT": {Currency: "EUR", CallingCode: 370, Languages: []string{"lt"}, EU: true, EEA: true, Schengen: true},
+	"LU": {Currency: "EUR", CallingCode: 352, Languages: []string{"fr", "de", "lb"}, EU: true, EEA: true, Schengen: true},
+	"LV": {Currency: "EUR", CallingCode: 371, Languages: []string{"lv"}, EU: true, EEA: true, Schengen: true},
+	"LY": {Currency: "LYD", CallingCode: 218, Languages: []string{"ar"}},
+	"MA": {Currency: "MAD", CallingCode: 212, Languages: []string{"ar", "ber"}},
+	"MC": {Currency: "EUR", CallingCode: 377, Languages: []string{"fr"}},
+	"MD": {Currency: "MDL", CallingCode: 373, Languages: []string{"ro"}},
+	"ME": {Currency: "EUR", CallingCode: 382, Languages: []string{"sr"}},
+	"MF": {Currency: "EUR", CallingCode: 590, Languages: []string{"fr"}},
+	"MG": {Currency: "MGA", CallingCode: 261, Languages: []string{"mg", "fr"}},
+	"MH": {Currency: "USD", CallingCode: 692, Languages: []string{"en", "mh"}},
+	"MK": {Currency: "MKD", CallingCode: 389, Languages: []string{"mk"}},
+	"ML": {Currency: "XOF", CallingCode: 223, Languages: []string{"fr"}},
+	"MM": {Currency: "MMK", CallingCode: 95, Languages: []string{"my"}},
+	"MN": {Currency: "MNT", CallingCode: 976, Languages: []string{"mn"}},
+	"MO": {Currency: "MOP", CallingCode: 853, Languages: []string{"zh", "pt"}},
+	"MP": {Currency: "USD", CallingCode: 1, Languages: []string{"en", "cal", "ch"}},
+	"MQ": {Currency: "EUR", CallingCode: 596, Languages: []string{"fr"}},
+	"MR": {Currency: "MRO", CallingCode: 222, Languages: []string{"ar"}},
+	"MS": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"MT": {Currency: "EUR", CallingCode: 356, Languages: []string{"mt", "en"}, EU: true, EEA: true, Schengen: true},
+	"MU": {Currency: "MUR", CallingCode: 230, Languages: []string{"mfe", "en", "fr"}},
+	"MV": {Currency: "MVR", CallingCode: 960, Languages: []string{"dv"}},
+	"MW": {Currency: "MWK", CallingCode: 265, Languages: []string{"en", "ny"}},
+	"MX": {Currency: "MXN", CallingCode: 52, Languages: []string{"es"}},
+	"MY": {Currency: "MYR", CallingCode: 60, Languages: []string{"ms", "en"}},
+	"MZ": {Currency: "MZN", CallingCode: 258, Languages: []string{"pt"}},
+	"NA": {Currency: "NAD", CallingCode: 264, Languages: []string{"af", "de", "en", "hgm", "hz", "kwn", "loz", "ng", "tn"}},
+	"NC": {Currency: "XPF", CallingCode: 687, Languages: []string{"fr"}},
+	"NE": {Currency: "XOF", CallingCode: 227, Languages: []string{"fr"}},
+	"NF": {Currency: "AUD", CallingCode: 672, Languages: []string{"en", "pih"}},
+	"NG": {Currency: "NGN", CallingCode: 234, Languages: []string{"en"}},
+	"NI": {Currency: "NIO", CallingCode: 505, Languages: []string{"es"}},
+	"NL": {Currency: "EUR", CallingCode: 31, Languages: []string{"nl"}, EU: true, EEA: true, Schengen: true},
+	"NO": {Currency: "NOK", CallingCode: 47, Languages: []string{"nb", "nn", "smi"}, EEA: true, Schengen: true},
+	"NP": {Currency: "NPR", CallingCode: 977, Languages: []string{"ne"}},
+	"NR": {Currency: "AUD", CallingCode: 674, Languages: []string{"en", "na"}},
+	"NU": {Currency: "NZD", CallingCode: 683, Languages: []string{"en", "niu"}},
+	"NZ": {Currency: "NZD", CallingCode: 64, Languages: []string{"en", "mi", "nzs"}},
+	"OM": {Currency: "OMR", CallingCode: 968, Languages: []string{"ar"}},
+	"PA": {Currency: "PAB", CallingCode: 507, Languages: []string{"es"}},
+	"PE": {Currency: "PEN", CallingCode: 51, Languages: []string{"es", "ay", "qu"}},
+	"PF": {Currency: "XPF", CallingCode: 689, Languages: []string{"fr"}},
+	"PG": {Currency: "PGK", CallingCode: 675, Languages: []string{"tpi", "en", "ho"}},
+	"PH": {Currency: "PHP", CallingCode: 63, Languages: []string{"fil", "en"}},
+	"PK": {Currency: "PKR", CallingCode: 92, Languages: []string{"ur", "en"}},
+	"PL": {Currency: "PLN", CallingCode: 48, Languages: []string{"pl"}, EU: true, EEA: true, Schengen: true},
+	"PM": {Currency: "EUR", CallingCode: 508, Languages: []string{"fr"}},
+	"PN": {Currency: "NZD", CallingCode: 64, Languages: []string{"en"}},
+	"PR": {Currency: "USD", CallingCode: 1, Languages: []string{"es", "en"}},
+	"PS": {Currency: "ILS", CallingCode: 970, Languages: []string{"ar"}},
+	"PT": {Currency: "EUR", CallingCode: 351, Languages: []string{"pt"}, EU: true, EEA: true, Schengen: true},
+	"PW": {Currency: "USD", CallingCode: 680, Languages: []string{"pau", "en"}},
+	"PY": {Currency: "PYG", CallingCode: 595, Languages: []string{"gn", "es"}},
+	"QA": {Currency: "QAR", CallingCode: 974, Languages: []string{"ar"}},
+	"RE": {Currency: "EUR", CallingCode: 262, Languages: []string{"fr"}},
+	"RO": {Currency: "RON", CallingCode: 40, Languages: []string{"ro"}, EU: true, EEA: true, Schengen: true},
+	"RS": {Currency: "RSD", CallingCode: 381, Languages: []string{"sr"}},
+	"RU": {Currency: "RUB", CallingCode: 7, Languages: []string{"ru"}},
+	"RW": {Currency: "RWF", CallingCode: 250, Languages: []string{"rw", "en", "fr"}},
+	"SA": {Currency: "SAR", CallingCode: 966, Languages: []string{"ar"}},
+	"SB": {Currency: "SBD", CallingCode: 677, Languages: []string{"en"}},
+	"SC": {Currency: "SCR", CallingCode: 248, Languages: []string{"fr", "crs", "en"}},
+	"SD": {Currency: "SDG", CallingCode: 249, Languages: []string{"ar", "en"}},
+	"SE": {Currency: "SEK", CallingCode: 46, Languages: []string{"sv"}, EU: true, EEA: true, Schengen: true},
+	"SG": {Currency: "SGD", CallingCode: 65, Languages: []string{"en", "ms", "ta", "zh"}},
+	"SH": {Currency: "SHP", CallingCode: 290, Languages: []string{"en"}},
+	"SI": {Currency: "EUR", CallingCode: 386, Languages: []string{"sl"}, EU: true, EEA: true, Schengen: true},
+	"SJ": {Currency: "NOK", CallingCode: 47, Languages: []string{"no"}},
+	"SK": {Currency: "EUR", CallingCode: 421, Languages: []string{"sk"}, EU: true, EEA: true, Schengen: true},
+	"SL": {Currency: "SLL", CallingCode: 232, Languages: []string{"en"}},
+	"SM": {Currency: "EUR", CallingCode: 378, Languages: []string{"it"}},
+	"SN": {Currency: "XOF", CallingCode: 221, Languages: []string{"fr"}},
+	"SO": {Currency: "SOS", CallingCode: 252, Languages: []string{"so", "ar"}},
+	"SR": {Currency: "SRD", CallingCode: 597, Languages: []string{"nl"}},
+	"SS": {Currency: "SSP", CallingCode: 211, Languages: []string{"en"}},
+	"ST": {Currency: "STN", CallingCode: 239, Languages: []string{"pt"}},
+	"SV": {Currency: "USD", CallingCode: 503, Languages: []string{"es"}},
+	"SX": {Currency: "ANG", CallingCode: 1, Languages: []string{"en", "nl"}},
+	"SY": {Currency: "SYP", CallingCode: 963, Languages: []string{"ar"}},
+	"SZ": {Currency: "SZL", CallingCode: 268, Languages: []string{"en", "ss"}},
+	"TC": {Currency: "USD", CallingCode: 1, Languages: []string{"en"}},
+	"TD": {Currency: "XAF", CallingCode: 235, Languages: []string{"fr", "ar"}},
+	"TF": {Currency: "EUR", Languages: []string{"fr"}},
+	"TG": {Currency: "XOF", CallingCode: 228, Languages: []string{"fr"}},
+	"TH": {Currency: "THB", CallingCode: 66, Languages: []string{"th"}},
+	"TJ": {Currency: "TJS", CallingCode: 992, Languages: []string{"tg", "ru"}},
+	"TK": {Currency: "NZD", CallingCode: 690, Languages: []string{"tkl", "en", "sm"}},
+	"TL": {Currency: "USD", CallingCode: 670, Languages: []string{"pt", "tet"}},
+	"TM": {Currency: "TMT", CallingCode: 993, Languages: []string{"tk", "ru"}},
+	"TN": {Currency: "TND", CallingCode: 216, Languages: []string{"ar"}},
+	"TO": {Currency: "TOP", CallingCode: 676, Languages: []string{"to", "en"}},
+	"TR": {Currency: "TRY", CallingCode: 90, Languages: []string{"tr"}},
+	"TT": {Currency: "TTD", CallingCode: 1, Languages: []string{"en"}},
+	"TV": {Currency: "AUD", CallingCode: 688, Languages: []string{"tvl", "en"}},
+	"TW": {Currency: "TWD", CallingCode: 886, Languages: []string{"zh"}},
+	"TZ": {Currency: "TZS", CallingCode: 255, Languages: []string{"sw", "en"}},
+	"UA": {Currency: "UAH", CallingCode: 380, Languages: []string{"uk", "ru"}},
+	"UG": {Currency: "UGX", CallingCode: 256, Languages: []string{"sw", "en"}},
+	"UM": {Currency: "USD", Languages: []string{"en"}},
+	"US": {Currency: "USD", CallingCode: 1, Languages: []string{"en"}},
+	"UY": {Currency: "UYU", CallingCode: 598, Languages: []string{"es"}},
+	"UZ": {Currency: "UZS", CallingCode: 998, Languages: []string{"uz", "ru"}},
+	"VA": {Currency: "EUR", CallingCode: 39, Languages: []string{"it", "la"}},
+	"VC": {Currency: "XCD", CallingCode: 1, Languages: []string{"en"}},
+	"VE": {Currency: "VEF", CallingCode: 58, Languages: []string{"es"}},
+	"VG": {Currency: "USD", CallingCode: 1, Languages: []string{"en"}},
+	"VI": {Currency: "USD", CallingCode: 1, Languages: []string{"en"}},
+	"VN": {Currency: "VND", CallingCode: 84, Languages: []string{"vi"}},
+	"VU": {Currency: "VUV", CallingCode: 678, Languages: []string{"bi", "en", "fr"}},
+	"WF": {Currency: "XPF", CallingCode: 681, Languages: []string{"fr"}},
+	"WS": {Currency: "WST", CallingCode: 685, Languages: []string{"sm", "en"}},
+	"YE": {Currency: "YER", CallingCode: 967, Languages: []string{"ar"}},
+	"YT": {Currency: "EUR", CallingCode: 262, Languages: []string{"fr"}},
+	"ZA": {Currency: "ZAR", CallingCode: 27, Languages: []string{"en", "af", "nr", "nso", "ss", "st", "tn", "ts", "ve", "xh", "zu"}},
+	"ZM": {Currency: "ZMW", CallingCode: 260, Languages: []string{"en"}},
+	"ZW": {Currency: "USD", CallingCode: 263, Languages: []string{"sn", "bwg", "en", "kck", "khi", "nd", "ndc", "ny", "st", "tn", "toi", "ts", "ve", "xh", "zib"}},
+}
@@ -0,0 +1,301 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+// countries is the ISO 3166-1 list of countries, sorted by alpha-2 code.
+// The names are from the iso-codes project and the continents are those
+// that libGeoIP assigns.
+var countries = []Country{
+	{"AD", "AND", 20, "Andorra", "Principality of Andorra", "", "EU", 3},
+	{"AE", "ARE", 784, "United Arab Emirates", "", "", "AS", 4},
+	{"AF", "AFG", 4, "Afghanistan", "Islamic Republic of Afghanistan", "", "AS", 5},
+	{"AG", "ATG", 28, "Antigua and Barbuda", "", "", "NA", 6},
+	{"AI", "AIA", 660, "Anguilla", "", "", "NA", 7},
+	{"AL", "ALB", 8, "Albania", "Republic of Albania", "", "EU", 8},
+	{"AM", "ARM", 51, "Armenia", "Republic of Armenia", "", "AS", 9},
+	{"AO", "AGO", 24, "Angola", "Republic of Angola", "", "AF", 11},
+	{"AQ", "ATA", 10, "Antarctica", "", "", "AN", 12},
+	{"AR", "ARG", 32, "Argentina", "Argentine Republic", "", "SA", 13},
+	{"AS", "ASM", 16, "American Samoa", "", "", "OC", 14},
+	{"AT", "AUT", 40, "Austria", "Republic of Austria", "", "EU", 15},
+	{"AU", "AUS", 36, "Australia", "", "", "OC", 16},
+	{"AW", "ABW", 533, "Aruba", "", "", "NA", 17},
+	{"AX", "ALA", 248, "Åland Islands", "", "", "EU", 247},
+	{"AZ", "AZE", 31, "Azerbaijan", "Republic of Azerbaijan", "", "AS", 18},
+	{"BA", "BIH", 70, "Bosnia and Herzegovina", "Republic of Bosnia and Herzegovina", "", "EU", 19},
+	{"BB", "BRB", 52, "Barbados", "", "", "NA", 20},
+	{"BD", "BGD", 50, "Bangladesh", "People's Republic of Bangladesh", "", "AS", 21},
+	{"BE", "BEL", 56, "Belgium", "Kingdom of Belgium", "", "EU", 22},
+	{"BF", "BFA", 854, "Burkina Faso", "", "", "AF", 23},
+	{"BG", "BGR", 100, "Bulgaria", "Republic of Bulgaria", "", "EU", 24},
+	{"BH", "BHR", 48, "Bahrain", "Kingdom of Bahrain", "", "AS", 25},
+	{"BI", "BDI", 108, "Burundi", "Republic of Burundi", "", "AF", 26},
+	{"BJ", "BEN", 204, "Benin", "Republic of Benin", "", "AF", 27},
+	{"BL", "BLM", 652, "Saint Barthélemy", "", "", "NA", 251},
+	{"BM", "BMU", 60, "Bermuda", "", "", "NA", 28},
+	{"BN", "BRN", 96, "Brunei Darussalam", "", "", "AS", 29},
+	{"BO", "BOL", 68, "Bolivia, Plurinational State of", "Plurinational State of Bolivia", "Bolivia", "SA", 30},
+	{"BQ", "BES", 535, "Bonaire, Sint Eustatius and Saba", "Bonaire, Sint Eustatius and Saba", "", "NA", 253},
+	{"BR", "BRA", 76, "Brazil", "Federative Republic of Brazil", "", "SA", 31},
+	{"BS", "BHS", 44, "Bahamas", "Commonwealth of the Bahamas", "", "NA", 32},
+	{"BT", "BTN", 64, "Bhutan", "Kingdom of Bhutan", "", "AS", 33},
+	{"BV", "BVT", 74, "Bouvet Island", "", "", "AN", 34},
+	{"BW", "BWA", 72, "Botswana", "Republic of Botswana", "", "AF", 35},
+	{"BY", "BLR", 112, "Belarus", "Republic of Belarus", "", "EU", 36},
+	{"BZ", "BLZ", 84, "Belize", "", "", "NA", 37},
+	{"CA", "CAN", 124, "Canada", "", "", "NA", 38},
+	{"CC", "CCK", 166, "Cocos (Keeling) Islands", "", "", "AS", 39},
+	{"CD", "COD", 180, "Congo, The Democratic Republic of the", "", "", "AF", 40},
+	{"CF", "CAF", 140, "Central African Republic", "", "", "AF", 41},
+	{"CG", "COG", 178, "Congo", "Republic of the Congo", "", "AF", 42},
+	{"CH", "CHE", 756, "Switzerland", "Swiss Confederation", "", "EU", 43},
+	{"CI", "CIV", 384, "Côte d'Ivoire", "Republic of Côte d'Ivoire", "", "AF", 44},
+	{"CK", "COK", 184, "Cook Islands", "", "", "OC", 45},
+	{"CL", "CHL", 152, "Chile", "Republic of Chile", "", "SA", 46},
+	{"CM", "CMR", 120, "Cameroon", "Republic of Cameroon", "", "AF", 47},
+	{"CN", "CHN", 156, "China", "People's Republic of China", "", "AS", 48},
+	{"CO", "COL", 170, "Colombia", "Republic of Colombia", "", "SA", 49},
+	{"CR", "CRI", 188, "Costa Rica", "Republic of Costa Rica", "", "NA", 50},
+	{"CU", "CUB", 192, "Cuba", "Republic of Cuba", "", "NA", 51},
+	{"CV", "CPV", 132, "Cabo Verde", "Republic of Cabo Verde", "", "AF", 52},
+	{"CW", "CUW", 531, "Curaçao", "Curaçao", "", "NA", 10},
+	{"CX", "CXR", 162, "Christmas Island", "", "", "AS", 53},
+	{"CY", "CYP", 196, "Cyprus", "Republic of Cyprus", "", "AS", 54},
+	{"CZ", "CZE", 203, "Czechia", "Czech Republic", "", "EU", 55},
+	{"DE", "DEU", 276, "Germany", "Federal Republic of Germany", "", "EU", 56},
+	{"DJ", "DJI", 262, "Djibouti", "Republic of Djibouti", "", "AF", 57},
+	{"DK", "DNK", 208, "Denmark", "Kingdom of Denmark", "", "EU", 58},
+	{"DM", "DMA", 212, "Dominica", "Commonwealth of Dominica", "", "NA", 59},
+	{"DO", "DOM", 214, "Dominican Republic", "", "", "NA", 60},
+	{"DZ", "DZA", 12, "Algeria", "People's Democratic Republic of Algeria", "", "AF", 61},
+	{"EC", "ECU", 218, "Ecuador", "Republic of Ecuador", "", "SA", 62},
+	{"EE", "EST", 233, "Estonia", "Republic of Estonia", "", "EU", 63},
+	{"EG", "EGY", 818, "Egypt", "Arab Republic of Egypt", "", "AF", 64},
+	{"EH", "ESH", 732, "Western Sahara", "", "", "AF", 65},
+	{"ER", "ERI", 232, "Eritrea", "the State of Eritrea", "", "AF", 66},
+	{"ES", "ESP", 724, "Spain", "Kingdom of Spain", "", "EU", 67},
+	{"ET", "ETH", 231, "Ethiopia", "Federal Democratic Republic of Ethiopia", "", "AF", 68},
+	{"FI", "FIN", 246, "Finland", "Republic of Finland", "", "EU", 69},
+	{"FJ", "FJI", 242, "Fiji", "Republic of Fiji", "", "OC", 70},
+	{"FK", "FLK", 238, "Falkland Islands (Malvinas)", "", "", "SA", 71},
+	{"FM", "FSM", 583, "Micronesia, Federated States of", "Federated States of Micronesia", "", "OC", 72},
+	{"FO", "FRO", 234, "Faroe Islands", "", "", "EU", 73},
+	{"FR", "FRA", 250, "France", "French Republic", "", "EU", 74},
+	{"GA", "GAB", 266, "Gabon", "Gabonese Republic", "", "AF", 76},
+	{"GB", "GBR", 826, "United Kingdom", "United Kingdom of Great Britain and Northern Ireland", "", "EU", 77},
+	{"GD", "GRD", 308, "Grenada", "", "", "NA", 78},
+	{"GE", "GEO", 268, "Georgia", "", "", "AS", 79},
+	{"GF", "GUF", 254, "French Guiana", "", "", "SA", 80},
+	{"GG", "GGY", 831, "Guernsey", "", "", "EU", 248},
+	{"GH", "GHA", 288, "Ghana", "Republic of Ghana", "", "AF", 81},
+	{"GI", "GIB", 292, "Gibraltar", "", "", "EU", 82},
+	{"GL", "GRL", 304, "Greenland", "", "", "NA", 83},
+	{"GM", "GMB", 270, "Gambia", "Republic of the Gambia", "", "AF", 84},
+	{"GN", "GIN", 324, "Guinea", "Republic of Guinea", "", "AF", 85},
+	{"GP", "GLP", 312, "Guadeloupe", "", "", "NA", 86},
+	{"GQ", "GNQ", 226, "Equatorial Guinea", "Republic of Equatorial Guinea", "", "AF", 87},
+	{"GR", "GRC", 300, "Greece", "Hellenic Republic", "", "EU", 88},
+	{"GS", "SGS", 239, "South Georgia and the South Sandwich Islands", "", "", "AN", 89},
+	{"GT", "GTM", 320, "Guatemala", "Republic of Guatemala", "", "NA", 90},
+	{"GU", "GUM", 316, "Guam", "", "", "OC", 91},
+	{"GW", "GNB", 624, "Guinea-Bissau", "Republic of Guinea-Bissau", "", "AF", 92},
+	{"GY", "GUY", 328, "Guyana", "Republic of Guyana", "", "SA", 93},
+	{"HK", "HKG", 344, "Hong Kong", "Hong Kong Special Administrative Region of China", "", "AS", 94},
+	{"HM", "HMD", 334, "Heard Island and McDonald Islands", "", "", "AN", 95},
+	{"HN", "HND", 340, "Honduras", "Republic of Honduras", "", "NA", 96},
+	{"HR", "HRV", 191, "Croatia", "Republic of Croatia", "", "EU", 97},
+	{"HT", "HTI", 332, "Haiti", "Republic of Haiti", "", "NA", 98},
+	{"HU", "HUN", 348, "Hungary", "Hungary", "", "EU", 99},
+	{"ID", "IDN", 360, "Indonesia", "Republic of Indonesia", "", "AS", 100},
+	{"IE", "IRL", 372, "Ireland", "", "", "EU", 101},
+	{"IL", "ISR", 376, "Israel", "State of Israel", "", "AS", 102},
+	{"IM", "IMN", 833, "Isle of Man", "", "", "EU", 249},
+	{"IN", "IND", 356, "India", "Republic of India", "", "AS", 103},
+	{"IO", "IOT", 86, "British Indian Ocean Territory", "", "", "AS", 104},
+	{"IQ", "IRQ", 368, "Iraq", "Republic of Iraq", "", "AS", 105},
+	{"IR", "IRN", 364, "Iran, Islamic Republic of", "Islamic Republic of Iran", "Iran", "AS", 106},
+	{"IS", "ISL", 352, "Iceland", "Republic of Iceland", "", "EU", 107},
+	{"IT", "ITA", 380, "Italy", "Italian Republic", "", "EU", 108},
+	{"JE", "JEY", 832, "Jersey", "", "", "EU", 250},
+	{"JM", "JAM", 388, "Jamaica", "", "", "NA", 109},
+	{"JO", "JOR", 400, "Jordan", "Hashemite Kingdom of Jordan", "", "AS", 110},
+	{"JP", "JPN", 392, "Japan", "", "", "AS", 111},
+	{"KE", "KEN", 404, "Kenya", "Republic of Kenya", "", "AF", 112},
+	{"KG", "KGZ", 417, "Kyrgyzstan", "Kyrgyz Republic", "", "AS", 113},
+	{"KH", "KHM", 116, "Cambodia", "Kingdom of Cambodia", "", "AS", 114},
+	{"KI", "KIR", 296, "Kiribati", "Republic of Kiribati", "", "OC", 115},
+	{"KM", "COM", 174, "Comoros", "Union of the Comoros", "", "AF", 116},
+	{"KN", "KNA", 659, "Saint Kitts and Nevis", "", "", "NA", 117},
+	{"KP", "PRK", 408, "Korea, Democratic People's Republic of", "Democratic People's Republic of Korea", "North Korea", "AS", 118},
+	{"KR", "KOR", 410, "Korea, Republic of", "", "South Korea", "AS", 119},
+	{"KW", "KWT", 414, "Kuwait", "State of Kuwait", "", "AS", 120},
+	{"KY", "CYM", 136, "Cayman Islands", "", "", "NA", 121},
+	{"KZ", "KAZ", 398, "Kazakhstan", "Republic of Kazakhstan", "", "AS", 122},
+	{"LA", "LAO", 418, "Lao People's Democratic Republic", "", "Laos", "AS", 123},
+	{"LB", "LBN", 422, "Lebanon", "Lebanese Republic", "", "AS", 124},
+	{"LC", "LCA", 662, "Saint Lucia", "", "", "NA", 125},
+	{"LI", "LIE", 438, "Liechtenstein", "Principality of Liechtenstein", "", "EU", 126},
+	{"LK", "LKA", 144, "Sri Lanka", "Democratic Socialist Republic of Sri Lanka", "", "AS", 127},
+	{"LR", "LBR", 430, "Liberia", "Republic of Liberia", "", "AF", 128},
+	{"LS", "LSO", 426, "Lesotho", "Kingdom of Lesotho", "", "AF", 129},
+	{"LT", "LTU", 440, "Lithuania", "Republic of Lithuania", "", "EU", 130},
+	{"LU", "LUX", 442, "Luxembourg", "Grand Duchy of Luxembourg", "", "EU", 131},
+	{"LV", "LVA", 428, "Latvia", "Republic of Latvia", "", "EU", 132},
+	{"LY", "LBY", 434, "Libya", "Libya", "", "AF", 133},
+	{"MA", "MAR", 504, "Morocco", "Kingdom of Morocco", "", "AF", 134},
+	{"MC", "MCO", 492, "Monaco", "Principality of Monaco", "", "EU", 135},
+	{"MD", "MDA", 498, "Moldova, Republic of", "Republic of Moldova", "Moldova", "EU", 136},
+	{"ME", "MNE", 499, "Montenegro", "Montenegro", "", "EU", 242},
+	{"MF", "MAF", 663, "Saint Martin (French part)", "", "", "NA", 252},
+	{"MG", "MDG", 450, "Madagascar", "Republic of Madagascar", "", "AF", 137},
+	{"MH", "MHL", 584, "Marshall Islands", "Republic of the Marshall Islands", "", "OC", 138},
+	{"MK", "MKD", 807, "North Macedonia", "Republic of North Macedonia", "", "EU", 139},
+	{"ML", "MLI", 466, "Mali", "Republic of Mali", "", "AF", 140},
+	{"MM", "MMR", 104, "Myanmar", "Republic of Myanmar", "", "AS", 141},
+	{"MN", "MNG", 496, "Mongolia", "", "", "AS", 142},
+	{"MO", "MAC", 446, "Macao", "Macao Special Administrative Region of China", "", "AS", 143},
+	{"MP", "MNP", 580, "Northern Mariana Islands", "Commonwealth of the Northern Mariana Islands", "", "OC", 144},
+	{"MQ", "MTQ", 474, "Martinique", "", "", "NA", 145},
+	{"MR", "MRT", 478, "Mauritania", "Islamic Republic of Mauritania", "", "AF", 146},
+	{"MS", "MSR", 500, "Montserrat", "", "", "NA", 147},
+	{"MT", "MLT", 470, "Malta", "Republic of Malta", "", "EU", 148},
+	{"MU", "MUS", 480, "Mauritius", "Republic of Mauritius", "", "AF", 149},
+	{"MV", "MDV", 462, "Maldives", "Republic of Maldives", "", "AS", 150},
+	{"MW", "MWI", 454, "Malawi", "Republic of Malawi", "", "AF", 151},
+	{"MX", "MEX", 484, "Mexico", "United Mexican States", "", "NA", 152},
+	{"MY", "MYS", 458, "Malaysia", "", "", "AS", 153},
+	{"MZ", "MOZ", 508, "Mozambique", "Republic of Mozambique", "", "AF", 154},
+	{"NA", "NAM", 516, "Namibia", "Republic of Namibia", "", "AF", 155},
+	{"NC", "NCL", 540, "New Caledonia", "", "", "OC", 156},
+	{"NE", "NER", 562, "Niger", "Republic of the Niger", "", "AF", 157},
+	{"NF", "NFK", 574, "Norfolk Island", "", "", "OC", 158},
+	{"NG", "NGA", 566, "Nigeria", "Federal Republic of Nigeria", "", "AF", 159},
+	{"NI", "NIC", 558, "Nicaragua", "Republic of Nicaragua", "", "NA", 160},
+	{"NL", "NLD", 528, "Netherlands", "Kingdom of the Netherlands", "", "EU", 161},
+	{"NO", "NOR", 578, "Norway", "Kingdom of Norway", "", "EU", 162},
+	{"NP", "NPL", 524, "Nepal", "Federal Democratic Republic of Nepal", "", "AS", 163},
+	{"NR", "NRU", 520, "Nauru", "Republic of Nauru", "", "OC", 164},
+	{"NU", "NIU", 570, "Niue", "Niue", "", "OC", 165},
+	{"NZ", "NZL", 554, "New Zealand", "", "", "OC", 166},
+	{"OM", "OMN", 512, "Oman", "Sultanate of Oman", "", "AS", 167},
+	{"PA", "PAN", 591, "Panama", "Republic of Panama", "", "NA", 168},
+	{"PE", "PER", 604, "Peru", "Republic of Peru", "", "SA", 169},
+	{"PF", "PYF", 258, "French Polynesia", "", "", "OC", 170},
+	{"PG", "PNG", 598, "Papua New Guinea", "Independent State of Papua New Guinea", "", "OC", 171},
+	{"PH", "PHL", 608, "Philippines", "Republic of the Philippines", "", "AS", 172},
+	{"PK", "PAK", 586, "Pakistan", "Islamic Republic of Pakistan", "", "AS", 173},
+	{"PL", "POL", 616, "Poland", "Republic of Poland", "", "EU", 174},
+	{"PM", "SPM", 666, "Saint Pierre and Miquelon", "", "", "NA", 175},
+	{"PN", "PCN", 612, "Pitcairn", "", "", "OC", 176},
+	{"PR", "PRI", 630, "Puerto Rico", "", "", "NA", 177},
+	{"PS", "PSE", 275, "Palestine, State of", "the State of Palestine", "", "AS", 178},
+	{"PT", "PRT", 620, "Portugal", "Portuguese Republic", "", "EU", 179},
+	{"PW", "PLW", 585, "Palau", "Republic of Palau", "", "OC", 180},
+	{"PY", "PRY", 600, "Paraguay", "Republic of Paraguay", "", "SA", 181},
+	{"QA", "QAT", 634, "Qatar", "State of Qatar", "", "AS", 182},
+	{"RE", "REU", 638, "Réunion", "", "", "AF", 183},
+	{"RO", "ROU", 642, "Romania", "", "", "EU", 184},
+	{"RS", "SRB", 688, "Serbia", "Republic of Serbia", "", "EU", 239},
+	{"RU", "RUS", 643, "Russian Federation", "", "", "EU", 185},
+	{"RW", "RWA", 646, "Rwanda", "Rwandese Republic", "", "AF", 186},
+	{"SA", "SAU", 682, "Saudi Arabia", "Kingdom of Saudi Arabia", "", "AS", 187},
+	{"SB", "SLB", 90, "Solomon Islands", "", "", "OC", 188},
+	{"SC", "SYC", 690, "Seychelles", "Republic of Seychelles", "", "AF", 189},
+	{"SD", "SDN", 729, "Sudan", "Republic of the Sudan", "", "AF", 190},
+	{"SE", "SWE", 752, "Sweden", "Kingdom of Sweden", "", "EU", 191},
+	{"SG", "SGP", 702, "Singapore", "Republic of Singapore", "", "AS", 192},
+	{"SH", "SHN", 654, "Saint Helena, Ascension and Tristan da Cunha", "", "", "AF", 193},
+	{"SI", "SVN", 705, "Slovenia", "Republic of Slovenia", "", "EU", 194},
+	{"SJ", "SJM", 744, "Svalbard and Jan Mayen", "", "", "EU", 195},
+	{"SK", "SVK", 703, "Slovakia", "Slovak Republic", "", "EU", 196},
+	{"SL", "SLE", 694, "Sierra Leone", "Republic of Sierra Leone", "", "AF", 197},
+	{"SM", "SMR", 674, "San Marino", "Republic of San Marino", "", "EU", 198},
+	{"SN", "SEN", 686, "Senegal", "Republic of Senegal", "", "AF", 199},
+	{"SO", "SOM", 706, "Somalia", "Federal Republic of Somalia", "", "AF", 200},
+	{"SR", "SUR", 740, "Suriname", "Republic of Suriname", "", "SA", 201},
+	{"SS", "SSD", 728, "South Sudan", "Republic of South Sudan", "", "AF", 254},
+	{"ST", "STP", 678, "Sao Tome and Principe", "Democratic Republic of Sao Tome and Principe", "", "AF", 202},
+	{"SV", "SLV", 222, "El Salvador", "Republic of El Salvador", "", "NA", 203},
+	{"SX", "SXM", 534, "Sint Maarten (Dutch part)", "Sint Maarten (Dutch part)", "", "NA", 75},
+	{"SY", "SYR", 760, "Syrian Arab Republic", "", "Syria", "AS", 204},
+	{"SZ", "SWZ", 748, "Eswatini", "Kingdom of Eswatini", "", "AF", 205},
+	{"TC", "TCA", 796, "Turks and Caicos Islands", "", "", "NA", 206},
+	{"TD", "TCD", 148, "Chad", "Republic of Chad", "", "AF", 207},
+	{"TF", "ATF", 260, "French Southern Territories", "", "", "AN", 208},
+	{"TG", "TGO", 768, "Togo", "Togolese Republic", "", "AF", 209},
+	{"TH", "THA", 764, "Thailand", "Kingdom of Thailand", "", "AS", 210},
+	{"TJ", "TJK", 762, "Tajikistan", "Republic of Tajikistan", "", "AS", 211},
+	{"TK", "TKL", 772, "Tokelau", "", "", "OC", 212},
+	{"TL", "TLS", 626, "Timor-Leste", "Democratic Republic of Timor-Leste", "", "AS", 216},
+	{"TM", "TKM", 795, "Turkmenistan", "", "", "AS", 213},
+	{"TN", "TUN", 788, "Tunisia", "Republic of Tunisia", "", "AF", 214},
+	{"TO", "TON", 776, "Tonga", "Kingdom of Tonga", "", "OC", 215},
+	{"TR", "TUR", 792, "Türkiye", "Republic of Türkiye", "", "EU", 217},
+	{"TT", "TTO", 780, "Trinidad and Tobago", "Republic of Trinidad and Tobago", "", "NA", 218},
+	{"TV", "TUV", 798, "Tuvalu", "", "", "OC", 219},
+	{"TW", "TWN", 158, "Taiwan, Province of China", "Taiwan, Province of China", "Taiwan", "AS", 220},
+	{"TZ", "TZA", 834, "Tanzania, United Republic of", "United Republic of Tanzania", "Tanzania", "AF", 221},
+	{"UA", "UKR", 804, "Ukraine", "", "", "EU", 222},
+	{"UG", "UGA", 800, "Uganda", "Republic of Uganda", "", "AF", 223},
+	{"UM", "UMI", 581, "United States Minor Outlying Islands", "", "", "OC", 224},
+	{"US", "USA", 840, "United States", "United States of America", "", "NA", 225},
+	{"UY", "URY", 858, "Uruguay", "Eastern Republic of Uruguay", "", "SA", 226},
+	{"UZ", "UZB", 860, "Uzbekistan", "Republic of Uzbekistan", "", "AS", 227},
+	{"VA", "VAT", 336, "Holy See (Vatican City State)", "", "", "EU", 228},
+	{"VC", "VCT", 670, "Saint Vincent and the Grenadines", "", "", "NA", 229},
+	{"VE", "VEN", 862, "Venezuela, Bolivarian Republic of", "Bolivarian Republic of Venezuela", "Venezuela", "SA", 230},
+	{"VG", "VGB", 92, "Virgin Islands, British", "British Virgin Islands", "", "NA", 231},
+	{"VI", "VIR", 850, "Virgin Islands, U.S.", "Virgin Islands of the United States", "", "NA", 232},
+	{"VN", "VNM", 704, "Viet Nam", "Socialist Republic of Viet Nam", "Vietnam", "AS", 233},
+	{"VU", "VUT", 548, "Vanuatu", "Republic of Vanuatu", "", "OC", 234},
+	{"WF", "WLF", 876, "Wallis and Futuna", "", "", "OC", 235},
+	{"WS", "WSM", 882, "Samoa", "Independent State of Samoa", "", "OC", 236},
+	{"YE", "YEM", 887, "Yemen", "Republic of Yemen", "", "AS", 237},
+	{"YT", "MYT", 175, "Mayotte", "", "", "AF", 238},
+	{"ZA", "ZAF", 710, "South Africa", "Republic of South Africa", "", "AF", 240},
+	{"ZM", "ZMB", 894, "Zambia", "Republic of Zambia", "", "AF", 241},
+	{"ZW", "ZWE", 716, "Zimbabwe", "Republic of Zimbabwe", "", "AF", 243},
+}
+
+// geoipCodes are the country codes of the GeoIP ids, in the order of the
+// GeoIP_country_code table of libGeoIP.
+var geoipCodes = [256]string{
+	"--", "AP", "EU", "AD", "AE", "AF", "AG", "AI", "AL", "AM",
+	"CW", "AO", "AQ", "AR", "AS", "AT", "AU", "AW", "AZ", "BA",
+	"BB", "BD", "BE", "BF", "BG", "BH", "BI", "BJ", "BM", "BN",
+	"BO", "BR", "BS", "BT", "BV", "BW", "BY", "BZ", "CA", "CC",
+	"CD", "CF", "CG", "CH", "CI", "CK", "CL", "CM", "CN", "CO",
+	"CR", "CU", "CV", "CX", "CY", "CZ", "DE", "DJ", "DK", "DM",
+	"DO", "DZ", "EC", "EE", "EG", "EH", "ER", "ES", "ET", "FI",
+	"FJ", "FK", "FM", "FO", "FR", "SX", "GA", "GB", "GD", "GE",
+	"GF", "GH", "GI", "GL", "GM", "GN", "GP", "GQ", "GR", "GS",
+	"GT", "GU", "GW", "GY", "HK", "HM", "HN", "HR", "HT", "HU",
+	"ID", "IE", "IL", "IN", "IO", "IQ", "IR", "IS", "IT", "JM",
+	"JO", "JP", "KE", "KG", "KH", "KI", "KM", "KN", "KP", "KR",
+	"KW", "KY", "KZ", "LA", "LB", "LC", "LI", "LK", "LR", "LS",
+	"LT", "LU", "LV", "LY", "MA", "MC", "MD", "MG", "MH", "MK",
+	"ML", "MM", "MN", "MO", "MP", "MQ", "MR", "MS", "MT", "MU",
+	"MV", "MW", "MX", "MY", "MZ", "NA", "NC", "NE", "NF", "NG",
+	"NI", "NL", "NO", "NP", "NR", "NU", "NZ", "OM", "PA", "PE",
+	"PF", "PG", "PH", "PK", "PL", "PM", "PN", "PR", "PS", "PT",
+	"PW", "PY", "QA", "RE", "RO", "RU", "RW", "SA", "SB", "SC",
+	"SD", "SE", "SG", "SH", "SI", "SJ", "SK", "SL", "SM", "SN",
+	"SO", "SR", "ST", "SV", "SY", "SZ", "TC", "TD", "TF", "TG",
+	"TH", "TJ", "TK", "TM", "TN", "TO", "TL", "TR", "TT", "TV",
+	"TW", "TZ", "UA", "UG", "UM", "US", "UY", "UZ", "VA", "VC",
+	"VE", "VG", "VI", "VN", "VU", "WF", "WS", "YE", "YT", "RS",
+	"ZA", "ZM", "ME", "ZW", "A1", "A2", "O1", "AX", "GG", "IM",
+	"JE", "BL", "MF", "BQ", "SS", "O1",
+}
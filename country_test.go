@@ -0,0 +1,120 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "testing"
+
+func TestCountryLookups(t *testing.T) {
+	za := CountryByCode("za")
+	if za == nil || za.Alpha3 != "ZAF" || za.Numeric != 710 || za.Continent != "AF" || za.ID != 240 {
+		t.Fatalf("%+v", za)
+	}
+	if CountryByCode("ZAF") != za || CountryByNumeric(710) != za || CountryByName("south africa") != za {
+		t.Fatal("ZA lookups")
+	}
+	kr := CountryByName("South Korea")
+	if kr == nil || kr.Alpha2 != "KR" || CountryByName("Korea, Republic of") != kr {
+		t.Fatalf("%+v", kr)
+	}
+	if CountryByCode("XX") != nil || CountryByNumeric(999) != nil || CountryByName("Atlantis") != nil {
+		t.Fatal("unknown country found")
+	}
+	if n := len(Countries()); n != 249 {
+		t.Fatalf("%v countries", n)
+	}
+}
+
+func TestCountryByID(t *testing.T) {
+	tests := []struct {
+		id   int
+		code string
+	}{
+		{0, "--"},
+		{1, "AP"},
+		{2, "EU"},
+		{3, "AD"},
+		{225, "US"},
+		{244, "A1"},
+		{246, "O1"},
+		{254, "SS"},
+		{255, "O1"},
+	}
+	for _, test := range tests {
+		if c := CountryByID(test.id); c == nil || c.Alpha2 != test.code {
+			t.Fatalf("%v: got %+v, want %v", test.id, c, test.code)
+		}
+	}
+	if CountryByID(-1) != nil || CountryByID(256) != nil {
+		t.Fatal("out of range id found")
+	}
+	for _, c := range Countries() {
+		if CountryByID(c.ID).Alpha2 != c.Alpha2 {
+			t.Fatalf("%v has id %v", c.Alpha2, c.ID)
+		}
+	}
+}
+
+func TestContinentName(t *testing.T) {
+	if ContinentName("na") != "North America" || ContinentName("XX") != "" {
+		t.Fatal(ContinentName("na"))
+	}
+	for _, c := range Countries() {
+		if ContinentName(c.Continent) == "" {
+			t.Fatalf("%v has continent %q", c.Alpha2, c.Continent)
+		}
+	}
+}
+
+func TestCountryInfo(t *testing.T) {
+	gir := &GeoIPRecord{CountryCode: "AT"}
+	at := gir.CountryInfo()
+	if at == nil || at.Currency != "EUR" || at.CallingCode != 43 || !at.EU || !at.EEA || !at.Schengen {
+		t.Fatalf("%+v", at)
+	}
+	if len(at.Languages) != 1 || at.Languages[0] != "de" {
+		t.Fatalf("%v", at.Languages)
+	}
+	ch := CountryInfoByCode("CHE")
+	if ch.EU || ch.EEA || !ch.Schengen || ch.Currency != "CHF" {
+		t.Fatalf("%+v", ch)
+	}
+	if ie := CountryInfoByCode("IE"); !ie.EU || ie.Schengen {
+		t.Fatalf("%+v", ie)
+	}
+	if (&GeoIPRecord{CountryCode: "A1"}).CountryInfo() != nil || (*GeoIPRecord)(nil).CountryInfo() != nil {
+		t.Fatal("info for no country")
+	}
+	n := 0
+	for _, c := range Countries() {
+		if c.Info() == nil {
+			t.Fatalf("%v has no info", c.Alpha2)
+		}
+		if c.Info().EU {
+			n++
+		}
+	}
+	if n != 27 {
+		t.Fatalf("%v EU members", n)
+	}
+}
+
+func TestCountryFlag(t *testing.T) {
+	if f := CountryByCode("ZA").Flag(); f != "\U0001F1FF\U0001F1E6" {
+		t.Fatalf("%q", f)
+	}
+	if f := CountryByCode("O1").Flag(); f != "" {
+		t.Fatalf("%q", f)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CountrySet is a set of country codes described by an expression such
+// as "EU,EEA,-GB,US". The terms are applied from left to right; a term
+// adds its countries, or removes them if it starts with "-". A term is
+//
+//	a country code, alpha-2 or alpha-3, including the special GeoIP codes
+//	EU, EEA or SCHENGEN, the members of these groups
+//	continent:AF or Africa, the countries of a continent
+//	*, all countries
+//
+// Continents need the prefix or their name because their codes are also
+// country codes, for example AF is Afghanistan. The group EU shadows the
+// GeoIP code EU, so Contains("EU") is always false. The zero value is an
+// empty set.
+type CountrySet struct {
+	codes map[string]bool
+	terms []string
+}
+
+// ParseCountrySet parses a country set expression.
+func ParseCountrySet(expr string) (*CountrySet, error) {
+	s := new(CountrySet)
+	if err := s.Set(expr); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Set applies the terms of expr to s, so that a flag can be given more
+// than once. If a term is invalid, s is left unchanged.
+func (s *CountrySet) Set(expr string) error {
+	type change struct {
+		codes  []string
+		remove bool
+	}
+	var changes []change
+	var terms []string
+	for _, term := range strings.Split(expr, ",") {
+		term = strings.TrimSpace(term)
+		if len(term) == 0 {
+			continue
+		}
+		name, remove := term, strings.HasPrefix(term, "-")
+		if remove {
+			name = strings.TrimSpace(term[1:])
+		} else {
+			name = strings.TrimPrefix(name, "+")
+		}
+		codes, err := countrySetTerm(name)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change{codes, remove})
+		terms = append(terms, term)
+	}
+	if s.codes == nil {
+		s.codes = make(map[string]bool)
+	}
+	for _, c := range changes {
+		for _, code := range c.codes {
+			if c.remove {
+				delete(s.codes, code)
+			} else {
+				s.codes[code] = true
+			}
+		}
+	}
+	s.terms = append(s.terms, terms...)
+	return nil
+}
+
+func countrySetTerm(name string) ([]string, error) {
+	upper := strings.ToUpper(name)
+	switch upper {
+	case "*":
+		return countrySetCodes(func(c *CountryInfo) bool { return true }), nil
+	case "EU":
+		return countrySetCodes(func(c *CountryInfo) bool { return c.EU }), nil
+	case "EEA":
+		return countrySetCodes(func(c *CountryInfo) bool { return c.EEA }), nil
+	case "SCHENGEN":
+		return countrySetCodes(func(c *CountryInfo) bool { return c.Schengen }), nil
+	}
+	continent := ""
+	if strings.HasPrefix(upper, "CONTINENT:") {
+		continent = strings.TrimSpace(upper[len("CONTINENT:"):])
+		if len(ContinentName(continent)) == 0 {
+			return nil, fmt.Errorf("unknown continent %q", name)
+		}
+	} else {
+		for code, cname := range continentNames {
+			if strings.EqualFold(cname, name) {
+				continent = code
+			}
+		}
+	}
+	if len(continent) > 0 {
+		var codes []string
+		for _, c := range countries {
+			if c.Continent == continent {
+				codes = append(codes, c.Alpha2)
+			}
+		}
+		return codes, nil
+	}
+	if c := CountryByCode(name); c != nil {
+		return []string{c.Alpha2}, nil
+	}
+	return nil, fmt.Errorf("unknown country or group %q", name)
+}
+
+func countrySetCodes(match func(c *CountryInfo) bool) (codes []string) {
+	for i := range countries {
+		if match(countries[i].Info()) {
+			codes = append(codes, countries[i].Alpha2)
+		}
+	}
+	return
+}
+
+// String returns the terms that were applied to s.
+func (s *CountrySet) String() string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join(s.terms, ",")
+}
+
+// UnmarshalText replaces s with the set described by text. If text is
+// invalid, s is left unchanged.
+func (s *CountrySet) UnmarshalText(text []byte) error {
+	var t CountrySet
+	if err := t.Set(string(text)); err != nil {
+		return err
+	}
+	*s = t
+	return nil
+}
+
+// MarshalText returns the terms of s, so that a set survives a round trip
+// through UnmarshalText.
+func (s *CountrySet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Contains reports whether the country code, as returned by lookups such
+// as CountryCodeByIPv4, is in the set. Alpha-3 codes are accepted too.
+func (s *CountrySet) Contains(code string) bool {
+	if s == nil || len(code) == 0 {
+		return false
+	}
+	if s.codes[strings.ToUpper(code)] {
+		return true
+	}
+	if c := CountryByCode(code); c != nil {
+		return s.codes[c.Alpha2]
+	}
+	return false
+}
+
+// ContainsRecord reports whether the country of gir is in the set.
+func (s *CountrySet) ContainsRecord(gir *GeoIPRecord) bool {
+	return gir != nil && s.Contains(gir.CountryCode)
+}
+
+// Codes returns the alpha-2 codes in the set in order.
+func (s *CountrySet) Codes() []string {
+	if s == nil {
+		return nil
+	}
+	codes := make([]string, 0, len(s.codes))
+	for code := range s.codes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
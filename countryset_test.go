@@ -0,0 +1,111 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"encoding"
+	"flag"
+	"strings"
+	"testing"
+)
+
+var (
+	_ flag.Value               = new(CountrySet)
+	_ encoding.TextUnmarshaler = new(CountrySet)
+)
+
+func TestCountrySet(t *testing.T) {
+	tests := []struct {
+		expr    string
+		members string
+		others  string
+	}{
+		{"EU,EEA,-GB,US", "DE,FR,NO,IS,US,usa,AUT", "GB,CH,CA,A1,"},
+		{"schengen", "CH,NO,PL", "IE,CY,GB"},
+		{"continent:AF, -za", "NG,EG,KE", "ZA,AF"},
+		{"Africa,+AF", "NG,ZA,AF", "FR,US"},
+		{"*,-CN,-north america", "ZA,DE,BR", "CN,US,CA,A1"},
+		{"A1,A2,AP", "A1,A2,AP", "ZA"},
+		{"", "", "ZA"},
+	}
+	for _, test := range tests {
+		s, err := ParseCountrySet(test.expr)
+		if err != nil {
+			t.Fatalf("%q: %v", test.expr, err)
+		}
+		for _, code := range strings.Split(test.members, ",") {
+			if len(code) > 0 && !s.Contains(code) {
+				t.Fatalf("%q does not contain %v", test.expr, code)
+			}
+		}
+		for _, code := range strings.Split(test.others, ",") {
+			if s.Contains(code) {
+				t.Fatalf("%q contains %q", test.expr, code)
+			}
+		}
+	}
+}
+
+func TestCountrySetErrors(t *testing.T) {
+	for _, expr := range []string{"XX", "EU,-XYZ", "continent:XX", "-"} {
+		if _, err := ParseCountrySet(expr); err == nil {
+			t.Fatalf("%q: no error", expr)
+		}
+	}
+}
+
+func TestCountrySetFlag(t *testing.T) {
+	var s CountrySet
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&s, "countries", "")
+	if err := fs.Parse([]string{"-countries", "ZA,NL", "-countries", "-NL,BE"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(s.Codes(), ","); got != "BE,ZA" {
+		t.Fatalf("got %v", got)
+	}
+	if s.String() != "ZA,NL,-NL,BE" {
+		t.Fatalf("got %v", s.String())
+	}
+	if err := s.UnmarshalText([]byte("US")); err != nil || strings.Join(s.Codes(), ",") != "US" {
+		t.Fatalf("%v %v", err, s.Codes())
+	}
+	if !s.ContainsRecord(&GeoIPRecord{CountryCode: "US"}) || s.ContainsRecord(nil) {
+		t.Fatal("ContainsRecord")
+	}
+}
+
+func TestCountrySetInvalidTerm(t *testing.T) {
+	s, err := ParseCountrySet("ZA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("NL,XX"); err == nil {
+		t.Fatal("no error")
+	}
+	if err := s.UnmarshalText([]byte("XX")); err == nil {
+		t.Fatal("no error")
+	}
+	if got := strings.Join(s.Codes(), ","); got != "ZA" || s.String() != "ZA" {
+		t.Fatalf("got %v %v", got, s)
+	}
+	var nilSet *CountrySet
+	if nilSet.Codes() != nil || nilSet.Contains("ZA") {
+		t.Fatal("nil set")
+	}
+	if eu, _ := ParseCountrySet("EU"); eu.Contains("EU") || !eu.Contains("NL") {
+		t.Fatal("EU")
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"bytes"
+	"errors"
+	"net"
+)
+
+type ChangeKind int
+
+const (
+	Added ChangeKind = iota
+	Removed
+	Changed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	}
+	return "unknown"
+}
+
+// Change is a range of addresses whose record differs between two databases.
+// Before is nil for added ranges and After is nil for removed ranges.
+type Change struct {
+	Kind   ChangeKind
+	Start  net.IP
+	End    net.IP
+	Before *GeoIPRecord
+	After  *GeoIPRecord
+}
+
+// Networks returns the smallest list of CIDR networks that exactly covers the change.
+func (c *Change) Networks() []*net.IPNet {
+	return rangeToCIDRs(c.Start, c.End)
+}
+
+// CountryChanges counts the changes that affect a single country.
+type CountryChanges struct {
+	Added   int // ranges without data that now belong to the country
+	Removed int // ranges of the country that no longer have data
+	Gained  int // ranges that moved to the country from another country
+	Lost    int // ranges that moved from the country to another country
+	Updated int // ranges that stayed in the country but whose record changed
+}
+
+// DatabaseDiff is the result of comparing two databases.
+// Countries is keyed by country code.
+type DatabaseDiff struct {
+	Changes   []*Change
+	Countries map[string]*CountryChanges
+}
+
+var errDiffAborted = errors.New("diff aborted")
+
+// Diff compares two versions of a database and reports every range
+// whose record was added, removed or changed.
+func Diff(oldDB, newDB *GeoIP) (*DatabaseDiff, error) {
+	return DiffFunc(oldDB, newDB, equalRecords)
+}
+
+// DiffFunc is like Diff, but uses equal to decide whether two records
+// are the same. It is never called with nil records.
+func DiffFunc(oldDB, newDB *GeoIP, equal func(a, b *GeoIPRecord) bool) (*DatabaseDiff, error) {
+	if oldDB.IsIPv6Database() != newDB.IsIPv6Database() {
+		return nil, errors.New("cannot diff an IPv4 database against an IPv6 database")
+	}
+	return diffRanges(oldDB.walk, newDB.walk, equal)
+}
+
+// SameCountry reports whether a and b are in the same country.
+// Passing it to DiffFunc ignores changes within a country, such as a moved city.
+func SameCountry(a, b *GeoIPRecord) bool {
+	return a.CountryCode == b.CountryCode
+}
+
+type walkFunc func(fn func(r *Range) error) error
+
+// diffRanges merges the ranges of two walks, which must both cover the whole
+// address space, and records every part where the records differ.
+func diffRanges(walkOld, walkNew walkFunc, equal func(a, b *GeoIPRecord) bool) (*DatabaseDiff, error) {
+	done := make(chan struct{})
+	oldRanges, oldErr := streamRanges(walkOld, done)
+	newRanges, newErr := streamRanges(walkNew, done)
+	d := &DatabaseDiff{Countries: make(map[string]*CountryChanges)}
+	a, aok := <-oldRanges
+	b, bok := <-newRanges
+	for aok && bok {
+		start, end := a.Start, a.End
+		if bytes.Compare(b.Start, start) > 0 {
+			start = b.Start
+		}
+		if bytes.Compare(b.End, end) < 0 {
+			end = b.End
+		}
+		switch {
+		case a.Record == nil && b.Record == nil:
+		case a.Record == nil:
+			d.add(Added, start, end, nil, b.Record)
+		case b.Record == nil:
+			d.add(Removed, start, end, a.Record, nil)
+		case !equal(a.Record, b.Record):
+			d.add(Changed, start, end, a.Record, b.Record)
+		}
+		if isLastIP(end) {
+			break
+		}
+		if bytes.Equal(a.End, end) {
+			a, aok = <-oldRanges
+		}
+		if bytes.Equal(b.End, end) {
+			b, bok = <-newRanges
+		}
+	}
+	close(done)
+	if err := <-oldErr; err != nil && err != errDiffAborted {
+		return nil, err
+	}
+	if err := <-newErr; err != nil && err != errDiffAborted {
+		return nil, err
+	}
+	if !aok || !bok {
+		return nil, errors.New("database ranges do not cover the address space")
+	}
+	d.count()
+	return d, nil
+}
+
+func streamRanges(walk walkFunc, done <-chan struct{}) (<-chan *Range, <-chan error) {
+	ranges := make(chan *Range, 64)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(ranges)
+		errc <- walk(func(r *Range) error {
+			select {
+			case ranges <- r:
+				return nil
+			case <-done:
+				return errDiffAborted
+			}
+		})
+	}()
+	return ranges, errc
+}
+
+func (d *DatabaseDiff) add(kind ChangeKind, start, end net.IP, before, after *GeoIPRecord) {
+	if n := len(d.Changes); n > 0 {
+		last := d.Changes[n-1]
+		if last.Kind == kind && bytes.Equal(nextIP(last.End), start) &&
+			equalRecords(last.Before, before) && equalRecords(last.After, after) {
+			last.End = end
+			return
+		}
+	}
+	d.Changes = append(d.Changes, &Change{Kind: kind, Start: start, End: end, Before: before, After: after})
+}
+
+func (d *DatabaseDiff) country(code string) *CountryChanges {
+	c, ok := d.Countries[code]
+	if !ok {
+		c = new(CountryChanges)
+		d.Countries[code] = c
+	}
+	return c
+}
+
+func (d *DatabaseDiff) count() {
+	for _, c := range d.Changes {
+		switch {
+		case c.Kind == Added:
+			d.country(c.After.CountryCode).Added++
+		case c.Kind == Removed:
+			d.country(c.Before.CountryCode).Removed++
+		case c.Before.CountryCode != c.After.CountryCode:
+			d.country(c.Before.CountryCode).Lost++
+			d.country(c.After.CountryCode).Gained++
+		default:
+			d.country(c.After.CountryCode).Updated++
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"testing"
+)
+
+func TestRangeToCIDRs(t *testing.T) {
+	tests := []struct {
+		start, end string
+		want       []string
+	}{
+		{"10.0.0.0", "10.0.0.255", []string{"10.0.0.0/24"}},
+		{"10.0.0.1", "10.0.0.6", []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}},
+		{"0.0.0.0", "255.255.255.255", []string{"0.0.0.0/0"}},
+		{"255.255.255.254", "255.255.255.255", []string{"255.255.255.254/31"}},
+	}
+	for _, test := range tests {
+		nets := rangeToCIDRs(net.ParseIP(test.start).To4(), net.ParseIP(test.end).To4())
+		if len(nets) != len(test.want) {
+			t.Fatalf("%v-%v: got %v", test.start, test.end, nets)
+		}
+		for i, n := range nets {
+			if n.String() != test.want[i] {
+				t.Fatalf("%v-%v: got %v, want %v", test.start, test.end, n, test.want[i])
+			}
+		}
+	}
+}
+
+// fakeWalk returns a walk over the IPv4 space where every entry of
+// countries is the country of a consecutive /2, and "" means no data.
+func fakeWalk(countries ...string) walkFunc {
+	return func(fn func(r *Range) error) error {
+		for i, c := range countries {
+			start := net.IPv4(byte(i<<6), 0, 0, 0).To4()
+			r := &Range{Start: start, End: lastIP(start, 2)}
+			if c != "" {
+				r.Record = &GeoIPRecord{CountryCode: c}
+			}
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestDiffRanges(t *testing.T) {
+	d, err := diffRanges(fakeWalk("ZA", "", "NL", "NL"), fakeWalk("ZA", "FR", "", "DE"), equalRecords)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Changes) != 3 {
+		t.Fatalf("got %v changes", len(d.Changes))
+	}
+	if c := d.Changes[0]; c.Kind != Added || c.After.CountryCode != "FR" || c.Start.String() != "64.0.0.0" {
+		t.Fatalf("1: %v", c)
+	}
+	if c := d.Changes[1]; c.Kind != Removed || c.Before.CountryCode != "NL" || c.End.String() != "191.255.255.255" {
+		t.Fatalf("2: %v", c)
+	}
+	if c := d.Changes[2]; c.Kind != Changed || c.Before.CountryCode != "NL" || c.After.CountryCode != "DE" {
+		t.Fatalf("3: %v", c)
+	}
+	if c := d.Countries["NL"]; c.Removed != 1 || c.Lost != 1 {
+		t.Fatalf("4: %+v", c)
+	}
+	if c := d.Countries["DE"]; c.Gained != 1 {
+		t.Fatalf("5: %+v", c)
+	}
+	if _, ok := d.Countries["ZA"]; ok {
+		t.Fatal("6")
+	}
+}
+
+func TestDiffRangesMerge(t *testing.T) {
+	d, err := diffRanges(fakeWalk("ZA", "ZA", "ZA", "ZA"), fakeWalk("", "", "ZA", "ZA"), equalRecords)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Changes) != 1 || d.Changes[0].End.String() != "127.255.255.255" {
+		t.Fatalf("%v", d.Changes)
+	}
+	if nets := d.Changes[0].Networks(); len(nets) != 1 || nets[0].String() != "0.0.0.0/1" {
+		t.Fatalf("%v", nets)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+)
+
+// EarthRadius is the mean radius of the earth in kilometres.
+const EarthRadius = 6371.0088
+
+// WGS-84 ellipsoid
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = (1 - wgs84F) * wgs84A
+)
+
+var errNoConvergence = errors.New("vincenty formula failed to converge")
+
+// HasLocation reports whether the record has coordinates. Records from
+// country databases and addresses without a known location have 0,0.
+func (gir *GeoIPRecord) HasLocation() bool {
+	return gir != nil && (gir.Latitude != 0 || gir.Longitude != 0)
+}
+
+// Distance returns the great-circle distance in kilometres between the
+// locations of two records.
+func Distance(a, b *GeoIPRecord) float64 {
+	return Haversine(a.Latitude, a.Longitude, b.Latitude, b.Longitude)
+}
+
+// Bearing returns the initial bearing from the location of a to that of b,
+// see InitialBearing.
+func Bearing(a, b *GeoIPRecord) float64 {
+	return InitialBearing(a.Latitude, a.Longitude, b.Latitude, b.Longitude)
+}
+
+// Haversine returns the great-circle distance in kilometres between two
+// coordinates in degrees, on a sphere with the EarthRadius.
+func Haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dphi, dlambda := phi2-phi1, radians(lon2-lon1)
+	h := math.Pow(math.Sin(dphi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dlambda/2), 2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Vincenty returns the distance in kilometres between two coordinates
+// in degrees on the WGS-84 ellipsoid. It is more accurate than Haversine,
+// but fails for some nearly antipodal points.
+func Vincenty(lat1, lon1, lat2, lon2 float64) (float64, error) {
+	L := radians(lon2 - lon1)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(radians(lat1)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(radians(lat2)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cos2Alpha, cos2SigmaM float64
+	for i := 0; ; i++ {
+		if i == 200 {
+			return 0, errNoConvergence
+		}
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			// coincident points
+			return 0, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cos2Alpha != 0 {
+			// not an equatorial line
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		C := wgs84F / 16 * cos2Alpha * (4 + wgs84F*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			break
+		}
+	}
+	u2 := cos2Alpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return wgs84B * A * (sigma - deltaSigma) / 1000, nil
+}
+
+// InitialBearing returns the direction in degrees, clockwise from north
+// and in [0, 360), in which to leave the first coordinate to follow the
+// great circle to the second.
+func InitialBearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dlambda := radians(lon2 - lon1)
+	y := math.Sin(dlambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dlambda)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// DistanceToIP returns the great-circle distance in kilometres between
+// the locations of two addresses in a city database.
+func (gi *GeoIP) DistanceToIP(ip1, ip2 net.IP) (float64, error) {
+	return distanceBetween(gi, ip1, ip2)
+}
+
+func distanceBetween(res Resolver, ip1, ip2 net.IP) (float64, error) {
+	a, b := res.Lookup(ip1), res.Lookup(ip2)
+	if !a.HasLocation() {
+		return 0, fmt.Errorf("no location for %v", ip1)
+	}
+	if !b.HasLocation() {
+		return 0, fmt.Errorf("no location for %v", ip2)
+	}
+	return Distance(a, b), nil
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
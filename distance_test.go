@@ -0,0 +1,100 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"math"
+	"net"
+	"testing"
+)
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestHaversine(t *testing.T) {
+	london := &GeoIPRecord{Latitude: 51.5074, Longitude: -0.1278}
+	paris := &GeoIPRecord{Latitude: 48.8566, Longitude: 2.3522}
+	if d := Distance(london, paris); !near(d, 343.557, 0.01) {
+		t.Fatalf("london-paris %v", d)
+	}
+	if d := Haversine(-33.9249, 18.4241, -26.2041, 28.0473); !near(d, 1261.577, 0.01) {
+		t.Fatalf("cape town-johannesburg %v", d)
+	}
+	if d := Haversine(0, 0, 0, 180); !near(d, math.Pi*EarthRadius, 1e-6) {
+		t.Fatalf("antipodes %v", d)
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	// Flinders Peak to Buninyong, from Vincenty's paper
+	d, err := Vincenty(-37.95103342, 144.42486789, -37.65282114, 143.92649554)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(d, 54.972271, 1e-6) {
+		t.Fatalf("%v", d)
+	}
+	if d, err := Vincenty(10, 10, 10, 10); err != nil || d != 0 {
+		t.Fatalf("coincident %v %v", d, err)
+	}
+	if _, err := Vincenty(0, 0, 0.5, 179.7); err == nil {
+		t.Fatal("nearly antipodal points converged")
+	}
+}
+
+func TestBearing(t *testing.T) {
+	// on the sphere, 306.868 on the ellipsoid
+	if b := InitialBearing(-37.95103342, 144.42486789, -37.65282114, 143.92649554); !near(b, 306.984, 0.01) {
+		t.Fatalf("%v", b)
+	}
+	tests := []struct {
+		lat, lon float64
+		want     float64
+	}{
+		{1, 0, 0},
+		{0, 1, 90},
+		{-1, 0, 180},
+		{0, -1, 270},
+	}
+	for _, test := range tests {
+		if b := Bearing(&GeoIPRecord{}, &GeoIPRecord{Latitude: test.lat, Longitude: test.lon}); !near(b, test.want, 1e-9) {
+			t.Fatalf("%v,%v: %v", test.lat, test.lon, b)
+		}
+	}
+}
+
+type locationResolver map[string]*GeoIPRecord
+
+func (r locationResolver) Lookup(ip net.IP) *GeoIPRecord {
+	return r[ip.String()]
+}
+
+func TestDistanceBetween(t *testing.T) {
+	res := locationResolver{
+		"1.1.1.1": {Latitude: 51.5074, Longitude: -0.1278},
+		"2.2.2.2": {Latitude: 48.8566, Longitude: 2.3522},
+		"3.3.3.3": {CountryCode: "FR"},
+	}
+	if d, err := distanceBetween(res, net.ParseIP("1.1.1.1"), net.ParseIP("2.2.2.2")); err != nil || !near(d, 343.557, 0.01) {
+		t.Fatalf("%v %v", d, err)
+	}
+	if _, err := distanceBetween(res, net.ParseIP("1.1.1.1"), net.ParseIP("3.3.3.3")); err == nil {
+		t.Fatal("no location")
+	}
+	if _, err := distanceBetween(res, net.ParseIP("4.4.4.4"), net.ParseIP("1.1.1.1")); err == nil {
+		t.Fatal("not found")
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package enrich adds GeoIP fields to log lines.
+//
+// Text lines, such as Apache and nginx combined logs, get the fields
+// appended as key=value pairs:
+//
+//	196.213.226.36 - - [...] "GET / HTTP/1.1" 200 512 "-" "curl" geo_country=ZA geo_city="Cape Town"
+//
+// JSON lines get the fields merged into the object as extra keys.
+package enrich
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// DefaultFields are the fields added if Enricher.Fields is empty.
+var DefaultFields = []string{"country", "city", "lat", "lon"}
+
+// fieldValues are the fields that can be added, and how to get their values.
+var fieldValues = map[string]func(gir *geoip.GeoIPRecord) interface{}{
+	"country":      func(gir *geoip.GeoIPRecord) interface{} { return gir.CountryCode },
+	"country3":     func(gir *geoip.GeoIPRecord) interface{} { return gir.CountryCode3 },
+	"country_name": func(gir *geoip.GeoIPRecord) interface{} { return gir.CountryName },
+	"continent":    func(gir *geoip.GeoIPRecord) interface{} { return gir.ContinentCode },
+	"region":       func(gir *geoip.GeoIPRecord) interface{} { return gir.Region },
+	"city":         func(gir *geoip.GeoIPRecord) interface{} { return gir.City },
+	"postal_code":  func(gir *geoip.GeoIPRecord) interface{} { return gir.PostalCode },
+	"lat":          func(gir *geoip.GeoIPRecord) interface{} { return gir.Latitude },
+	"lon":          func(gir *geoip.GeoIPRecord) interface{} { return gir.Longitude },
+	"asn":          func(gir *geoip.GeoIPRecord) interface{} { return gir.ASNumber },
+	"org":          func(gir *geoip.GeoIPRecord) interface{} { return gir.Organization },
+}
+
+// Enricher looks up the client address of log lines and adds its record.
+//
+// The address is read from the JSONKey of JSON lines if JSONKey is set,
+// else from the text matched by Pattern if it is set, else from the
+// Field-th whitespace separated field of the line.
+type Enricher struct {
+	Resolver geoip.Resolver
+	// Field is the index of the field holding the address, starting at 0.
+	Field int
+	// Pattern finds the address in a line, in the submatch named "ip"
+	// or else in the first submatch or else in the whole match.
+	Pattern *regexp.Regexp
+	// JSONKey is the key of the address in JSON lines, with dots
+	// separating the keys of nested objects, like "client.ip".
+	JSONKey string
+	// Fields are the names of the fields to add, DefaultFields if empty.
+	// Valid names are country, country3, country_name, continent, region,
+	// city, postal_code, lat, lon, asn and org.
+	Fields []string
+	// Prefix is prepended to the names of the added fields, "geo_" if empty.
+	Prefix string
+	// Workers is the number of lines enriched concurrently by Run.
+	// Lines are always written in the order they were read.
+	Workers int
+}
+
+// Validate checks that all the fields are known.
+func (e *Enricher) Validate() error {
+	for _, f := range e.fields() {
+		if _, ok := fieldValues[f]; !ok {
+			return fmt.Errorf("enrich: unknown field %q", f)
+		}
+	}
+	return nil
+}
+
+func (e *Enricher) fields() []string {
+	if len(e.Fields) == 0 {
+		return DefaultFields
+	}
+	return e.Fields
+}
+
+func (e *Enricher) prefix() string {
+	if len(e.Prefix) == 0 {
+		return "geo_"
+	}
+	return e.Prefix
+}
+
+// Address returns the client address of line, or nil if it has none.
+func (e *Enricher) Address(line []byte) net.IP {
+	var s string
+	switch {
+	case len(e.JSONKey) > 0:
+		var obj map[string]interface{}
+		if err := json.Unmarshal(line, &obj); err != nil {
+			return nil
+		}
+		s, _ = lookupKey(obj, e.JSONKey).(string)
+	case e.Pattern != nil:
+		m := e.Pattern.FindSubmatch(line)
+		if m == nil {
+			return nil
+		}
+		i := 0
+		if len(m) > 1 {
+			i = 1
+		}
+		for j, name := range e.Pattern.SubexpNames() {
+			if name == "ip" {
+				i = j
+			}
+		}
+		s = string(m[i])
+	default:
+		fields := bytes.Fields(line)
+		if e.Field < 0 || e.Field >= len(fields) {
+			return nil
+		}
+		s = string(fields[e.Field])
+	}
+	return parseAddr(s)
+}
+
+func lookupKey(obj map[string]interface{}, key string) interface{} {
+	keys := strings.Split(key, ".")
+	for _, k := range keys[:len(keys)-1] {
+		var ok bool
+		if obj, ok = obj[k].(map[string]interface{}); !ok {
+			return nil
+		}
+	}
+	return obj[keys[len(keys)-1]]
+}
+
+// parseAddr parses an address that can be quoted, bracketed, followed by
+// a comma or have a port, as found in logs.
+func parseAddr(s string) net.IP {
+	s = strings.Trim(s, `"',;`)
+	if ip := net.ParseIP(strings.Trim(s, "[]")); ip != nil {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		return net.ParseIP(host)
+	}
+	return nil
+}
+
+// value returns the value of the field f of gir, and false if it is empty.
+func value(f string, gir *geoip.GeoIPRecord) (interface{}, bool) {
+	v := fieldValues[f](gir)
+	switch v := v.(type) {
+	case string:
+		return v, len(v) > 0
+	case int:
+		return v, v != 0
+	}
+	return v, gir.Latitude != 0 || gir.Longitude != 0
+}
+
+// Line returns line with the fields of its client address added. Lines
+// without an address, or whose address is not found, are returned as is.
+func (e *Enricher) Line(line []byte) []byte {
+	ip := e.Address(line)
+	if ip == nil {
+		return line
+	}
+	gir := e.Resolver.Lookup(ip)
+	if gir == nil {
+		return line
+	}
+	if len(e.JSONKey) > 0 {
+		return e.mergeJSON(line, gir)
+	}
+	return e.appendText(line, gir)
+}
+
+func (e *Enricher) appendText(line []byte, gir *geoip.GeoIPRecord) []byte {
+	out := make([]byte, len(line), len(line)+64)
+	copy(out, line)
+	for _, f := range e.fields() {
+		v, ok := value(f, gir)
+		if !ok {
+			continue
+		}
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+			if strings.ContainsAny(s, " \t\"=\\") || !strconv.CanBackquote(s) {
+				s = strconv.Quote(s)
+			}
+		case float64:
+			s = strconv.FormatFloat(v, 'f', 4, 64)
+		case int:
+			s = strconv.Itoa(v)
+		}
+		out = append(out, ' ')
+		out = append(out, e.prefix()...)
+		out = append(out, f...)
+		out = append(out, '=')
+		out = append(out, s...)
+	}
+	return out
+}
+
+// mergeJSON adds the fields as keys at the end of the object in line,
+// keeping the rest of the line as it is.
+func (e *Enricher) mergeJSON(line []byte, gir *geoip.GeoIPRecord) []byte {
+	trimmed := bytes.TrimRight(line, " \t")
+	end := len(trimmed) - 1
+	if end < 0 || trimmed[end] != '}' {
+		return line
+	}
+	empty := len(bytes.TrimSpace(trimmed[bytes.IndexByte(trimmed, '{')+1:end])) == 0
+	out := append([]byte(nil), trimmed[:end]...)
+	for _, f := range e.fields() {
+		v, ok := value(f, gir)
+		if !ok {
+			continue
+		}
+		data, _ := json.Marshal(v)
+		key, _ := json.Marshal(e.prefix() + f)
+		if !empty {
+			out = append(out, ',')
+		}
+		empty = false
+		out = append(out, key...)
+		out = append(out, ':')
+		out = append(out, data...)
+	}
+	return append(out, '}')
+}
+
+// Run enriches every line read from r and writes it to w.
+func (e *Enricher) Run(w io.Writer, r io.Reader) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	workers := e.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	type job struct {
+		line []byte
+		done chan []byte
+	}
+	jobs := make(chan *job, workers)
+	ordered := make(chan *job, 4*workers)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(jobs)
+		defer close(ordered)
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadBytes('\n')
+			if len(line) > 0 {
+				j := &job{line: bytes.TrimRight(line, "\r\n"), done: make(chan []byte, 1)}
+				ordered <- j
+				jobs <- j
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				readErr <- err
+				return
+			}
+		}
+	}()
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.done <- e.Line(j.line)
+			}
+		}()
+	}
+
+	bw := bufio.NewWriter(w)
+	var writeErr error
+	for j := range ordered {
+		line := <-j.done
+		if writeErr == nil {
+			bw.Write(line)
+			writeErr = bw.WriteByte('\n')
+		}
+	}
+	if err := <-readErr; err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	return bw.Flush()
+}
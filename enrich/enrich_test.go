@@ -0,0 +1,108 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrich
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+var testResolver = geoiptest.New(map[string]*geoip.GeoIPRecord{
+	"196.213.226.36": {CountryCode: "ZA", City: "Cape Town", Latitude: -33.9167, Longitude: 18.4167},
+	"2001:db8::1":    {CountryCode: "DE", ASNumber: 3320},
+})
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		e    *Enricher
+		line string
+		want string
+	}{
+		{
+			&Enricher{},
+			`196.213.226.36 - - [10/Oct/2013:13:55:36 +0200] "GET / HTTP/1.1" 200 2326 "-" "curl/7.29"`,
+			`196.213.226.36 - - [10/Oct/2013:13:55:36 +0200] "GET / HTTP/1.1" 200 2326 "-" "curl/7.29" geo_country=ZA geo_city="Cape Town" geo_lat=-33.9167 geo_lon=18.4167`,
+		},
+		{
+			&Enricher{Field: 1, Fields: []string{"country", "lat", "asn"}, Prefix: "client_"},
+			`www.example.com [2001:db8::1]:443 GET /`,
+			`www.example.com [2001:db8::1]:443 GET / client_country=DE client_asn=3320`,
+		},
+		{
+			&Enricher{Pattern: regexp.MustCompile(`client=(?P<ip>\S+)`)},
+			`level=info client=196.213.226.36, msg=hello`,
+			`level=info client=196.213.226.36, msg=hello geo_country=ZA geo_city="Cape Town" geo_lat=-33.9167 geo_lon=18.4167`,
+		},
+		{
+			&Enricher{JSONKey: "client.ip", Fields: []string{"country", "city"}},
+			`{"client": {"ip": "196.213.226.36"}, "status": 200}`,
+			`{"client": {"ip": "196.213.226.36"}, "status": 200,"geo_country":"ZA","geo_city":"Cape Town"}`,
+		},
+		{
+			&Enricher{JSONKey: "ip", Fields: []string{"country"}},
+			`{"ip": "10.0.0.1"}`,
+			`{"ip": "10.0.0.1"}`,
+		},
+		{&Enricher{}, `not a log line`, `not a log line`},
+		{&Enricher{JSONKey: "ip"}, `{"ip": `, `{"ip": `},
+	}
+	for _, test := range tests {
+		test.e.Resolver = testResolver
+		if got := string(test.e.Line([]byte(test.line))); got != test.want {
+			t.Fatalf("got  %v\nwant %v", got, test.want)
+		}
+	}
+}
+
+// slowResolver answers in reverse order of the requests.
+type slowResolver struct{}
+
+func (slowResolver) Lookup(ip net.IP) *geoip.GeoIPRecord {
+	time.Sleep(time.Duration(255-int(ip.To4()[3])) * 10 * time.Microsecond)
+	return &geoip.GeoIPRecord{CountryCode: fmt.Sprint(ip.To4()[3])}
+}
+
+func TestRunOrder(t *testing.T) {
+	var in, want bytes.Buffer
+	for i := 0; i < 256; i++ {
+		fmt.Fprintf(&in, "10.0.0.%v x\n", i)
+		fmt.Fprintf(&want, "10.0.0.%v x geo_country=%v\n", i, i)
+	}
+	in.WriteString("no newline")
+	want.WriteString("no newline\n")
+	var out bytes.Buffer
+	e := &Enricher{Resolver: slowResolver{}, Workers: 8}
+	if err := e.Run(&out, &in); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want.String() {
+		t.Fatalf("%v", out.String())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	e := &Enricher{Resolver: testResolver, Fields: []string{"country", "planet"}}
+	if err := e.Run(new(bytes.Buffer), strings.NewReader("")); err == nil {
+		t.Fatal("unknown field")
+	}
+}
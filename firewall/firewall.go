@@ -0,0 +1,194 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package firewall writes lists of networks, such as those returned by
+// GeoIP.CountryNetworks, in the formats of common Linux firewall tools.
+package firewall
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+)
+
+type Format int
+
+const (
+	// CIDR writes one network per line.
+	CIDR Format = iota
+	// IPSet writes input for "ipset restore".
+	IPSet
+	// Nftables writes nftables set definitions for use with "include".
+	Nftables
+	// IPTables writes input for "iptables-restore --noflush" with the
+	// IPv4 networks. Without --noflush, iptables-restore flushes every
+	// other chain of the filter table.
+	IPTables
+	// IP6Tables writes input for "ip6tables-restore --noflush" with the
+	// IPv6 networks.
+	IP6Tables
+)
+
+var formatNames = []string{
+	CIDR:      "cidr",
+	IPSet:     "ipset",
+	Nftables:  "nftables",
+	IPTables:  "iptables",
+	IP6Tables: "ip6tables",
+}
+
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+	return formatNames[f]
+}
+
+// ParseFormat returns the format with the given name, for example "ipset".
+func ParseFormat(name string) (Format, error) {
+	for f, n := range formatNames {
+		if n == name {
+			return Format(f), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown firewall format %q", name)
+}
+
+// Rules are networks together with the names used for them.
+//
+// Sets can only hold one address family, so the IPSet and Nftables
+// formats put the IPv4 networks in a set called Name and the IPv6
+// networks in a set called Name followed by "6". IPTables and IP6Tables
+// add a chain called Name that jumps to Target for every network; the
+// chain is emptied first, so loading the rules again replaces it. They
+// must be loaded with --noflush to leave the other chains alone. Write
+// fails if Name, with the "6" if any, is too long for the format: 31
+// characters for ipset, 255 for nftables and 28 for iptables.
+type Rules struct {
+	Name     string
+	Target   string // iptables target, DROP if empty
+	Networks []*net.IPNet
+}
+
+// Write writes the rules to w in the given format.
+func (r *Rules) Write(w io.Writer, f Format) error {
+	if err := r.checkName(f); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	v4, v6 := r.split()
+	switch f {
+	case CIDR:
+		for _, n := range r.Networks {
+			fmt.Fprintf(bw, "%v\n", n)
+		}
+	case IPSet:
+		writeIPSet(bw, r.Name, "inet", v4)
+		writeIPSet(bw, r.Name+"6", "inet6", v6)
+	case Nftables:
+		writeNftSet(bw, r.Name, "ipv4_addr", v4)
+		writeNftSet(bw, r.Name+"6", "ipv6_addr", v6)
+	case IPTables:
+		r.writeIPTables(bw, v4)
+	case IP6Tables:
+		r.writeIPTables(bw, v6)
+	default:
+		return fmt.Errorf("unknown firewall format %v", f)
+	}
+	return bw.Flush()
+}
+
+// Maximum lengths of set and chain names. Longer names fail to load.
+const (
+	maxIPSetName    = 31
+	maxNftSetName   = 255
+	maxIPTablesName = 28
+)
+
+// checkName reports an error if the names that the rules use in format f
+// are empty or too long, including the "6" appended to IPv6 set names.
+func (r *Rules) checkName(f Format) error {
+	max := 0
+	name := r.Name
+	switch f {
+	case CIDR:
+		return nil
+	case IPSet:
+		max, name = maxIPSetName, r.Name+"6"
+	case Nftables:
+		max, name = maxNftSetName, r.Name+"6"
+	case IPTables, IP6Tables:
+		max = maxIPTablesName
+	}
+	if len(r.Name) == 0 {
+		return fmt.Errorf("no %v set or chain name", f)
+	}
+	if max > 0 && len(name) > max {
+		return fmt.Errorf("%v name %q is longer than %v characters", f, name, max)
+	}
+	return nil
+}
+
+func (r *Rules) split() (v4, v6 []*net.IPNet) {
+	for _, n := range r.Networks {
+		if n.IP.To4() != nil {
+			v4 = append(v4, n)
+		} else {
+			v6 = append(v6, n)
+		}
+	}
+	return
+}
+
+func writeIPSet(w io.Writer, name, family string, nets []*net.IPNet) {
+	if len(nets) == 0 {
+		return
+	}
+	maxelem := 65536
+	for maxelem < len(nets) {
+		maxelem *= 2
+	}
+	fmt.Fprintf(w, "create %v hash:net family %v maxelem %v -exist\n", name, family, maxelem)
+	for _, n := range nets {
+		fmt.Fprintf(w, "add %v %v -exist\n", name, n)
+	}
+}
+
+func writeNftSet(w io.Writer, name, typ string, nets []*net.IPNet) {
+	if len(nets) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "set %v {\n\ttype %v\n\tflags interval\n\telements = {\n", name, typ)
+	for i, n := range nets {
+		sep := ","
+		if i == len(nets)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(w, "\t\t%v%v\n", n, sep)
+	}
+	fmt.Fprintf(w, "\t}\n}\n")
+}
+
+func (r *Rules) writeIPTables(w io.Writer, nets []*net.IPNet) {
+	target := r.Target
+	if len(target) == 0 {
+		target = "DROP"
+	}
+	fmt.Fprintf(w, "*filter\n:%v - [0:0]\n", r.Name)
+	for _, n := range nets {
+		fmt.Fprintf(w, "-A %v -s %v -j %v\n", r.Name, n, target)
+	}
+	fmt.Fprintf(w, "COMMIT\n")
+}
@@ -0,0 +1,94 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firewall
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func testRules() *Rules {
+	var nets []*net.IPNet
+	for _, s := range []string{"196.213.0.0/16", "41.0.0.0/11", "2c0f:f000::/20"} {
+		_, n, _ := net.ParseCIDR(s)
+		nets = append(nets, n)
+	}
+	return &Rules{Name: "geo_za", Networks: nets}
+}
+
+var formatTests = []struct {
+	format Format
+	want   string
+}{
+	{CIDR, "196.213.0.0/16\n41.0.0.0/11\n2c0f:f000::/20\n"},
+	{IPSet, "create geo_za hash:net family inet maxelem 65536 -exist\n" +
+		"add geo_za 196.213.0.0/16 -exist\n" +
+		"add geo_za 41.0.0.0/11 -exist\n" +
+		"create geo_za6 hash:net family inet6 maxelem 65536 -exist\n" +
+		"add geo_za6 2c0f:f000::/20 -exist\n"},
+	{Nftables, "set geo_za {\n\ttype ipv4_addr\n\tflags interval\n\telements = {\n\t\t196.213.0.0/16,\n\t\t41.0.0.0/11\n\t}\n}\n" +
+		"set geo_za6 {\n\ttype ipv6_addr\n\tflags interval\n\telements = {\n\t\t2c0f:f000::/20\n\t}\n}\n"},
+	{IPTables, "*filter\n:geo_za - [0:0]\n-A geo_za -s 196.213.0.0/16 -j DROP\n-A geo_za -s 41.0.0.0/11 -j DROP\nCOMMIT\n"},
+	{IP6Tables, "*filter\n:geo_za - [0:0]\n-A geo_za -s 2c0f:f000::/20 -j DROP\nCOMMIT\n"},
+}
+
+func TestWrite(t *testing.T) {
+	for _, test := range formatTests {
+		var buf bytes.Buffer
+		if err := testRules().Write(&buf, test.format); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != test.want {
+			t.Fatalf("%v:\n%v", test.format, buf.String())
+		}
+	}
+}
+
+func TestWriteName(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		ok     bool
+	}{
+		{"", CIDR, true},
+		{"", IPSet, false},
+		{"geo_abcdefghijklmnopqrstuvwxyz", IPSet, true},
+		{"geo_abcdefghijklmnopqrstuvwxyz0", IPSet, false},
+		{"geo_abcdefghijklmnopqrstuvwx", IPTables, true},
+		{"geo_abcdefghijklmnopqrstuvwxy", IP6Tables, false},
+		{"geo_abcdefghijklmnopqrstuvwxyz", Nftables, true},
+	}
+	for _, test := range tests {
+		r := testRules()
+		r.Name = test.name
+		var buf bytes.Buffer
+		if err := r.Write(&buf, test.format); (err == nil) != test.ok || (err != nil && buf.Len() > 0) {
+			t.Fatalf("%v %v: %v, wrote %q", test.name, test.format, err, buf.String())
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, test := range formatTests {
+		f, err := ParseFormat(test.format.String())
+		if err != nil || f != test.format {
+			t.Fatalf("%v: %v %v", test.format, f, err)
+		}
+	}
+	if _, err := ParseFormat("pf"); err == nil {
+		t.Fatal("pf")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ASCIIFold transliterates s to ASCII: letters lose their diacritics,
+// ligatures such as "æ" and "ß" are spelled out and typographic quotes
+// and dashes become their ASCII forms. Characters without an ASCII form,
+// such as those of non-Latin scripts, are removed.
+func ASCIIFold(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return asciiFold(s)
+		}
+	}
+	return s
+}
+
+func asciiFold(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			buf.WriteRune(r)
+		} else {
+			buf.WriteString(asciiFolds[r])
+		}
+	}
+	return buf.String()
+}
+
+// NormalizeKey returns a key for matching names from different sources:
+// the ASCII folded name in lower case, with every run of spaces and
+// punctuation replaced by a single space. "Le Kremlin-Bicêtre" and
+// "le kremlin bicetre" have the same key. Letters without an ASCII form,
+// such as those of non-Latin scripts, are kept in lower case, so that
+// "Москва" has the key "москва". A name without letters or digits has
+// the key "", which matches nothing.
+func NormalizeKey(s string) string {
+	var key bytes.Buffer
+	space := false
+	add := func(r rune) {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+		case 'A' <= r && r <= 'Z':
+			r += 'a' - 'A'
+		case r == '\'':
+			// "Land's End" is "lands end"
+			return
+		case r < utf8.RuneSelf:
+			space = key.Len() > 0
+			return
+		}
+		if space {
+			key.WriteByte(' ')
+			space = false
+		}
+		key.WriteRune(r)
+	}
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			add(r)
+		} else if fold, ok := asciiFolds[r]; ok {
+			for _, c := range fold {
+				add(c)
+			}
+		} else if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			add(unicode.ToLower(r))
+		} else {
+			add(' ')
+		}
+	}
+	return key.String()
+}
+
+// ASCIICity returns the city of the record transliterated to ASCII.
+func (gir *GeoIPRecord) ASCIICity() string {
+	return ASCIIFold(gir.City)
+}
+
+// CityKey returns the normalized key of the city of the record, see NormalizeKey.
+func (gir *GeoIPRecord) CityKey() string {
+	return NormalizeKey(gir.City)
+}
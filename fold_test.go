@@ -0,0 +1,71 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "testing"
+
+func TestASCIIFold(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Cape Town", "Cape Town"},
+		{"Le Kremlin-bicêtre", "Le Kremlin-bicetre"},
+		{"São Paulo", "Sao Paulo"},
+		{"Kraków", "Krakow"},
+		{"Łódź", "Lodz"},
+		{"Düsseldorf", "Dusseldorf"},
+		{"Gießen", "Giessen"},
+		{"Ærøskøbing", "AEroskobing"},
+		{"Reykjavík", "Reykjavik"},
+		{"Cần Thơ", "Can Tho"},
+		{"Dún Laoghaire", "Dun Laoghaire"},
+		{"L’Aquila", "L'Aquila"},
+		{"Москва", ""},
+	}
+	for _, test := range tests {
+		if got := ASCIIFold(test.in); got != test.want {
+			t.Fatalf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Le Kremlin-bicêtre", "le kremlin bicetre"},
+		{"LE KREMLIN BICETRE", "le kremlin bicetre"},
+		{"  St. John's ", "st johns"},
+		{"L’Aquila", "laquila"},
+		{"Winston-Salem", "winston salem"},
+		{"Москва", "москва"},
+		{"Ho Chi Minh (Сайгон)", "ho chi minh сайгон"},
+		{"東京", "東京"},
+		{" - ", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := NormalizeKey(test.in); got != test.want {
+			t.Fatalf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestCityKey(t *testing.T) {
+	gir := &GeoIPRecord{City: "Le Kremlin-bicêtre"}
+	if gir.ASCIICity() != "Le Kremlin-bicetre" || gir.CityKey() != "le kremlin bicetre" {
+		t.Fatalf("%q %q", gir.ASCIICity(), gir.CityKey())
+	}
+}
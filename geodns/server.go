@@ -0,0 +1,316 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geodns answers DNS TXT queries for reversed addresses with
+// GeoIP data, so that
+//
+//	dig +short TXT 36.226.213.196.geo.example
+//
+// prints something like
+//
+//	"country=ZA" "city=Cape Town" "asn=AS36937"
+//
+// IPv6 addresses are queried with reversed nibbles, as in ip6.arpa.
+package geodns
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jdevilliers/geoip"
+)
+
+const (
+	typeTXT  = 16
+	typeANY  = 255
+	classIN  = 1
+	classANY = 255
+
+	rcodeSuccess  = 0
+	rcodeFormat   = 1
+	rcodeNXDomain = 3
+	rcodeNotImp   = 4
+	rcodeRefused  = 5
+
+	headerLen = 12
+	udpMaxLen = 512
+)
+
+// DefaultTTL is the time to live of answers if Server.TTL is zero.
+const DefaultTTL = 3600
+
+var errIgnore = errors.New("geodns: not a query")
+
+// Server answers TXT queries for names in Zone.
+type Server struct {
+	// Zone is the domain the reversed addresses are queried under,
+	// for example "geo.example".
+	Zone     string
+	Resolver geoip.Resolver
+	// TTL is the time to live of answers in seconds.
+	TTL uint32
+	// TCPTimeout closes idle TCP connections, 10 seconds if zero.
+	TCPTimeout time.Duration
+}
+
+// ListenAndServe serves UDP and TCP on addr, for example ":53".
+// It returns when either of them fails.
+func (s *Server) ListenAndServe(addr string) error {
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	defer pc.Close()
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	errc := make(chan error, 2)
+	go func() { errc <- s.ServeUDP(pc) }()
+	go func() { errc <- s.ServeTCP(l) }()
+	return <-errc
+}
+
+// ServeUDP answers the queries read from conn until reading fails.
+func (s *Server) ServeUDP(conn net.PacketConn) error {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		resp, err := s.answer(buf[:n], udpMaxLen)
+		if err != nil {
+			continue
+		}
+		conn.WriteTo(resp, addr)
+	}
+}
+
+// ServeTCP answers the queries on the connections accepted from l until
+// accepting fails.
+func (s *Server) ServeTCP(l net.Listener) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(c)
+	}
+}
+
+func (s *Server) serveConn(c net.Conn) {
+	defer c.Close()
+	timeout := s.TCPTimeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	var length [2]byte
+	for {
+		c.SetDeadline(time.Now().Add(timeout))
+		if _, err := io.ReadFull(c, length[:]); err != nil {
+			return
+		}
+		query := make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(c, query); err != nil {
+			return
+		}
+		resp, err := s.answer(query, 65535)
+		if err != nil {
+			return
+		}
+		binary.BigEndian.PutUint16(length[:], uint16(len(resp)))
+		if _, err := c.Write(append(length[:], resp...)); err != nil {
+			return
+		}
+	}
+}
+
+// Answer returns the response to a DNS query message. It returns an
+// error for messages that must not be answered, such as responses.
+func (s *Server) Answer(query []byte) ([]byte, error) {
+	return s.answer(query, 65535)
+}
+
+func (s *Server) answer(query []byte, maxLen int) ([]byte, error) {
+	if len(query) < headerLen || query[2]&0x80 != 0 {
+		return nil, errIgnore
+	}
+	resp := make([]byte, headerLen, udpMaxLen)
+	copy(resp, query[:4])
+	// QR and AA set, opcode and RD copied
+	resp[2] = 0x80 | query[2]&0x79 | 0x04
+	resp[3] = 0
+	if opcode := query[2] >> 3 & 0xf; opcode != 0 {
+		return setRcode(resp, rcodeNotImp), nil
+	}
+	if binary.BigEndian.Uint16(query[4:]) != 1 {
+		return setRcode(resp, rcodeFormat), nil
+	}
+	labels, end, err := readName(query, headerLen)
+	if err != nil || end+4 > len(query) {
+		return setRcode(resp, rcodeFormat), nil
+	}
+	qtype := binary.BigEndian.Uint16(query[end:])
+	qclass := binary.BigEndian.Uint16(query[end+2:])
+	resp = append(resp, query[headerLen:end+4]...)
+	binary.BigEndian.PutUint16(resp[4:], 1)
+
+	zone := splitZone(s.Zone)
+	if len(labels) < len(zone) || !equalLabels(labels[len(labels)-len(zone):], zone) || qclass != classIN && qclass != classANY {
+		return setRcode(resp, rcodeRefused), nil
+	}
+	ip := reversedIP(labels[:len(labels)-len(zone)])
+	if ip == nil {
+		return setRcode(resp, rcodeNXDomain), nil
+	}
+	gir := s.Resolver.Lookup(ip)
+	if gir == nil {
+		return setRcode(resp, rcodeNXDomain), nil
+	}
+	if qtype != typeTXT && qtype != typeANY {
+		return resp, nil
+	}
+	answer := s.txtRecord(txtStrings(gir))
+	if len(resp)+len(answer) > maxLen {
+		// truncated, the client retries over TCP
+		resp[2] |= 0x02
+		return resp, nil
+	}
+	binary.BigEndian.PutUint16(resp[6:], 1)
+	return append(resp, answer...), nil
+}
+
+func setRcode(resp []byte, rcode byte) []byte {
+	resp[3] = resp[3]&0xf0 | rcode
+	return resp
+}
+
+// txtRecord returns a TXT resource record for the name of the question.
+func (s *Server) txtRecord(txt []string) []byte {
+	ttl := s.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	var rdata []byte
+	for _, t := range txt {
+		if len(t) > 255 {
+			t = t[:255]
+		}
+		rdata = append(rdata, byte(len(t)))
+		rdata = append(rdata, t...)
+	}
+	rr := make([]byte, 12, 12+len(rdata))
+	// compression pointer to the question name
+	binary.BigEndian.PutUint16(rr[0:], 0xc000|headerLen)
+	binary.BigEndian.PutUint16(rr[2:], typeTXT)
+	binary.BigEndian.PutUint16(rr[4:], classIN)
+	binary.BigEndian.PutUint32(rr[6:], ttl)
+	binary.BigEndian.PutUint16(rr[10:], uint16(len(rdata)))
+	return append(rr, rdata...)
+}
+
+func txtStrings(gir *geoip.GeoIPRecord) (txt []string) {
+	add := func(key, value string) {
+		if len(value) > 0 {
+			txt = append(txt, key+"="+value)
+		}
+	}
+	add("country", gir.CountryCode)
+	add("region", gir.Region)
+	add("city", gir.City)
+	if gir.ASNumber != 0 {
+		add("asn", fmt.Sprintf("AS%d", gir.ASNumber))
+	}
+	add("org", gir.Organization)
+	return
+}
+
+// readName reads the uncompressed name at off and returns its labels
+// and the offset after it.
+func readName(msg []byte, off int) (labels []string, end int, err error) {
+	total := 0
+	for {
+		if off >= len(msg) {
+			return nil, 0, errors.New("name out of bounds")
+		}
+		n := int(msg[off])
+		off++
+		if n == 0 {
+			return labels, off, nil
+		}
+		total += n + 1
+		if n&0xc0 != 0 || off+n > len(msg) || total > 255 {
+			return nil, 0, errors.New("invalid name")
+		}
+		labels = append(labels, string(msg[off:off+n]))
+		off += n
+	}
+}
+
+func splitZone(zone string) []string {
+	zone = strings.Trim(zone, ".")
+	if len(zone) == 0 {
+		return nil
+	}
+	return strings.Split(zone, ".")
+}
+
+func equalLabels(a, b []string) bool {
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// reversedIP parses the labels of a reversed IPv4 address, like
+// 4.3.2.1, or of a reversed IPv6 address in nibble format.
+func reversedIP(labels []string) net.IP {
+	switch len(labels) {
+	case net.IPv4len:
+		ip := make(net.IP, net.IPv4len)
+		for i, l := range labels {
+			b, err := strconv.ParseUint(l, 10, 8)
+			if err != nil {
+				return nil
+			}
+			ip[net.IPv4len-1-i] = byte(b)
+		}
+		return ip
+	case 2 * net.IPv6len:
+		ip := make(net.IP, net.IPv6len)
+		for i, l := range labels {
+			if len(l) != 1 {
+				return nil
+			}
+			b, err := strconv.ParseUint(l, 16, 4)
+			if err != nil {
+				return nil
+			}
+			pos := 2*net.IPv6len - 1 - i
+			ip[pos/2] |= byte(b) << uint(4*(1-pos%2))
+		}
+		return ip
+	}
+	return nil
+}
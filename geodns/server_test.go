@@ -0,0 +1,174 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geodns
+
+import (
+	"encoding/binary"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+var testServer = &Server{
+	Zone: "geo.example.",
+	Resolver: geoiptest.New(map[string]*geoip.GeoIPRecord{
+		"196.213.226.36": {CountryCode: "ZA", City: "Cape Town", ASNumber: 36937},
+		"2001:db8::1":    {CountryCode: "DE"},
+	}),
+}
+
+func query(name string, qtype uint16) []byte {
+	msg := []byte{0x12, 0x34, 0x01, 0x00, 0, 1, 0, 0, 0, 0, 0, 0}
+	for _, l := range strings.Split(strings.Trim(name, "."), ".") {
+		msg = append(msg, byte(len(l)))
+		msg = append(msg, l...)
+	}
+	msg = append(msg, 0, byte(qtype>>8), byte(qtype), 0, classIN)
+	return msg
+}
+
+// parseTXT returns the rcode and the strings of the first answer of resp.
+func parseTXT(t *testing.T, resp []byte) (int, []string) {
+	if resp[0] != 0x12 || resp[1] != 0x34 || resp[2]&0x80 == 0 {
+		t.Fatalf("bad header %x", resp[:4])
+	}
+	rcode := int(resp[3] & 0xf)
+	if binary.BigEndian.Uint16(resp[6:]) == 0 {
+		return rcode, nil
+	}
+	_, off, err := readName(resp, headerLen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	off += 4 + 2
+	if typ := binary.BigEndian.Uint16(resp[off:]); typ != typeTXT {
+		t.Fatalf("type %v", typ)
+	}
+	rdlen := int(binary.BigEndian.Uint16(resp[off+8:]))
+	rdata := resp[off+10:]
+	if len(rdata) != rdlen {
+		t.Fatalf("rdlength %v, have %v", rdlen, len(rdata))
+	}
+	var txt []string
+	for len(rdata) > 0 {
+		n := int(rdata[0])
+		txt = append(txt, string(rdata[1:1+n]))
+		rdata = rdata[1+n:]
+	}
+	return rcode, txt
+}
+
+func TestAnswer(t *testing.T) {
+	tests := []struct {
+		name  string
+		qtype uint16
+		rcode int
+		txt   []string
+	}{
+		{"36.226.213.196.geo.example", typeTXT, rcodeSuccess, []string{"country=ZA", "city=Cape Town", "asn=AS36937"}},
+		{"36.226.213.196.GEO.Example.", typeANY, rcodeSuccess, []string{"country=ZA", "city=Cape Town", "asn=AS36937"}},
+		{"36.226.213.196.geo.example", 1, rcodeSuccess, nil},
+		{"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.geo.example", typeTXT, rcodeSuccess, []string{"country=DE"}},
+		{"1.0.0.10.geo.example", typeTXT, rcodeNXDomain, nil},
+		{"www.geo.example", typeTXT, rcodeNXDomain, nil},
+		{"300.0.0.10.geo.example", typeTXT, rcodeNXDomain, nil},
+		{"36.226.213.196.other.example", typeTXT, rcodeRefused, nil},
+	}
+	for _, test := range tests {
+		resp, err := testServer.Answer(query(test.name, test.qtype))
+		if err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+		rcode, txt := parseTXT(t, resp)
+		if rcode != test.rcode || !reflect.DeepEqual(txt, test.txt) {
+			t.Fatalf("%v: got %v %q, want %v %q", test.name, rcode, txt, test.rcode, test.txt)
+		}
+	}
+}
+
+func TestAnswerInvalid(t *testing.T) {
+	if _, err := testServer.Answer([]byte{1, 2, 3}); err == nil {
+		t.Fatal("short message")
+	}
+	resp := query("36.226.213.196.geo.example", typeTXT)
+	resp[2] |= 0x80
+	if _, err := testServer.Answer(resp); err == nil {
+		t.Fatal("response")
+	}
+	q := query("36.226.213.196.geo.example", typeTXT)
+	resp, err := testServer.Answer(q[:len(q)-3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcode, _ := parseTXT(t, resp); rcode != rcodeFormat {
+		t.Fatalf("truncated question: %v", rcode)
+	}
+}
+
+func TestServeUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer pc.Close()
+	go testServer.ServeUDP(pc)
+	c, err := net.Dial("udp", pc.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Write(query("36.226.213.196.geo.example", typeTXT))
+	buf := make([]byte, udpMaxLen)
+	n, err := c.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, txt := parseTXT(t, buf[:n]); len(txt) != 3 {
+		t.Fatalf("%q", txt)
+	}
+}
+
+func TestServeTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer l.Close()
+	go testServer.ServeTCP(l)
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	for i := 0; i < 2; i++ {
+		q := query("36.226.213.196.geo.example", typeTXT)
+		c.Write(append([]byte{byte(len(q) >> 8), byte(len(q))}, q...))
+		buf := make([]byte, 2+udpMaxLen)
+		n, err := c.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(binary.BigEndian.Uint16(buf)) != n-2 {
+			t.Fatalf("length %v, read %v", binary.BigEndian.Uint16(buf), n)
+		}
+		if _, txt := parseTXT(t, buf[2:n]); len(txt) != 3 {
+			t.Fatalf("%q", txt)
+		}
+	}
+}
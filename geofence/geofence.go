@@ -0,0 +1,114 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geofence matches the location of addresses against named zones
+// made of polygons and circles.
+package geofence
+
+import (
+	"net"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Shape is an area on the surface of the earth.
+type Shape interface {
+	Contains(lat, lon float64) bool
+}
+
+// Polygon is a GeoJSON polygon: a list of closed rings of [longitude, latitude]
+// positions, where the first ring is the outer boundary and the others are holes.
+// Edges are straight lines in longitude and latitude, and polygons must not
+// cross the antimeridian.
+type Polygon [][][2]float64
+
+func (p Polygon) Contains(lat, lon float64) bool {
+	if len(p) == 0 || !inRing(p[0], lat, lon) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if inRing(hole, lat, lon) {
+			return false
+		}
+	}
+	return true
+}
+
+// inRing reports whether the point is inside ring using the even-odd rule.
+func inRing(ring [][2]float64, lat, lon float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+	return in
+}
+
+// MultiPolygon is the union of its polygons.
+type MultiPolygon []Polygon
+
+func (m MultiPolygon) Contains(lat, lon float64) bool {
+	for _, p := range m {
+		if p.Contains(lat, lon) {
+			return true
+		}
+	}
+	return false
+}
+
+// Circle is the area within Radius kilometres of a point.
+type Circle struct {
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+}
+
+func (c *Circle) Contains(lat, lon float64) bool {
+	return geoip.Haversine(c.Latitude, c.Longitude, lat, lon) <= c.Radius
+}
+
+// Zone is a named shape.
+type Zone struct {
+	Name  string
+	Shape Shape
+}
+
+// Fence matches addresses against a list of zones.
+type Fence struct {
+	Resolver geoip.Resolver
+	Zones    []*Zone
+}
+
+// Match returns the names of the zones that contain the location of ip,
+// in the order of Zones. It returns nil if the address has no coordinates.
+func (f *Fence) Match(ip net.IP) []string {
+	gir := f.Resolver.Lookup(ip)
+	if !gir.HasLocation() {
+		return nil
+	}
+	return f.MatchLocation(gir.Latitude, gir.Longitude)
+}
+
+// MatchLocation returns the names of the zones that contain the point.
+func (f *Fence) MatchLocation(lat, lon float64) (names []string) {
+	for _, z := range f.Zones {
+		if z.Shape.Contains(lat, lon) {
+			names = append(names, z.Name)
+		}
+	}
+	return
+}
@@ -0,0 +1,107 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+const testZones = `{
+	"type": "FeatureCollection",
+	"features": [
+		{
+			"type": "Feature",
+			"properties": {"name": "western-cape"},
+			"geometry": {
+				"type": "Polygon",
+				"coordinates": [
+					[[17, -35], [24, -35], [24, -31], [17, -31], [17, -35]],
+					[[18.3, -34.1], [18.6, -34.1], [18.6, -33.8], [18.3, -33.8], [18.3, -34.1]]
+				]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"name": "benelux"},
+			"geometry": {
+				"type": "MultiPolygon",
+				"coordinates": [
+					[[[3, 50.7], [7.2, 50.7], [7.2, 53.6], [3, 53.6], [3, 50.7]]],
+					[[[2.5, 49.5], [6.4, 49.5], [6.4, 51.5], [2.5, 51.5], [2.5, 49.5]]]
+				]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"name": "stellenbosch", "radius": 30000},
+			"geometry": {"type": "Point", "coordinates": [18.86, -33.93]}
+		}
+	]
+}`
+
+func TestFence(t *testing.T) {
+	zones, err := ReadGeoJSON(strings.NewReader(testZones), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &Fence{
+		Resolver: geoiptest.New(map[string]*geoip.GeoIPRecord{
+			"1.0.0.1": {City: "Cape Town", Latitude: -33.92, Longitude: 18.42},
+			"1.0.0.2": {City: "Paarl", Latitude: -33.73, Longitude: 18.97},
+			"1.0.0.3": {City: "Brussels", Latitude: 50.85, Longitude: 4.35},
+			"1.0.0.4": {City: "Amsterdam", Latitude: 52.37, Longitude: 4.90},
+			"1.0.0.5": {City: "Johannesburg", Latitude: -26.20, Longitude: 28.05},
+			"1.0.0.6": {CountryCode: "EU"},
+		}),
+		Zones: zones,
+	}
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{"1.0.0.1", ""}, // in the hole
+		{"1.0.0.2", "western-cape,stellenbosch"},
+		{"1.0.0.3", "benelux"},
+		{"1.0.0.4", "benelux"},
+		{"1.0.0.5", ""},
+		{"1.0.0.6", ""},
+		{"10.0.0.1", ""},
+	}
+	for _, test := range tests {
+		if got := strings.Join(f.Match(net.ParseIP(test.ip)), ","); got != test.want {
+			t.Fatalf("%v: got %q, want %q", test.ip, got, test.want)
+		}
+	}
+}
+
+func TestReadGeoJSONErrors(t *testing.T) {
+	tests := []string{
+		`{"type": "Point", "coordinates": [0, 0]}`,
+		`{"type": "Feature", "properties": {}, "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 1], [0, 0]]]}}`,
+		`{"type": "Feature", "properties": {"name": "a"}, "geometry": {"type": "Point", "coordinates": [0, 0]}}`,
+		`{"type": "Feature", "properties": {"name": "a"}, "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 0]]]}}`,
+		`{"type": "Feature", "properties": {"name": "a"}, "geometry": {"type": "LineString", "coordinates": [[0, 0], [1, 0]]}}`,
+	}
+	for i, test := range tests {
+		if _, err := ReadGeoJSON(strings.NewReader(test), ""); err == nil {
+			t.Fatalf("%v: no error", i)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type geoJSON struct {
+	Type       string                 `json:"type"`
+	Features   []*geoJSON             `json:"features"`
+	Geometry   *geoJSON               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+	// Coordinates is decoded once the type of the geometry is known.
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// ReadGeoJSON reads the zones of a GeoJSON FeatureCollection or Feature.
+// Every feature is a zone whose name is the property nameProperty, or
+// "name" if it is empty. Polygon and MultiPolygon geometries are used as
+// they are. GeoJSON has no circles, so a Point feature is a circle if it
+// has a numeric "radius" property, which is in metres.
+func ReadGeoJSON(r io.Reader, nameProperty string) ([]*Zone, error) {
+	if nameProperty == "" {
+		nameProperty = "name"
+	}
+	var doc geoJSON
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	var features []*geoJSON
+	switch doc.Type {
+	case "FeatureCollection":
+		features = doc.Features
+	case "Feature":
+		features = []*geoJSON{&doc}
+	default:
+		return nil, fmt.Errorf("geojson: unsupported type %q", doc.Type)
+	}
+	zones := make([]*Zone, 0, len(features))
+	for i, f := range features {
+		if f.Type != "Feature" || f.Geometry == nil {
+			return nil, fmt.Errorf("geojson: feature %v has no geometry", i)
+		}
+		name, _ := f.Properties[nameProperty].(string)
+		if name == "" {
+			return nil, fmt.Errorf("geojson: feature %v has no %q property", i, nameProperty)
+		}
+		shape, err := f.shape()
+		if err != nil {
+			return nil, fmt.Errorf("geojson: feature %q: %v", name, err)
+		}
+		zones = append(zones, &Zone{Name: name, Shape: shape})
+	}
+	return zones, nil
+}
+
+func (f *geoJSON) shape() (Shape, error) {
+	g := f.Geometry
+	switch g.Type {
+	case "Polygon":
+		var p Polygon
+		if err := json.Unmarshal(g.Coordinates, &p); err != nil {
+			return nil, err
+		}
+		return p, checkPolygon(p)
+	case "MultiPolygon":
+		var m MultiPolygon
+		if err := json.Unmarshal(g.Coordinates, &m); err != nil {
+			return nil, err
+		}
+		for _, p := range m {
+			if err := checkPolygon(p); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case "Point":
+		var pos [2]float64
+		if err := json.Unmarshal(g.Coordinates, &pos); err != nil {
+			return nil, err
+		}
+		radius, ok := f.Properties["radius"].(float64)
+		if !ok || radius <= 0 {
+			return nil, fmt.Errorf("point without a radius")
+		}
+		return &Circle{Latitude: pos[1], Longitude: pos[0], Radius: radius / 1000}, nil
+	}
+	return nil, fmt.Errorf("unsupported geometry %q", g.Type)
+}
+
+func checkPolygon(p Polygon) error {
+	if len(p) == 0 {
+		return fmt.Errorf("empty polygon")
+	}
+	for _, ring := range p {
+		if len(ring) < 4 {
+			return fmt.Errorf("polygon ring with %v positions", len(ring))
+		}
+	}
+	return nil
+}
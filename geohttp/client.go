@@ -0,0 +1,139 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geohttp
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies are the networks of the proxies whose forwarding
+// headers are believed.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses a list of CIDR networks or single addresses.
+func ParseTrustedProxies(addrs ...string) (TrustedProxies, error) {
+	p := make(TrustedProxies, 0, len(addrs))
+	for _, addr := range addrs {
+		if !strings.Contains(addr, "/") {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address %q", addr)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			p = append(p, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, n)
+	}
+	return p, nil
+}
+
+// Contains reports whether ip is the address of a trusted proxy.
+func (p TrustedProxies) Contains(ip net.IP) bool {
+	for _, n := range p {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the client that made r.
+//
+// The forwarding headers are only used when the connection comes from
+// a trusted proxy. The chain of addresses in the Forwarded header, or
+// in X-Forwarded-For if there is no Forwarded header, is followed from
+// the right, and the first address that is not a trusted proxy is the
+// client. X-Real-IP is used when neither header is present.
+// ClientIP returns nil if the remote address of r cannot be parsed.
+func (p TrustedProxies) ClientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !p.Contains(ip) {
+		return ip
+	}
+	chain := forwardedFor(r.Header)
+	if chain == nil {
+		chain = splitList(r.Header["X-Forwarded-For"])
+	}
+	if chain == nil {
+		if realIP := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+			return realIP
+		}
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		hop := parseHop(chain[i])
+		if hop == nil {
+			// obfuscated or malformed, nothing further left can be trusted
+			break
+		}
+		ip = hop
+		if !p.Contains(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+// forwardedFor returns the for= addresses of the Forwarded headers in h,
+// see RFC 7239.
+func forwardedFor(h http.Header) (chain []string) {
+	for _, elem := range splitList(h["Forwarded"]) {
+		for _, pair := range strings.Split(elem, ";") {
+			kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(kv) == 2 && strings.EqualFold(kv[0], "for") {
+				chain = append(chain, strings.Trim(kv[1], `"`))
+			}
+		}
+	}
+	return
+}
+
+// splitList splits comma separated header values.
+func splitList(values []string) (list []string) {
+	for _, v := range values {
+		for _, elem := range strings.Split(v, ",") {
+			if elem = strings.TrimSpace(elem); len(elem) > 0 {
+				list = append(list, elem)
+			}
+		}
+	}
+	return
+}
+
+// parseHop parses an address that can have a port and square brackets,
+// like "192.0.2.60", "192.0.2.60:4711" or "[2001:db8::17]:4711".
+func parseHop(s string) net.IP {
+	if ip := net.ParseIP(s); ip != nil {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		return net.ParseIP(host)
+	}
+	return net.ParseIP(strings.Trim(s, "[]"))
+}
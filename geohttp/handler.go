@@ -0,0 +1,167 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geohttp serves GeoIP lookups as JSON over HTTP.
+package geohttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// DefaultMaxBatch is the number of addresses a single batched lookup may
+// contain if Handler.MaxBatch is zero.
+const DefaultMaxBatch = 100
+
+// Handler serves the following endpoints:
+//
+//	/lookup/{ip}         the record of a single address
+//	/lookup?ip=a,b&ip=c  the records of several addresses, as a list
+//	/info                the databases in use
+//
+// The address "me" stands for the address of the client.
+type Handler struct {
+	Resolver geoip.Resolver
+	// Databases are the databases described at /info.
+	Databases []*geoip.GeoIP
+	// MaxBatch limits the number of addresses in a batched lookup.
+	MaxBatch int
+	// Proxies are the proxies trusted to report the address that
+	// "me" stands for, see TrustedProxies.ClientIP.
+	Proxies TrustedProxies
+}
+
+// NewHandler returns a Handler that merges the records of dbs.
+func NewHandler(dbs ...*geoip.GeoIP) *Handler {
+	return &Handler{Resolver: geoip.Multi(dbs), Databases: dbs}
+}
+
+// Result is the response for a single address.
+type Result struct {
+	IP    string `json:"ip"`
+	Found bool   `json:"found"`
+	Error string `json:"error,omitempty"`
+	*geoip.GeoIPRecord
+}
+
+// DatabaseInfo is the description of a database served at /info.
+type DatabaseInfo struct {
+	Info        string `json:"info"`
+	Edition     int    `json:"edition"`
+	Description string `json:"description"`
+	Created     string `json:"created,omitempty"`
+	IPv6        bool   `json:"ipv6"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeJSON(w, http.StatusMethodNotAllowed, &Result{Error: "method not allowed"})
+		return
+	}
+	switch path := r.URL.Path; {
+	case path == "/info":
+		h.serveInfo(w, r)
+	case path == "/lookup":
+		h.serveBatch(w, r)
+	case strings.HasPrefix(path, "/lookup/"):
+		h.serveLookup(w, r, strings.TrimPrefix(path, "/lookup/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) serveLookup(w http.ResponseWriter, r *http.Request, addr string) {
+	res := h.lookup(r, addr)
+	switch {
+	case len(res.Error) > 0:
+		writeJSON(w, http.StatusBadRequest, res)
+	case !res.Found:
+		writeJSON(w, http.StatusNotFound, res)
+	default:
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+func (h *Handler) serveBatch(w http.ResponseWriter, r *http.Request) {
+	var addrs []string
+	for _, v := range r.URL.Query()["ip"] {
+		for _, addr := range strings.Split(v, ",") {
+			if addr = strings.TrimSpace(addr); len(addr) > 0 {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	max := h.MaxBatch
+	if max == 0 {
+		max = DefaultMaxBatch
+	}
+	switch {
+	case len(addrs) == 0:
+		writeJSON(w, http.StatusBadRequest, &Result{Error: "missing ip parameter"})
+		return
+	case len(addrs) > max:
+		writeJSON(w, http.StatusBadRequest, &Result{Error: fmt.Sprintf("too many addresses, the maximum is %v", max)})
+		return
+	}
+	results := make([]*Result, len(addrs))
+	for i, addr := range addrs {
+		results[i] = h.lookup(r, addr)
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (h *Handler) lookup(r *http.Request, addr string) *Result {
+	var ip net.IP
+	if addr == "me" {
+		ip = h.Proxies.ClientIP(r)
+		if c, ok := FromContext(r.Context()); ok {
+			ip = c.IP
+		}
+	} else {
+		ip = net.ParseIP(addr)
+	}
+	if ip == nil {
+		return &Result{IP: addr, Error: "invalid address"}
+	}
+	gir := h.Resolver.Lookup(ip)
+	return &Result{IP: ip.String(), Found: gir != nil, GeoIPRecord: gir}
+}
+
+func (h *Handler) serveInfo(w http.ResponseWriter, r *http.Request) {
+	infos := make([]*DatabaseInfo, len(h.Databases))
+	for i, gi := range h.Databases {
+		infos[i] = &DatabaseInfo{
+			Info:        gi.DatabaseInfo(),
+			Edition:     gi.DatabaseEdition(),
+			Description: gi.DatabaseDescription(),
+			IPv6:        gi.IsIPv6Database(),
+		}
+		if t, err := gi.DatabaseCreateTime(); err == nil {
+			infos[i].Created = t.Format("2006-01-02")
+		}
+	}
+	writeJSON(w, http.StatusOK, infos)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,129 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geohttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+var testResolver = geoiptest.Countries(map[string]string{
+	"196.213.226.36": "ZA",
+	"194.247.30.31":  "NL",
+	"2001:db8::1":    "DE",
+})
+
+func serve(h http.Handler, target string, header map[string]string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest("GET", target, nil)
+	r.RemoteAddr = "196.213.226.36:41234"
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestLookup(t *testing.T) {
+	h := &Handler{Resolver: testResolver}
+	tests := []struct {
+		target string
+		header map[string]string
+		status int
+		ip     string
+		code   string
+	}{
+		{"/lookup/194.247.30.31", nil, 200, "194.247.30.31", "NL"},
+		{"/lookup/2001:db8::1", nil, 200, "2001:db8::1", "DE"},
+		{"/lookup/10.0.0.1", nil, 404, "10.0.0.1", ""},
+		{"/lookup/nonsense", nil, 400, "nonsense", ""},
+		{"/lookup/me", nil, 200, "196.213.226.36", "ZA"},
+		{"/lookup/me", map[string]string{"X-Forwarded-For": "194.247.30.31"}, 200, "196.213.226.36", "ZA"},
+	}
+	for _, test := range tests {
+		w := serve(h, test.target, test.header)
+		if w.Code != test.status {
+			t.Fatalf("%v: status %v", test.target, w.Code)
+		}
+		var res Result
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatalf("%v: %v", test.target, err)
+		}
+		if res.IP != test.ip {
+			t.Fatalf("%v: ip %v", test.target, res.IP)
+		}
+		if res.GeoIPRecord != nil && res.CountryCode != test.code {
+			t.Fatalf("%v: country %v", test.target, res.CountryCode)
+		}
+	}
+}
+
+func TestLookupForwarded(t *testing.T) {
+	proxies, _ := ParseTrustedProxies("196.213.226.0/24")
+	h := &Handler{Resolver: testResolver, Proxies: proxies}
+	w := serve(h, "/lookup/me", map[string]string{"X-Forwarded-For": "10.0.0.1, 194.247.30.31"})
+	var res Result
+	json.Unmarshal(w.Body.Bytes(), &res)
+	if res.IP != "194.247.30.31" || res.CountryCode != "NL" {
+		t.Fatalf("%v", w.Body)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	h := &Handler{Resolver: testResolver, MaxBatch: 4}
+	w := serve(h, "/lookup?ip=196.213.226.36,10.0.0.1&ip=bad&ip=me", nil)
+	if w.Code != 200 {
+		t.Fatalf("status %v", w.Code)
+	}
+	var results []*Result
+	if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("%v", w.Body)
+	}
+	if !results[0].Found || results[0].CountryCode != "ZA" {
+		t.Fatal("1")
+	}
+	if results[1].Found {
+		t.Fatal("2")
+	}
+	if len(results[2].Error) == 0 {
+		t.Fatal("3")
+	}
+	if results[3].IP != "196.213.226.36" {
+		t.Fatal("4")
+	}
+	if w := serve(h, "/lookup?ip=1.1.1.1,2.2.2.2,3.3.3.3,4.4.4.4,5.5.5.5", nil); w.Code != 400 {
+		t.Fatalf("max batch: %v", w.Code)
+	}
+	if w := serve(h, "/lookup", nil); w.Code != 400 {
+		t.Fatalf("no addresses: %v", w.Code)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	w := serve(&Handler{Resolver: testResolver}, "/info", nil)
+	if w.Code != 200 || w.Body.String() != "[]\n" {
+		t.Fatalf("%v %v", w.Code, w.Body)
+	}
+	if w := serve(&Handler{Resolver: testResolver}, "/other", nil); w.Code != 404 {
+		t.Fatalf("%v", w.Code)
+	}
+}
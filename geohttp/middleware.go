@@ -0,0 +1,61 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geohttp
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Client is the address of the client of a request and its record.
+// Record is nil if the address is not in the databases.
+type Client struct {
+	IP     net.IP
+	Record *geoip.GeoIPRecord
+}
+
+type contextKey int
+
+const clientKey contextKey = 0
+
+// NewContext returns a copy of ctx that carries c.
+func NewContext(ctx context.Context, c *Client) context.Context {
+	return context.WithValue(ctx, clientKey, c)
+}
+
+// FromContext returns the client stored in ctx by Middleware, if any.
+func FromContext(ctx context.Context) (*Client, bool) {
+	c, ok := ctx.Value(clientKey).(*Client)
+	return c, ok
+}
+
+// Middleware looks up the client address of every request, as returned
+// by proxies.ClientIP, and passes the request to next with the result
+// stored in its context. Requests whose client address cannot be
+// determined are passed on without a Client.
+func Middleware(res geoip.Resolver, proxies TrustedProxies, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := proxies.ClientIP(r)
+		if ip == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		c := &Client{IP: ip, Record: res.Lookup(ip)}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), c)))
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geohttp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+func TestClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/8", "2001:db8::/32", "192.0.2.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remote string
+		header map[string]string
+		want   string
+	}{
+		{"196.213.226.36:1234", nil, "196.213.226.36"},
+		{"196.213.226.36:1234", map[string]string{"X-Forwarded-For": "194.247.30.31"}, "196.213.226.36"},
+		{"10.1.1.1:1234", map[string]string{"X-Forwarded-For": "194.247.30.31"}, "194.247.30.31"},
+		{"10.1.1.1:1234", map[string]string{"X-Forwarded-For": "1.1.1.1, 194.247.30.31, 10.2.2.2"}, "194.247.30.31"},
+		{"10.1.1.1:1234", map[string]string{"X-Forwarded-For": "10.3.3.3, 10.2.2.2"}, "10.3.3.3"},
+		{"10.1.1.1:1234", map[string]string{"X-Forwarded-For": "garbage, 10.2.2.2"}, "10.2.2.2"},
+		{"10.1.1.1:1234", map[string]string{"X-Real-IP": "194.247.30.31"}, "194.247.30.31"},
+		{"192.0.2.1:1234", map[string]string{"Forwarded": `for=194.247.30.31;proto=https, for="[2001:db8::17]:4711"`}, "194.247.30.31"},
+		{"192.0.2.1:1234", map[string]string{"Forwarded": `for=_hidden`, "X-Forwarded-For": "194.247.30.31"}, "192.0.2.1"},
+		{"192.0.2.2:1234", map[string]string{"X-Real-IP": "194.247.30.31"}, "192.0.2.2"},
+		{"[2001:db8::1]:1234", map[string]string{"X-Forwarded-For": "2a00:1450::1"}, "2a00:1450::1"},
+	}
+	for i, test := range tests {
+		r, _ := http.NewRequest("GET", "/", nil)
+		r.RemoteAddr = test.remote
+		for k, v := range test.header {
+			r.Header.Set(k, v)
+		}
+		if ip := proxies.ClientIP(r); ip.String() != test.want {
+			t.Fatalf("%v: got %v, want %v", i, ip, test.want)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var got *Client
+	h := Middleware(testResolver, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = FromContext(r.Context())
+	}))
+	serve(h, "/", nil)
+	if got == nil || got.IP.String() != "196.213.226.36" || got.Record.CountryCode != "ZA" {
+		t.Fatalf("%+v", got)
+	}
+
+	proxies, _ := ParseTrustedProxies("196.213.226.0/24")
+	h = Middleware(testResolver, proxies, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = FromContext(r.Context())
+	}))
+	serve(h, "/", map[string]string{"X-Forwarded-For": "10.0.0.1"})
+	if got == nil || got.IP.String() != "10.0.0.1" || got.Record != nil {
+		t.Fatalf("%+v", got)
+	}
+}
+
+func TestRestrict(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	policy := &geoip.Policy{AllowCountries: []string{"ZA"}, Default: geoip.Deny}
+	if w := serve(Restrict(policy, testResolver, nil, ok), "/", nil); w.Code != 200 {
+		t.Fatalf("1: %v", w.Code)
+	}
+	policy.AllowCountries = []string{"NL"}
+	if w := serve(Restrict(policy, testResolver, nil, ok), "/", nil); w.Code != 403 {
+		t.Fatalf("2: %v", w.Code)
+	}
+	// the record stored by Middleware is used
+	h := Middleware(geoiptest.Countries(map[string]string{"196.213.226.36": "NL"}), nil, Restrict(policy, testResolver, nil, ok))
+	if w := serve(h, "/", nil); w.Code != 200 {
+		t.Fatalf("3: %v", w.Code)
+	}
+}
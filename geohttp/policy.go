@@ -0,0 +1,42 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geohttp
+
+import (
+	"net/http"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Restrict passes the requests that policy allows to next and answers the
+// others with 403 Forbidden. The client address is taken from the Client
+// stored by Middleware if there is one, otherwise it is found with
+// proxies.ClientIP and looked up with res. Requests without a client
+// address get the Unknown action.
+func Restrict(policy *geoip.Policy, res geoip.Resolver, proxies TrustedProxies, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := policy.Unknown
+		if c, ok := FromContext(r.Context()); ok {
+			action = policy.Decide(c.Record)
+		} else if ip := proxies.ClientIP(r); ip != nil {
+			action = policy.Decide(res.Lookup(ip))
+		}
+		if action != geoip.Allow {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
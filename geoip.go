@@ -12,19 +12,35 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Package geoip looks up the locations of addresses in GeoIP databases
+// with libGeoIP, and describes the countries and subdivisions it returns.
 package geoip
 
+import (
+	"net"
+)
+
 type GeoIPRecord struct {
-	CountryCode   string
-	CountryCode3  string
-	CountryName   string
-	Region        string
-	City          string
-	PostalCode    string
-	Latitude      float64
-	Longitude     float64
-	AreaCode      int
-	ContinentCode string
+	CountryCode   string  `json:"country_code,omitempty"`
+	CountryCode3  string  `json:"country_code3,omitempty"`
+	CountryName   string  `json:"country_name,omitempty"`
+	Region        string  `json:"region,omitempty"`
+	City          string  `json:"city,omitempty"`
+	PostalCode    string  `json:"postal_code,omitempty"`
+	Latitude      float64 `json:"latitude,omitempty"`
+	Longitude     float64 `json:"longitude,omitempty"`
+	AreaCode      int     `json:"area_code,omitempty"`
+	ContinentCode string  `json:"continent_code,omitempty"`
+	// ASNumber and Organization are only set by ASN, organization
+	// and ISP databases.
+	ASNumber     int    `json:"as_number,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	// SubdivisionCode and SubdivisionName are the ISO 3166-2 subdivision
+	// of Region, if it is known. See SubdivisionByRegion.
+	SubdivisionCode string `json:"subdivision_code,omitempty"`
+	SubdivisionName string `json:"subdivision_name,omitempty"`
+	// Anonymized is set on records coarsened by an Anonymizer.
+	Anonymized bool `json:"anonymized,omitempty"`
 }
 
 //ISO_8859-1 to UTF8
@@ -36,3 +52,12 @@ func latin1toUTF8(latin1Buf []byte) string {
 	}
 	return string(buf)
 }
+
+// ipv6String formats ip for the libGeoIP IPv6 lookup functions, which do
+// not accept IPv4 addresses in dotted notation.
+func ipv6String(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return "::ffff:" + ip4.String()
+	}
+	return ip.String()
+}
@@ -29,6 +29,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 	"unsafe"
@@ -143,9 +144,35 @@ func (gi *GeoIP) IsCityDatabase() bool {
 	return false
 }
 
+// IsOrgDatabase reports whether the database maps addresses to
+// organization names, which is the case for ASN, organization and ISP editions.
+func (gi *GeoIP) IsOrgDatabase() bool {
+	switch gi.edition {
+	case ASNUM_EDITION,
+		ASNUM_EDITION_V6,
+		ORG_EDITION,
+		ORG_EDITION_V6,
+		ISP_EDITION,
+		ISP_EDITION_V6:
+		return true
+	}
+	return false
+}
+
+func (gi *GeoIP) isASNumDatabase() bool {
+	return gi.edition == ASNUM_EDITION || gi.edition == ASNUM_EDITION_V6
+}
+
+// GeoIP is a database handle. It is safe for concurrent lookups, but
+// Delete must not be called while other calls are in progress. Record
+// lookups in city databases are serialized because libGeoIP keeps their
+// netmask in the handle; use a Pool to run them in parallel.
 type GeoIP struct {
 	gi      *C.GeoIP
 	edition int
+	charset Charset
+	// mu guards the calls that set or read the netmask of the handle.
+	mu sync.Mutex
 }
 
 func checkedCString(goVal string) *C.char {
@@ -157,16 +184,30 @@ func checkedCString(goVal string) *C.char {
 }
 
 func New() (gi *GeoIP, err error) {
+	return NewWithOptions(nil)
+}
+
+// NewWithOptions is like New, but sets the options of the handle.
+// A nil opts is the same as New.
+func NewWithOptions(opts *Options) (gi *GeoIP, err error) {
 	gi = new(GeoIP)
 	gi.gi = C.GeoIP_new(C.GEOIP_MEMORY_CACHE)
 	if gi.gi == nil {
 		err = errors.New("GeoIP_new failed")
 		return
 	}
+	gi.edition = gi.DatabaseEdition()
+	gi.setOptions(opts)
 	return
 }
 
 func Open(filename string) (gi *GeoIP, err error) {
+	return OpenWithOptions(filename, nil)
+}
+
+// OpenWithOptions is like Open, but sets the options of the handle.
+// A nil opts is the same as Open.
+func OpenWithOptions(filename string, opts *Options) (gi *GeoIP, err error) {
 	cFilename := checkedCString(filename)
 	defer C.free(unsafe.Pointer(cFilename))
 	gi = new(GeoIP)
@@ -176,21 +217,45 @@ func Open(filename string) (gi *GeoIP, err error) {
 		return
 	}
 	gi.edition = gi.DatabaseEdition()
+	gi.setOptions(opts)
 	return
 }
 
+func (gi *GeoIP) setOptions(opts *Options) {
+	if opts != nil && opts.Charset != CharsetLatin1 {
+		C.GeoIP_set_charset(gi.gi, C.int(opts.Charset))
+		gi.charset = Charset(C.GeoIP_charset(gi.gi))
+	}
+}
+
+// Charset returns the charset of the handle.
+func (gi *GeoIP) Charset() Charset {
+	return gi.charset
+}
+
 func (gi *GeoIP) DatabaseInfo() string {
 	// this call returns a newly allocated CString
 	info := C.GeoIP_database_info(gi.gi)
 	defer C.free(unsafe.Pointer(info))
 	goinfo := C.GoString(info)
-	return goinfo
+	return toUTF8(goinfo, CharsetLatin1)
 }
 
 func (gi *GeoIP) DatabaseEdition() (code int) {
 	return int(C.GeoIP_database_edition(gi.gi))
 }
 
+// DatabaseDescription returns the name libGeoIP uses for the database
+// edition, for example "GeoIP Country Edition".
+func (gi *GeoIP) DatabaseDescription() string {
+	edition := gi.DatabaseEdition()
+	if edition < 0 || edition >= len(C.GeoIPDBDescription) {
+		return ""
+	}
+	// this is a static CString
+	return C.GoString(C.GeoIPDBDescription[edition])
+}
+
 func (gi *GeoIP) DatabaseCreateTime() (time.Time, error) {
 	info := gi.DatabaseInfo()
 	infos := strings.Split(info, " ")
@@ -224,15 +289,17 @@ func (gi *GeoIP) CountryCodeByIPv4(ip net.IP) (code string) {
 }
 
 func (gi *GeoIP) CountryCodeByIPNum(ipnum uint32) (code string) {
+	var gl C.GeoIPLookup
 	// this call returns a static CString
-	return C.GoString(C.GeoIP_country_code_by_ipnum(gi.gi, C.ulong(ipnum)))
+	return C.GoString(C.GeoIP_country_code_by_ipnum_gl(gi.gi, C.ulong(ipnum), &gl))
 }
 
 func (gi *GeoIP) CountryCodeByIPv6(ip net.IP) (code string) {
 	cip := checkedCString(ip.String())
 	defer C.free(unsafe.Pointer(cip))
+	var gl C.GeoIPLookup
 	// this call returns a static CString
-	return C.GoString(C.GeoIP_country_code_by_name_v6(gi.gi, cip))
+	return C.GoString(C.GeoIP_country_code_by_name_v6_gl(gi.gi, cip, &gl))
 }
 
 func (gi *GeoIP) CountryCode3ByIPv4(ip net.IP) (code3 string) {
@@ -240,8 +307,9 @@ func (gi *GeoIP) CountryCode3ByIPv4(ip net.IP) (code3 string) {
 }
 
 func (gi *GeoIP) CountryCode3ByIPNum(ipnum uint32) (code3 string) {
+	var gl C.GeoIPLookup
 	// this call returns a static CString
-	return C.GoString(C.GeoIP_country_code3_by_ipnum(gi.gi, C.ulong(ipnum)))
+	return C.GoString(C.GeoIP_country_code3_by_ipnum_gl(gi.gi, C.ulong(ipnum), &gl))
 }
 
 func (gi *GeoIP) CountryNameByIPv4(ip net.IP) (name string) {
@@ -249,8 +317,9 @@ func (gi *GeoIP) CountryNameByIPv4(ip net.IP) (name string) {
 }
 
 func (gi *GeoIP) CountryNameByIPNum(ipnum uint32) (name string) {
+	var gl C.GeoIPLookup
 	// this call returns a static CString
-	return C.GoString(C.GeoIP_country_name_by_ipnum(gi.gi, C.ulong(ipnum)))
+	return toUTF8(C.GoString(C.GeoIP_country_name_by_ipnum_gl(gi.gi, C.ulong(ipnum), &gl)), gi.charset)
 }
 
 func (gi *GeoIP) RecordByIPv4(ip net.IP) (gir *GeoIPRecord) {
@@ -258,57 +327,142 @@ func (gi *GeoIP) RecordByIPv4(ip net.IP) (gir *GeoIPRecord) {
 }
 
 func (gi *GeoIP) RecordByIPNum(ipnum uint32) (gir *GeoIPRecord) {
+	gi.mu.Lock()
 	cGir := C.GeoIP_record_by_ipnum(gi.gi, C.ulong(ipnum))
+	gi.mu.Unlock()
 	if cGir == nil {
 		return nil
 	}
 	// this call frees all the CStrings in cGir
 	defer C.GeoIPRecord_delete(cGir)
+	return gi.newGeoIPRecord(cGir)
+}
+
+func (gi *GeoIP) newGeoIPRecord(cGir *C.GeoIPRecord) (gir *GeoIPRecord) {
 	gir = new(GeoIPRecord)
 	gir.CountryCode = C.GoString(cGir.country_code)
 	gir.CountryCode3 = C.GoString(cGir.country_code3)
-	gir.CountryName = C.GoString(cGir.country_name)
-	gir.Region = C.GoString(cGir.region)
-	gir.City = latin1toUTF8([]byte(C.GoString(cGir.city)))
-	gir.PostalCode = C.GoString(cGir.postal_code)
+	gir.CountryName = toUTF8(C.GoString(cGir.country_name), gi.charset)
+	gir.Region = toUTF8(C.GoString(cGir.region), gi.charset)
+	gir.City = toUTF8(C.GoString(cGir.city), gi.charset)
+	gir.PostalCode = toUTF8(C.GoString(cGir.postal_code), gi.charset)
 	gir.Latitude = float64(cGir.latitude)
 	gir.Longitude = float64(cGir.longitude)
 	gir.AreaCode = int(cGir.area_code)
 	gir.ContinentCode = C.GoString(cGir.continent_code)
+	gir.setSubdivision()
 	return
 }
 
 func (gi *GeoIP) CityByIPNum(ipnum uint32) string {
+	gi.mu.Lock()
 	cGir := C.GeoIP_record_by_ipnum(gi.gi, C.ulong(ipnum))
+	gi.mu.Unlock()
 	if cGir == nil {
 		return ""
 	}
 	// this call frees all the CStrings in cGir
 	defer C.GeoIPRecord_delete(cGir)
-	return latin1toUTF8([]byte(C.GoString(cGir.city)))
+	return toUTF8(C.GoString(cGir.city), gi.charset)
 }
 
 func (gi *GeoIP) RecordByIPv6(ip net.IP) (gir *GeoIPRecord) {
 	cip := checkedCString(ip.String())
 	defer C.free(unsafe.Pointer(cip))
+	gi.mu.Lock()
 	cGir := C.GeoIP_record_by_addr_v6(gi.gi, cip)
+	gi.mu.Unlock()
 	if cGir == nil {
 		return nil
 	}
 	// this call frees all the CStrings in cGir
 	defer C.GeoIPRecord_delete(cGir)
-	gir = new(GeoIPRecord)
-	gir.CountryCode = C.GoString(cGir.country_code)
-	gir.CountryCode3 = C.GoString(cGir.country_code3)
-	gir.CountryName = C.GoString(cGir.country_name)
-	gir.Region = C.GoString(cGir.region)
-	gir.City = latin1toUTF8([]byte(C.GoString(cGir.city)))
-	gir.PostalCode = C.GoString(cGir.postal_code)
-	gir.Latitude = float64(cGir.latitude)
-	gir.Longitude = float64(cGir.longitude)
-	gir.AreaCode = int(cGir.area_code)
-	gir.ContinentCode = C.GoString(cGir.continent_code)
-	return
+	return gi.newGeoIPRecord(cGir)
+}
+
+func (gi *GeoIP) OrgByIPv4(ip net.IP) (name string) {
+	return gi.OrgByIPNum(binary.BigEndian.Uint32(ip.To4()))
+}
+
+func (gi *GeoIP) OrgByIPNum(ipnum uint32) (name string) {
+	var gl C.GeoIPLookup
+	// this call returns a newly allocated CString
+	cName := C.GeoIP_name_by_ipnum_gl(gi.gi, C.ulong(ipnum), &gl)
+	defer C.free(unsafe.Pointer(cName))
+	return toUTF8(C.GoString(cName), gi.charset)
+}
+
+func (gi *GeoIP) OrgByIPv6(ip net.IP) (name string) {
+	cip := checkedCString(ipv6String(ip))
+	defer C.free(unsafe.Pointer(cip))
+	var gl C.GeoIPLookup
+	// this call returns a newly allocated CString
+	cName := C.GeoIP_name_by_addr_v6_gl(gi.gi, cip, &gl)
+	defer C.free(unsafe.Pointer(cName))
+	return toUTF8(C.GoString(cName), gi.charset)
+}
+
+// lookupRange returns the record for ip together with the netmask of the
+// database node it was found in. The record is nil if the database has no
+// data for ip.
+func (gi *GeoIP) lookupRange(ip net.IP) (gir *GeoIPRecord, netmask int, err error) {
+	var gl C.GeoIPLookup
+	switch {
+	case gi.IsCityDatabase() && gi.IsIPv4Database():
+		gi.mu.Lock()
+		defer gi.mu.Unlock()
+		cGir := C.GeoIP_record_by_ipnum(gi.gi, C.ulong(binary.BigEndian.Uint32(ip.To4())))
+		if cGir == nil {
+			return nil, int(C.GeoIP_last_netmask(gi.gi)), nil
+		}
+		// this call frees all the CStrings in cGir
+		defer C.GeoIPRecord_delete(cGir)
+		return gi.newGeoIPRecord(cGir), int(cGir.netmask), nil
+	case gi.IsCityDatabase():
+		cip := checkedCString(ipv6String(ip))
+		defer C.free(unsafe.Pointer(cip))
+		gi.mu.Lock()
+		defer gi.mu.Unlock()
+		cGir := C.GeoIP_record_by_addr_v6(gi.gi, cip)
+		if cGir == nil {
+			return nil, int(C.GeoIP_last_netmask(gi.gi)), nil
+		}
+		// this call frees all the CStrings in cGir
+		defer C.GeoIPRecord_delete(cGir)
+		return gi.newGeoIPRecord(cGir), int(cGir.netmask), nil
+	case gi.IsCountryDatabase() && gi.IsIPv4Database():
+		id := C.GeoIP_id_by_ipnum_gl(gi.gi, C.ulong(binary.BigEndian.Uint32(ip.To4())), &gl)
+		return gi.countryRecord(int(id)), int(gl.netmask), nil
+	case gi.IsCountryDatabase():
+		cip := checkedCString(ipv6String(ip))
+		defer C.free(unsafe.Pointer(cip))
+		id := C.GeoIP_id_by_addr_v6_gl(gi.gi, cip, &gl)
+		return gi.countryRecord(int(id)), int(gl.netmask), nil
+	case gi.IsOrgDatabase() && gi.IsIPv4Database():
+		// this call returns a newly allocated CString
+		cName := C.GeoIP_name_by_ipnum_gl(gi.gi, C.ulong(binary.BigEndian.Uint32(ip.To4())), &gl)
+		defer C.free(unsafe.Pointer(cName))
+		return orgRecord(toUTF8(C.GoString(cName), gi.charset), gi.isASNumDatabase()), int(gl.netmask), nil
+	case gi.IsOrgDatabase():
+		cip := checkedCString(ipv6String(ip))
+		defer C.free(unsafe.Pointer(cip))
+		// this call returns a newly allocated CString
+		cName := C.GeoIP_name_by_addr_v6_gl(gi.gi, cip, &gl)
+		defer C.free(unsafe.Pointer(cName))
+		return orgRecord(toUTF8(C.GoString(cName), gi.charset), gi.isASNumDatabase()), int(gl.netmask), nil
+	}
+	return nil, 0, fmt.Errorf("lookups are not supported for database edition %v", gi.edition)
+}
+
+// regionName returns the name libGeoIP has for a region, or "".
+func regionName(country, region string) string {
+	cCountry := checkedCString(country)
+	defer C.free(unsafe.Pointer(cCountry))
+	cRegion := checkedCString(region)
+	defer C.free(unsafe.Pointer(cRegion))
+	// this call returns a static CString
+	// older versions of libGeoIP have Latin-1 names
+	return toUTF8(C.GoString(C.GeoIP_region_name_by_code(cCountry, cRegion)), CharsetUTF8)
 }
 
 func (gi *GeoIP) Delete() {
@@ -325,9 +479,23 @@ func Code3ByID(id int) (code3 string) {
 	return C.GoString(C.GeoIP_code3_by_id(C.int(id)))
 }
 
+// countryRecord returns the record of the country id with the name in the
+// charset of the handle, as CountryNameByIPNum returns it.
+func (gi *GeoIP) countryRecord(id int) *GeoIPRecord {
+	gir := countryRecord(id)
+	if gir != nil {
+		// this call returns a static CString
+		gir.CountryName = toUTF8(C.GoString(C.GeoIP_country_name_by_id(gi.gi, C.int(id))), gi.charset)
+	}
+	return gir
+}
+
+// NameByID returns the country name of libGeoIP for the id. Without cgo
+// it returns the Name of CountryByID instead, which is the ISO 3166-1
+// short name and can differ, for example for "BO".
 func NameByID(id int) (name string) {
 	// this call returns a static CString
-	return C.GoString(C.GeoIP_name_by_id(C.int(id)))
+	return toUTF8(C.GoString(C.GeoIP_name_by_id(C.int(id))), CharsetLatin1)
 }
 
 func ContinentByID(id int) (continent string) {
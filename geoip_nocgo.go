@@ -38,6 +38,10 @@ func (gi *GeoIP) IsCityDatabase() bool {
 	panic("geoip needs cgo")
 }
 
+func (gi *GeoIP) IsOrgDatabase() bool {
+	panic("geoip needs cgo")
+}
+
 type GeoIP struct {
 }
 
@@ -45,10 +49,22 @@ func New() (gi *GeoIP, err error) {
 	return nil, errors.New("geoip needs cgo")
 }
 
+func NewWithOptions(opts *Options) (gi *GeoIP, err error) {
+	return nil, errors.New("geoip needs cgo")
+}
+
 func Open(filename string) (gi *GeoIP, err error) {
 	return nil, errors.New("geoip needs cgo")
 }
 
+func OpenWithOptions(filename string, opts *Options) (gi *GeoIP, err error) {
+	return nil, errors.New("geoip needs cgo")
+}
+
+func (gi *GeoIP) Charset() Charset {
+	panic("geoip needs cgo")
+}
+
 func (gi *GeoIP) DatabaseInfo() string {
 	panic("geoip needs cgo")
 }
@@ -57,6 +73,10 @@ func (gi *GeoIP) DatabaseEdition() (code int) {
 	panic("geoip needs cgo")
 }
 
+func (gi *GeoIP) DatabaseDescription() string {
+	panic("geoip needs cgo")
+}
+
 func (gi *GeoIP) DatabaseCreateTime() (time.Time, error) {
 	return time.Now(), errors.New("geoip needs cgo")
 }
@@ -109,22 +129,65 @@ func (gi *GeoIP) RecordByIPv6(ip net.IP) (gir *GeoIPRecord) {
 	panic("geoip needs cgo")
 }
 
+func (gi *GeoIP) OrgByIPv4(ip net.IP) (name string) {
+	panic("geoip needs cgo")
+}
+
+func (gi *GeoIP) OrgByIPNum(ipnum uint32) (name string) {
+	panic("geoip needs cgo")
+}
+
+func (gi *GeoIP) OrgByIPv6(ip net.IP) (name string) {
+	panic("geoip needs cgo")
+}
+
+func (gi *GeoIP) lookupRange(ip net.IP) (gir *GeoIPRecord, netmask int, err error) {
+	panic("geoip needs cgo")
+}
+
+// regionName has no names without libGeoIP, so only US and Canadian
+// regions are mapped to subdivisions.
+func regionName(country, region string) string {
+	return ""
+}
+
 func (gi *GeoIP) Delete() {
 	panic("geoip needs cgo")
 }
 
+// CodeByID and the other id functions use the country registry when cgo
+// is not available. NameByID returns the ISO 3166-1 names, which differ
+// from the names libGeoIP uses for a few countries.
 func CodeByID(id int) (code string) {
-	panic("geoip needs cgo")
+	if c := CountryByID(id); c != nil {
+		return c.Alpha2
+	}
+	return ""
 }
 
 func Code3ByID(id int) (code3 string) {
-	panic("geoip needs cgo")
+	if c := CountryByID(id); c != nil {
+		return c.Alpha3
+	}
+	return ""
 }
 
+// NameByID returns the Name of CountryByID. That is the ISO 3166-1 short
+// name, which can differ from the name libGeoIP returns in cgo builds.
 func NameByID(id int) (name string) {
-	panic("geoip needs cgo")
+	if c := CountryByID(id); c != nil {
+		return c.Name
+	}
+	return ""
 }
 
 func ContinentByID(id int) (continent string) {
-	panic("geoip needs cgo")
+	c := CountryByID(id)
+	if c == nil {
+		return ""
+	}
+	if len(c.Continent) == 0 {
+		return "--"
+	}
+	return c.Continent
 }
@@ -21,8 +21,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 var paths = []string{"/usr/share/GeoIP/", "/usr/local/share/GeoIP/"}
@@ -251,3 +253,135 @@ func TestBug14019(t *testing.T) {
 		t.Fatalf("Cities are encoded with Latin1")
 	}
 }
+
+func TestCharsetUTF8(t *testing.T) {
+	gi, err := Open(geoIPCity)
+	if err != nil {
+		t.Fatalf("Open(%v) failed", geoIPCity)
+	}
+	defer gi.Delete()
+	giutf8, err := OpenWithOptions(geoIPCity, &Options{Charset: CharsetUTF8})
+	if err != nil {
+		t.Fatalf("OpenWithOptions(%v) failed", geoIPCity)
+	}
+	defer giutf8.Delete()
+	if giutf8.Charset() != CharsetUTF8 {
+		t.Fatalf("charset %v", giutf8.Charset())
+	}
+
+	ipnum := binary.BigEndian.Uint32(net.ParseIP("83.206.228.217").To4())
+	if c := gi.CityByIPNum(ipnum); strings.HasPrefix(c, "Le") {
+		if c2 := giutf8.CityByIPNum(ipnum); c2 != c {
+			t.Fatalf("got %q, want %q", c2, c)
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		ipnum := binary.BigEndian.Uint32(generateBytes(4))
+		gir := giutf8.RecordByIPNum(ipnum)
+		if gir == nil {
+			continue
+		}
+		for _, s := range []string{gir.CountryName, gir.Region, gir.City, gir.PostalCode} {
+			if !utf8.ValidString(s) {
+				t.Fatalf("invalid UTF-8 in %+v", gir)
+			}
+		}
+		// the Latin-1 handle returns the same city, converted to UTF-8
+		if c := gi.CityByIPNum(ipnum); c != gir.City {
+			t.Fatalf("%v: got %q from the Latin-1 handle, %q from the UTF-8 one", ipnum, c, gir.City)
+		}
+	}
+}
+
+func TestCharsetCountryNetwork(t *testing.T) {
+	gi, err := OpenWithOptions(geoIPCountry, &Options{Charset: CharsetUTF8})
+	if err != nil {
+		t.Fatalf("OpenWithOptions(%v) failed", geoIPCountry)
+	}
+	defer gi.Delete()
+	for i := 0; i < 1000; i++ {
+		ip := net.IP(generateBytes(4))
+		gir, _ := gi.LookupNetwork(ip)
+		if name := gi.CountryNameByIPNum(binary.BigEndian.Uint32(ip)); gir != nil && gir.CountryName != name {
+			t.Fatalf("%v: LookupNetwork has %q, CountryNameByIPNum %q", ip, gir.CountryName, name)
+		}
+	}
+}
+
+// hammer looks up addresses from many goroutines and checks that the
+// results match those of serial lookups. Run it with -race.
+func hammer(t *testing.T, res NetworkResolver, serial NetworkResolver) {
+	ips := make([]net.IP, 1000)
+	want := make([]*GeoIPRecord, len(ips))
+	for i := range ips {
+		ips[i] = net.IP(generateBytes(4))
+		want[i], _ = serial.LookupNetwork(ips[i])
+	}
+	var wg sync.WaitGroup
+	errc := make(chan error, 16)
+	for g := 0; g < cap(errc); g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for j := range ips {
+				// start every goroutine at a different address
+				i := (j + g*len(ips)/cap(errc)) % len(ips)
+				gir, n := res.LookupNetwork(ips[i])
+				if n == nil || !n.Contains(ips[i]) || !equalRecords(gir, want[i]) {
+					errc <- fmt.Errorf("%v: got %v in %v, want %v", ips[i], gir, n, want[i])
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errc)
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentLookups(t *testing.T) {
+	for _, path := range []string{geoIPCountry, geoIPCity} {
+		gi, err := Open(path)
+		if err != nil {
+			t.Fatalf("Open(%v) failed", path)
+		}
+		defer gi.Delete()
+		serial, err := Open(path)
+		if err != nil {
+			t.Fatalf("Open(%v) failed", path)
+		}
+		defer serial.Delete()
+		hammer(t, gi, serial)
+	}
+}
+
+func TestPool(t *testing.T) {
+	p, err := OpenPool(geoIPCity, 0)
+	if err != nil {
+		t.Fatalf("OpenPool(%v) failed", geoIPCity)
+	}
+	defer p.Delete()
+	serial, err := Open(geoIPCity)
+	if err != nil {
+		t.Fatalf("Open(%v) failed", geoIPCity)
+	}
+	defer serial.Delete()
+	hammer(t, p, serial)
+	c := NewCache(p, 100)
+	hammer(t, c, serial)
+
+	done := make(chan error)
+	go func() {
+		done <- p.Reopen()
+	}()
+	hammer(t, c, serial)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if p.Reloads() != 1 {
+		t.Fatalf("%v reloads", p.Reloads())
+	}
+	hammer(t, c, serial)
+}
@@ -0,0 +1,149 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geomap writes the ranges of a GeoIP database as map files for
+// the nginx geo module and HAProxy, so that proxies can route on the
+// country, continent or ASN of a client without libGeoIP.
+package geomap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Format is the syntax of the lines written by Write.
+type Format int
+
+const (
+	// Nginx writes "network value;" lines for use inside a geo block.
+	Nginx Format = iota
+	// HAProxy writes "network value" lines for use with map_ip.
+	HAProxy
+)
+
+// Key is the field of the records used as map value.
+type Key int
+
+const (
+	Country Key = iota
+	Continent
+	ASN
+)
+
+var (
+	formatNames = []string{Nginx: "nginx", HAProxy: "haproxy"}
+	keyNames    = []string{Country: "country", Continent: "continent", ASN: "asn"}
+)
+
+func (f Format) String() string {
+	return name(formatNames, int(f))
+}
+
+func (k Key) String() string {
+	return name(keyNames, int(k))
+}
+
+// ParseFormat returns the format with the given name, "nginx" or "haproxy".
+func ParseFormat(s string) (Format, error) {
+	i, err := parse(formatNames, s, "format")
+	return Format(i), err
+}
+
+// ParseKey returns the key with the given name, "country", "continent" or "asn".
+func ParseKey(s string) (Key, error) {
+	i, err := parse(keyNames, s, "key")
+	return Key(i), err
+}
+
+func name(names []string, i int) string {
+	if i < 0 || i >= len(names) {
+		return fmt.Sprint(i)
+	}
+	return names[i]
+}
+
+func parse(names []string, s, what string) (int, error) {
+	for i, n := range names {
+		if n == s {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown map %v %q", what, s)
+}
+
+// Walker is a source of ranges, such as a *geoip.GeoIP.
+type Walker interface {
+	Walk(fn func(r *geoip.Range) error) error
+}
+
+// Value returns the map value of gir for the key, or "" if gir has no such value.
+func (k Key) Value(gir *geoip.GeoIPRecord) string {
+	switch k {
+	case Country:
+		return gir.CountryCode
+	case Continent:
+		return gir.ContinentCode
+	case ASN:
+		if gir.ASNumber != 0 {
+			return fmt.Sprintf("AS%d", gir.ASNumber)
+		}
+	}
+	return ""
+}
+
+// Write writes a line for every network of the ranges of db that have a
+// value for key. Adjacent ranges with the same value are aggregated into
+// the fewest networks.
+func Write(w io.Writer, db Walker, f Format, key Key) error {
+	if f != Nginx && f != HAProxy {
+		return fmt.Errorf("unknown map format %v", f)
+	}
+	bw := bufio.NewWriter(w)
+	var cur *geoip.Range
+	var value string
+	flush := func() {
+		if cur == nil {
+			return
+		}
+		for _, n := range cur.Networks() {
+			if f == Nginx {
+				fmt.Fprintf(bw, "%v %v;\n", n, value)
+			} else {
+				fmt.Fprintf(bw, "%v %v\n", n, value)
+			}
+		}
+		cur = nil
+	}
+	err := db.Walk(func(r *geoip.Range) error {
+		v := key.Value(r.Record)
+		if cur != nil && v == value && cur.Adjacent(r) {
+			cur.End = r.End
+			return nil
+		}
+		flush()
+		if len(v) > 0 {
+			cur = &geoip.Range{Start: r.Start, End: r.End}
+			value = v
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	flush()
+	return bw.Flush()
+}
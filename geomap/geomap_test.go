@@ -0,0 +1,78 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geomap
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/jdevilliers/geoip"
+)
+
+type fakeDB []*geoip.Range
+
+func (db fakeDB) Walk(fn func(r *geoip.Range) error) error {
+	for _, r := range db {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testRange(start, end string, gir *geoip.GeoIPRecord) *geoip.Range {
+	return &geoip.Range{Start: net.ParseIP(start).To4(), End: net.ParseIP(end).To4(), Record: gir}
+}
+
+var testDB = fakeDB{
+	testRange("1.0.0.0", "1.0.0.255", &geoip.GeoIPRecord{CountryCode: "AU", ContinentCode: "OC", City: "Brisbane"}),
+	testRange("1.0.1.0", "1.0.1.255", &geoip.GeoIPRecord{CountryCode: "AU", ContinentCode: "OC", City: "Sydney"}),
+	testRange("1.0.2.0", "1.0.3.255", &geoip.GeoIPRecord{CountryCode: "NZ", ContinentCode: "OC"}),
+	testRange("1.0.5.0", "1.0.5.255", &geoip.GeoIPRecord{CountryCode: "NZ", ContinentCode: "OC", ASNumber: 9500}),
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		format Format
+		key    Key
+		want   string
+	}{
+		{Nginx, Country, "1.0.0.0/23 AU;\n1.0.2.0/23 NZ;\n1.0.5.0/24 NZ;\n"},
+		{HAProxy, Continent, "1.0.0.0/22 OC\n1.0.5.0/24 OC\n"},
+		{HAProxy, ASN, "1.0.5.0/24 AS9500\n"},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := Write(&buf, testDB, test.format, test.key); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != test.want {
+			t.Fatalf("%v %v:\n%v", test.format, test.key, buf.String())
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	if f, err := ParseFormat("haproxy"); err != nil || f != HAProxy {
+		t.Fatal("format")
+	}
+	if k, err := ParseKey("asn"); err != nil || k != ASN {
+		t.Fatal("key")
+	}
+	if _, err := ParseKey("city"); err == nil {
+		t.Fatal("city")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geoiptest provides a fake resolver for the tests of the
+// packages that use a geoip.Resolver.
+package geoiptest
+
+import (
+	"net"
+	"sync"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Resolver is a geoip.Resolver that returns fixed records keyed by the
+// string form of the address. It is safe for concurrent use.
+type Resolver struct {
+	mu      sync.RWMutex
+	records map[string]*geoip.GeoIPRecord
+}
+
+// New returns a resolver for the records, which it does not copy.
+func New(records map[string]*geoip.GeoIPRecord) *Resolver {
+	if records == nil {
+		records = make(map[string]*geoip.GeoIPRecord)
+	}
+	return &Resolver{records: records}
+}
+
+// Countries returns a resolver whose records only have a country code.
+func Countries(codes map[string]string) *Resolver {
+	r := New(nil)
+	for ip, code := range codes {
+		r.records[ip] = &geoip.GeoIPRecord{CountryCode: code}
+	}
+	return r
+}
+
+func (r *Resolver) Lookup(ip net.IP) *geoip.GeoIPRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.records[ip.String()]
+}
+
+// Set sets the record of the address ip, or removes it if gir is nil.
+func (r *Resolver) Set(ip string, gir *geoip.GeoIPRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if gir == nil {
+		delete(r.records, ip)
+	} else {
+		r.records[ip] = gir
+	}
+}
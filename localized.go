@@ -0,0 +1,151 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// localizedMu guards countryNames and subdivisionNames.
+var localizedMu sync.RWMutex
+
+// LocalizedLanguages returns the languages that have localized country
+// names, as BCP 47 tags such as "de" and "pt-BR".
+func LocalizedLanguages() []string {
+	localizedMu.RLock()
+	defer localizedMu.RUnlock()
+	langs := make([]string, 0, len(countryNames))
+	for lang := range countryNames {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// SetLocalizedNames adds names in a language, or replaces the embedded
+// ones. countries is keyed by alpha-2 code and subdivisions by ISO 3166-2
+// code, and either can be nil. The names are merged with those already
+// known for the language, so "PT_br" adds to the embedded "pt-BR" names.
+func SetLocalizedNames(lang string, countries, subdivisions map[string]string) {
+	lang = canonicalTag(lang)
+	localizedMu.Lock()
+	defer localizedMu.Unlock()
+	for _, t := range []struct {
+		tables map[string]map[string]string
+		names  map[string]string
+	}{{countryNames, countries}, {subdivisionNames, subdivisions}} {
+		if len(t.names) == 0 {
+			continue
+		}
+		table := t.tables[lang]
+		if table == nil {
+			table = make(map[string]string)
+			t.tables[lang] = table
+		}
+		for code, name := range t.names {
+			table[strings.ToUpper(code)] = name
+		}
+	}
+}
+
+// canonicalTag returns a BCP 47 tag in the case the tables use, with a
+// lower case language, a title case script and an upper case region, for
+// example "zh-Hant-TW". Underscores are read as hyphens.
+func canonicalTag(lang string) string {
+	parts := strings.Split(strings.Replace(lang, "_", "-", -1), "-")
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 2:
+			parts[i] = strings.ToUpper(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// localizedName returns the name of code in lang, or "". If the language
+// is not known exactly, or has no name for code, it falls back to other
+// languages with the same base language, so "pt" finds "pt-BR" and
+// "de-AT" finds "de".
+func localizedName(tables map[string]map[string]string, lang, code string) string {
+	lang = canonicalTag(lang)
+	base := lang
+	if i := strings.Index(lang, "-"); i >= 0 {
+		base = lang[:i]
+	}
+	localizedMu.RLock()
+	defer localizedMu.RUnlock()
+	if name := tables[lang][code]; len(name) > 0 {
+		return name
+	}
+	if name := tables[base][code]; len(name) > 0 {
+		return name
+	}
+	var fallback string
+	for tag, table := range tables {
+		if strings.HasPrefix(tag, base+"-") && len(table[code]) > 0 && (len(fallback) == 0 || tag < fallback) {
+			fallback = tag
+		}
+	}
+	if len(fallback) == 0 {
+		return ""
+	}
+	return tables[fallback][code]
+}
+
+// LocalizedName returns the name of c in the language lang, or the
+// English name if there is none.
+func (c *Country) LocalizedName(lang string) string {
+	if name := localizedName(countryNames, lang, c.Alpha2); len(name) > 0 {
+		return name
+	}
+	return c.Name
+}
+
+// LocalizedName returns the name of s in the language lang, or the
+// English name if there is none.
+func (s *Subdivision) LocalizedName(lang string) string {
+	if name := localizedName(subdivisionNames, lang, s.Code); len(name) > 0 {
+		return name
+	}
+	return s.Name
+}
+
+// LocalizedCountryName returns the name of the country of the record in
+// the language lang, falling back to CountryName. The names come from the
+// embedded tables and SetLocalizedNames only: the legacy databases have
+// no localized names, and this package has no MMDB reader.
+func (gir *GeoIPRecord) LocalizedCountryName(lang string) string {
+	if c := gir.Country(); c != nil && c.Numeric != 0 {
+		return c.LocalizedName(lang)
+	}
+	return gir.CountryName
+}
+
+// LocalizedSubdivisionName returns the name of the subdivision of the
+// record in the language lang, falling back to SubdivisionName.
+func (gir *GeoIPRecord) LocalizedSubdivisionName(lang string) string {
+	if s := SubdivisionByCode(gir.SubdivisionCode); s != nil {
+		return s.LocalizedName(lang)
+	}
+	return gir.SubdivisionName
+}
@@ -0,0 +1,10798 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+// countryNames are the country names of CLDR, keyed by language and
+// alpha-2 code.
+var countryNames = map[string]map[string]string{
+	"de": {
+		"AD": "Andorra",
+		"AE": "Vereinigte Arabische Emirate",
+		"AF": "Afghanistan",
+		"AG": "Antigua und Barbuda",
+		"AI": "Anguilla",
+		"AL": "Albanien",
+		"AM": "Armenien",
+		"AO": "Angola",
+		"AQ": "Antarktis",
+		"AR": "Argentinien",
+		"AS": "Amerikanisch-Samoa",
+		"AT": "Österreich",
+		"AU": "Australien",
+		"AW": "Aruba",
+		"AX": "Ålandinseln",
+		"AZ": "Aserbaidschan",
+		"BA": "Bosnien und Herzegowina",
+		"BB": "Barbados",
+		"BD": "Bangladesch",
+		"BE": "Belgien",
+		"BF": "Burkina Faso",
+		"BG": "Bulgarien",
+		"BH": "Bahrain",
+		"BI": "Burundi",
+		"BJ": "Benin",
+		"BL": "St. Barthélemy",
+		"BM": "Bermuda",
+		"BN": "Brunei Darussalam",
+		"BO": "Bolivien",
+		"BQ": "Bonaire, Sint Eustatius und Saba",
+		"BR": "Brasilien",
+		"BS": "Bahamas",
+		"BT": "Bhutan",
+		"BV": "Bouvetinsel",
+		"BW": "Botsuana",
+		"BY": "Belarus",
+		"BZ": "Belize",
+		"CA": "Kanada",
+		"CC": "Kokosinseln",
+		"CD": "Kongo-Kinshasa",
+		"CF": "Zentralafrikanische Republik",
+		"CG": "Kongo-Brazzaville",
+		"CH": "Schweiz",
+		"CI": "Côte d’Ivoire",
+		"CK": "Cookinseln",
+		"CL": "Chile",
+		"CM": "Kamerun",
+		"CN": "China",
+		"CO": "Kolumbien",
+		"CR": "Costa Rica",
+		"CU": "Kuba",
+		"CV": "Cabo Verde",
+		"CW": "Curaçao",
+		"CX": "Weihnachtsinsel",
+		"CY": "Zypern",
+		"CZ": "Tschechien",
+		"DE": "Deutschland",
+		"DJ": "Dschibuti",
+		"DK": "Dänemark",
+		"DM": "Dominica",
+		"DO": "Dominikanische Republik",
+		"DZ": "Algerien",
+		"EC": "Ecuador",
+		"EE": "Estland",
+		"EG": "Ägypten",
+		"EH": "Westsahara",
+		"ER": "Eritrea",
+		"ES": "Spanien",
+		"ET": "Äthiopien",
+		"FI": "Finnland",
+		"FJ": "Fidschi",
+		"FK": "Falklandinseln",
+		"FM": "Mikronesien",
+		"FO": "Färöer",
+		"FR": "Frankreich",
+		"GA": "Gabun",
+		"GB": "Vereinigtes Königreich",
+		"GD": "Grenada",
+		"GE": "Georgien",
+		"GF": "Französisch-Guayana",
+		"GG": "Guernsey",
+		"GH": "Ghana",
+		"GI": "Gibraltar",
+		"GL": "Grönland",
+		"GM": "Gambia",
+		"GN": "Guinea",
+		"GP": "Guadeloupe",
+		"GQ": "Äquatorialguinea",
+		"GR": "Griechenland",
+		"GS": "Südgeorgien und die Südlichen Sandwichinseln",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guinea-Bissau",
+		"GY": "Guyana",
+		"HK": "Sonderverwaltungsregion Hongkong",
+		"HM": "Heard und McDonaldinseln",
+		"HN": "Honduras",
+		"HR": "Kroatien",
+		"HT": "Haiti",
+		"HU": "Ungarn",
+		"ID": "Indonesien",
+		"IE": "Irland",
+		"IL": "Israel",
+		"IM": "Isle of Man",
+		"IN": "Indien",
+		"IO": "Britisches Territorium im Indischen Ozean",
+		"IQ": "Irak",
+		"IR": "Iran",
+		"IS": "Island",
+		"IT": "Italien",
+		"JE": "Jersey",
+		"JM": "Jamaika",
+		"JO": "Jordanien",
+		"JP": "Japan",
+		"KE": "Kenia",
+		"KG": "Kirgisistan",
+		"KH": "Kambodscha",
+		"KI": "Kiribati",
+		"KM": "Komoren",
+		"KN": "St. Kitts und Nevis",
+		"KP": "Nordkorea",
+		"KR": "Südkorea",
+		"KW": "Kuwait",
+		"KY": "Kaimaninseln",
+		"KZ": "Kasachstan",
+		"LA": "Laos",
+		"LB": "Libanon",
+		"LC": "St. Lucia",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Liberia",
+		"LS": "Lesotho",
+		"LT": "Litauen",
+		"LU": "Luxemburg",
+		"LV": "Lettland",
+		"LY": "Libyen",
+		"MA": "Marokko",
+		"MC": "Monaco",
+		"MD": "Republik Moldau",
+		"ME": "Montenegro",
+		"MF": "St. Martin",
+		"MG": "Madagaskar",
+		"MH": "Marshallinseln",
+		"MK": "Mazedonien",
+		"ML": "Mali",
+		"MM": "Myanmar",
+		"MN": "Mongolei",
+		"MO": "Sonderverwaltungsregion Macau",
+		"MP": "Nördliche Marianen",
+		"MQ": "Martinique",
+		"MR": "Mauretanien",
+		"MS": "Montserrat",
+		"MT": "Malta",
+		"MU": "Mauritius",
+		"MV": "Malediven",
+		"MW": "Malawi",
+		"MX": "Mexiko",
+		"MY": "Malaysia",
+		"MZ": "Mosambik",
+		"NA": "Namibia",
+		"NC": "Neukaledonien",
+		"NE": "Niger",
+		"NF": "Norfolkinsel",
+		"NG": "Nigeria",
+		"NI": "Nicaragua",
+		"NL": "Niederlande",
+		"NO": "Norwegen",
+		"NP": "Nepal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "Neuseeland",
+		"OM": "Oman",
+		"PA": "Panama",
+		"PE": "Peru",
+		"PF": "Französisch-Polynesien",
+		"PG": "Papua-Neuguinea",
+		"PH": "Philippinen",
+		"PK": "Pakistan",
+		"PL": "Polen",
+		"PM": "St. Pierre und Miquelon",
+		"PN": "Pitcairninseln",
+		"PR": "Puerto Rico",
+		"PS": "Palästinensische Autonomiegebiete",
+		"PT": "Portugal",
+		"PW": "Palau",
+		"PY": "Paraguay",
+		"QA": "Katar",
+		"RE": "Réunion",
+		"RO": "Rumänien",
+		"RS": "Serbien",
+		"RU": "Russland",
+		"RW": "Ruanda",
+		"SA": "Saudi-Arabien",
+		"SB": "Salomonen",
+		"SC": "Seychellen",
+		"SD": "Sudan",
+		"SE": "Schweden",
+		"SG": "Singapur",
+		"SH": "St. Helena",
+		"SI": "Slowenien",
+		"SJ": "Spitzbergen und Jan Mayen",
+		"SK": "Slowakei",
+		"SL": "Sierra Leone",
+		"SM": "San Marino",
+		"SN": "Senegal",
+		"SO": "Somalia",
+		"SR": "Suriname",
+		"SS": "Südsudan",
+		"ST": "São Tomé und Príncipe",
+		"SV": "El Salvador",
+		"SX": "Sint Maarten",
+		"SY": "Syrien",
+		"SZ": "Swasiland",
+		"TC": "Turks- und Caicosinseln",
+		"TD": "Tschad",
+		"TF": "Französische Süd- und Antarktisgebiete",
+		"TG": "Togo",
+		"TH": "Thailand",
+		"TJ": "Tadschikistan",
+		"TK": "Tokelau",
+		"TL": "Timor-Leste",
+		"TM": "Turkmenistan",
+		"TN": "Tunesien",
+		"TO": "Tonga",
+		"TR": "Türkei",
+		"TT": "Trinidad und Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taiwan",
+		"TZ": "Tansania",
+		"UA": "Ukraine",
+		"UG": "Uganda",
+		"UM": "Amerikanische Überseeinseln",
+		"US": "Vereinigte Staaten",
+		"UY": "Uruguay",
+		"UZ": "Usbekistan",
+		"VA": "Vatikanstadt",
+		"VC": "St. Vincent und die Grenadinen",
+		"VE": "Venezuela",
+		"VG": "Britische Jungferninseln",
+		"VI": "Amerikanische Jungferninseln",
+		"VN": "Vietnam",
+		"VU": "Vanuatu",
+		"WF": "Wallis und Futuna",
+		"WS": "Samoa",
+		"YE": "Jemen",
+		"YT": "Mayotte",
+		"ZA": "Südafrika",
+		"ZM": "Sambia",
+		"ZW": "Simbabwe",
+	},
+	"en": {
+		"AD": "Andorra",
+		"AE": "United Arab Emirates",
+		"AF": "Afghanistan",
+		"AG": "Antigua & Barbuda",
+		"AI": "Anguilla",
+		"AL": "Albania",
+		"AM": "Armenia",
+		"AO": "Angola",
+		"AQ": "Antarctica",
+		"AR": "Argentina",
+		"AS": "American Samoa",
+		"AT": "Austria",
+		"AU": "Australia",
+		"AW": "Aruba",
+		"AX": "Åland Islands",
+		"AZ": "Azerbaijan",
+		"BA": "Bosnia & Herzegovina",
+		"BB": "Barbados",
+		"BD": "Bangladesh",
+		"BE": "Belgium",
+		"BF": "Burkina Faso",
+		"BG": "Bulgaria",
+		"BH": "Bahrain",
+		"BI": "Burundi",
+		"BJ": "Benin",
+		"BL": "St. Barthélemy",
+		"BM": "Bermuda",
+		"BN": "Brunei",
+		"BO": "Bolivia",
+		"BQ": "Caribbean Netherlands",
+		"BR": "Brazil",
+		"BS": "Bahamas",
+		"BT": "Bhutan",
+		"BV": "Bouvet Island",
+		"BW": "Botswana",
+		"BY": "Belarus",
+		"BZ": "Belize",
+		"CA": "Canada",
+		"CC": "Cocos (Keeling) Islands",
+		"CD": "Congo - Kinshasa",
+		"CF": "Central African Republic",
+		"CG": "Congo - Brazzaville",
+		"CH": "Switzerland",
+		"CI": "Côte d’Ivoire",
+		"CK": "Cook Islands",
+		"CL": "Chile",
+		"CM": "Cameroon",
+		"CN": "China",
+		"CO": "Colombia",
+		"CR": "Costa Rica",
+		"CU": "Cuba",
+		"CV": "Cape Verde",
+		"CW": "Curaçao",
+		"CX": "Christmas Island",
+		"CY": "Cyprus",
+		"CZ": "Czechia",
+		"DE": "Germany",
+		"DJ": "Djibouti",
+		"DK": "Denmark",
+		"DM": "Dominica",
+		"DO": "Dominican Republic",
+		"DZ": "Algeria",
+		"EC": "Ecuador",
+		"EE": "Estonia",
+		"EG": "Egypt",
+		"EH": "Western Sahara",
+		"ER": "Eritrea",
+		"ES": "Spain",
+		"ET": "Ethiopia",
+		"FI": "Finland",
+		"FJ": "Fiji",
+		"FK": "Falkland Islands",
+		"FM": "Micronesia",
+		"FO": "Faroe Islands",
+		"FR": "France",
+		"GA": "Gabon",
+		"GB": "United Kingdom",
+		"GD": "Grenada",
+		"GE": "Georgia",
+		"GF": "French Guiana",
+		"GG": "Guernsey",
+		"GH": "Ghana",
+		"GI": "Gibraltar",
+		"GL": "Greenland",
+		"GM": "Gambia",
+		"GN": "Guinea",
+		"GP": "Guadeloupe",
+		"GQ": "Equatorial Guinea",
+		"GR": "Greece",
+		"GS": "South Georgia & South Sandwich Islands",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guinea-Bissau",
+		"GY": "Guyana",
+		"HK": "Hong Kong SAR China",
+		"HM": "Heard & McDonald Islands",
+		"HN": "Honduras",
+		"HR": "Croatia",
+		"HT": "Haiti",
+		"HU": "Hungary",
+		"ID": "Indonesia",
+		"IE": "Ireland",
+		"IL": "Israel",
+		"IM": "Isle of Man",
+		"IN": "India",
+		"IO": "British Indian Ocean Territory",
+		"IQ": "Iraq",
+		"IR": "Iran",
+		"IS": "Iceland",
+		"IT": "Italy",
+		"JE": "Jersey",
+		"JM": "Jamaica",
+		"JO": "Jordan",
+		"JP": "Japan",
+		"KE": "Kenya",
+		"KG": "Kyrgyzstan",
+		"KH": "Cambodia",
+		"KI": "Kiribati",
+		"KM": "Comoros",
+		"KN": "St. Kitts & Nevis",
+		"KP": "North Korea",
+		"KR": "South Korea",
+		"KW": "Kuwait",
+		"KY": "Cayman Islands",
+		"KZ": "Kazakhstan",
+		"LA": "Laos",
+		"LB": "Lebanon",
+		"LC": "St. Lucia",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Liberia",
+		"LS": "Lesotho",
+		"LT": "Lithuania",
+		"LU": "Luxembourg",
+		"LV": "Latvia",
+		"LY": "Libya",
+		"MA": "Morocco",
+		"MC": "Monaco",
+		"MD": "Moldova",
+		"ME": "Montenegro",
+		"MF": "St. Martin",
+		"MG": "Madagascar",
+		"MH": "Marshall Islands",
+		"MK": "Macedonia",
+		"ML": "Mali",
+		"MM": "Myanmar (Burma)",
+		"MN": "Mongolia",
+		"MO": "Macau SAR China",
+		"MP": "Northern Mariana Islands",
+		"MQ": "Martinique",
+		"MR": "Mauritania",
+		"MS": "Montserrat",
+		"MT": "Malta",
+		"MU": "Mauritius",
+		"MV": "Maldives",
+		"MW": "Malawi",
+		"MX": "Mexico",
+		"MY": "Malaysia",
+		"MZ": "Mozambique",
+		"NA": "Namibia",
+		"NC": "New Caledonia",
+		"NE": "Niger",
+		"NF": "Norfolk Island",
+		"NG": "Nigeria",
+		"NI": "Nicaragua",
+		"NL": "Netherlands",
+		"NO": "Norway",
+		"NP": "Nepal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "New Zealand",
+		"OM": "Oman",
+		"PA": "Panama",
+		"PE": "Peru",
+		"PF": "French Polynesia",
+		"PG": "Papua New Guinea",
+		"PH": "Philippines",
+		"PK": "Pakistan",
+		"PL": "Poland",
+		"PM": "St. Pierre & Miquelon",
+		"PN": "Pitcairn Islands",
+		"PR": "Puerto Rico",
+		"PS": "Palestinian Territories",
+		"PT": "Portugal",
+		"PW": "Palau",
+		"PY": "Paraguay",
+		"QA": "Qatar",
+		"RE": "Réunion",
+		"RO": "Romania",
+		"RS": "Serbia",
+		"RU": "Russia",
+		"RW": "Rwanda",
+		"SA": "Saudi Arabia",
+		"SB": "Solomon Islands",
+		"SC": "Seychelles",
+		"SD": "Sudan",
+		"SE": "Sweden",
+		"SG": "Singapore",
+		"SH": "St. Helena",
+		"SI": "Slovenia",
+		"SJ": "Svalbard & Jan Mayen",
+		"SK": "Slovakia",
+		"SL": "Sierra Leone",
+		"SM": "San Marino",
+		"SN": "Senegal",
+		"SO": "Somalia",
+		"SR": "Suriname",
+		"SS": "South Sudan",
+		"ST": "São Tomé & Príncipe",
+		"SV": "El Salvador",
+		"SX": "Sint Maarten",
+		"SY": "Syria",
+		"SZ": "Swaziland",
+		"TC": "Turks & Caicos Islands",
+		"TD": "Chad",
+		"TF": "French Southern Territories",
+		"TG": "Togo",
+		"TH": "Thailand",
+		"TJ": "Tajikistan",
+		"TK": "Tokelau",
+		"TL": "Timor-Leste",
+		"TM": "Turkmenistan",
+		"TN": "Tunisia",
+		"TO": "Tonga",
+		"TR": "Turkey",
+		"TT": "Trinidad & Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taiwan",
+		"TZ": "Tanzania",
+		"UA": "Ukraine",
+		"UG": "Uganda",
+		"UM": "U.S. Outlying Islands",
+		"US": "United States",
+		"UY": "Uruguay",
+		"UZ": "Uzbekistan",
+		"VA": "Vatican City",
+		"VC": "St. Vincent & Grenadines",
+		"VE": "Venezuela",
+		"VG": "British Virgin Islands",
+		"VI": "U.S. Virgin Islands",
+		"VN": "Vietnam",
+		"VU": "Vanuatu",
+		"WF": "Wallis & Futuna",
+		"WS": "Samoa",
+		"YE": "Yemen",
+		"YT": "Mayotte",
+		"ZA": "South Africa",
+		"ZM": "Zambia",
+		"ZW": "Zimbabwe",
+	},
+	"es": {
+		"AD": "Andorra",
+		"AE": "Emiratos Árabes Unidos",
+		"AF": "Afganistán",
+		"AG": "Antigua y Barbuda",
+		"AI": "Anguila",
+		"AL": "Albania",
+		"AM": "Armenia",
+		"AO": "Angola",
+		"AQ": "Antártida",
+		"AR": "Argentina",
+		"AS": "Samoa Americana",
+		"AT": "Austria",
+		"AU": "Australia",
+		"AW": "Aruba",
+		"AX": "Islas Åland",
+		"AZ": "Azerbaiyán",
+		"BA": "Bosnia y Herzegovina",
+		"BB": "Barbados",
+		"BD": "Bangladés",
+		"BE": "Bélgica",
+		"BF": "Burkina Faso",
+		"BG": "Bulgaria",
+		"BH": "Baréin",
+		"BI": "Burundi",
+		"BJ": "Benín",
+		"BL": "San Bartolomé",
+		"BM": "Bermudas",
+		"BN": "Brunéi",
+		"BO": "Bolivia",
+		"BQ": "Caribe neerlandés",
+		"BR": "Brasil",
+		"BS": "Bahamas",
+		"BT": "Bután",
+		"BV": "Isla Bouvet",
+		"BW": "Botsuana",
+		"BY": "Bielorrusia",
+		"BZ": "Belice",
+		"CA": "Canadá",
+		"CC": "Islas Cocos",
+		"CD": "República Democrática del Congo",
+		"CF": "República Centroafricana",
+		"CG": "República del Congo",
+		"CH": "Suiza",
+		"CI": "Côte d’Ivoire",
+		"CK": "Islas Cook",
+		"CL": "Chile",
+		"CM": "Camerún",
+		"CN": "China",
+		"CO": "Colombia",
+		"CR": "Costa Rica",
+		"CU": "Cuba",
+		"CV": "Cabo Verde",
+		"CW": "Curazao",
+		"CX": "Isla de Navidad",
+		"CY": "Chipre",
+		"CZ": "Chequia",
+		"DE": "Alemania",
+		"DJ": "Yibuti",
+		"DK": "Dinamarca",
+		"DM": "Dominica",
+		"DO": "República Dominicana",
+		"DZ": "Argelia",
+		"EC": "Ecuador",
+		"EE": "Estonia",
+		"EG": "Egipto",
+		"EH": "Sáhara Occidental",
+		"ER": "Eritrea",
+		"ES": "España",
+		"ET": "Etiopía",
+		"FI": "Finlandia",
+		"FJ": "Fiyi",
+		"FK": "Islas Malvinas",
+		"FM": "Micronesia",
+		"FO": "Islas Feroe",
+		"FR": "Francia",
+		"GA": "Gabón",
+		"GB": "Reino Unido",
+		"GD": "Granada",
+		"GE": "Georgia",
+		"GF": "Guayana Francesa",
+		"GG": "Guernsey",
+		"GH": "Ghana",
+		"GI": "Gibraltar",
+		"GL": "Groenlandia",
+		"GM": "Gambia",
+		"GN": "Guinea",
+		"GP": "Guadalupe",
+		"GQ": "Guinea Ecuatorial",
+		"GR": "Grecia",
+		"GS": "Islas Georgia del Sur y Sandwich del Sur",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guinea-Bisáu",
+		"GY": "Guyana",
+		"HK": "RAE de Hong Kong (China)",
+		"HM": "Islas Heard y McDonald",
+		"HN": "Honduras",
+		"HR": "Croacia",
+		"HT": "Haití",
+		"HU": "Hungría",
+		"ID": "Indonesia",
+		"IE": "Irlanda",
+		"IL": "Israel",
+		"IM": "Isla de Man",
+		"IN": "India",
+		"IO": "Territorio Británico del Océano Índico",
+		"IQ": "Irak",
+		"IR": "Irán",
+		"IS": "Islandia",
+		"IT": "Italia",
+		"JE": "Jersey",
+		"JM": "Jamaica",
+		"JO": "Jordania",
+		"JP": "Japón",
+		"KE": "Kenia",
+		"KG": "Kirguistán",
+		"KH": "Camboya",
+		"KI": "Kiribati",
+		"KM": "Comoras",
+		"KN": "San Cristóbal y Nieves",
+		"KP": "Corea del Norte",
+		"KR": "Corea del Sur",
+		"KW": "Kuwait",
+		"KY": "Islas Caimán",
+		"KZ": "Kazajistán",
+		"LA": "Laos",
+		"LB": "Líbano",
+		"LC": "Santa Lucía",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Liberia",
+		"LS": "Lesoto",
+		"LT": "Lituania",
+		"LU": "Luxemburgo",
+		"LV": "Letonia",
+		"LY": "Libia",
+		"MA": "Marruecos",
+		"MC": "Mónaco",
+		"MD": "Moldavia",
+		"ME": "Montenegro",
+		"MF": "San Martín",
+		"MG": "Madagascar",
+		"MH": "Islas Marshall",
+		"MK": "Macedonia",
+		"ML": "Mali",
+		"MM": "Myanmar (Birmania)",
+		"MN": "Mongolia",
+		"MO": "RAE de Macao (China)",
+		"MP": "Islas Marianas del Norte",
+		"MQ": "Martinica",
+		"MR": "Mauritania",
+		"MS": "Montserrat",
+		"MT": "Malta",
+		"MU": "Mauricio",
+		"MV": "Maldivas",
+		"MW": "Malaui",
+		"MX": "México",
+		"MY": "Malasia",
+		"MZ": "Mozambique",
+		"NA": "Namibia",
+		"NC": "Nueva Caledonia",
+		"NE": "Níger",
+		"NF": "Isla Norfolk",
+		"NG": "Nigeria",
+		"NI": "Nicaragua",
+		"NL": "Países Bajos",
+		"NO": "Noruega",
+		"NP": "Nepal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "Nueva Zelanda",
+		"OM": "Omán",
+		"PA": "Panamá",
+		"PE": "Perú",
+		"PF": "Polinesia Francesa",
+		"PG": "Papúa Nueva Guinea",
+		"PH": "Filipinas",
+		"PK": "Pakistán",
+		"PL": "Polonia",
+		"PM": "San Pedro y Miquelón",
+		"PN": "Islas Pitcairn",
+		"PR": "Puerto Rico",
+		"PS": "Territorios Palestinos",
+		"PT": "Portugal",
+		"PW": "Palaos",
+		"PY": "Paraguay",
+		"QA": "Catar",
+		"RE": "Reunión",
+		"RO": "Rumanía",
+		"RS": "Serbia",
+		"RU": "Rusia",
+		"RW": "Ruanda",
+		"SA": "Arabia Saudí",
+		"SB": "Islas Salomón",
+		"SC": "Seychelles",
+		"SD": "Sudán",
+		"SE": "Suecia",
+		"SG": "Singapur",
+		"SH": "Santa Elena",
+		"SI": "Eslovenia",
+		"SJ": "Svalbard y Jan Mayen",
+		"SK": "Eslovaquia",
+		"SL": "Sierra Leona",
+		"SM": "San Marino",
+		"SN": "Senegal",
+		"SO": "Somalia",
+		"SR": "Surinam",
+		"SS": "Sudán del Sur",
+		"ST": "Santo Tomé y Príncipe",
+		"SV": "El Salvador",
+		"SX": "Sint Maarten",
+		"SY": "Siria",
+		"SZ": "Suazilandia",
+		"TC": "Islas Turcas y Caicos",
+		"TD": "Chad",
+		"TF": "Territorios Australes Franceses",
+		"TG": "Togo",
+		"TH": "Tailandia",
+		"TJ": "Tayikistán",
+		"TK": "Tokelau",
+		"TL": "Timor-Leste",
+		"TM": "Turkmenistán",
+		"TN": "Túnez",
+		"TO": "Tonga",
+		"TR": "Turquía",
+		"TT": "Trinidad y Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taiwán",
+		"TZ": "Tanzania",
+		"UA": "Ucrania",
+		"UG": "Uganda",
+		"UM": "Islas menores alejadas de EE. UU.",
+		"US": "Estados Unidos",
+		"UY": "Uruguay",
+		"UZ": "Uzbekistán",
+		"VA": "Ciudad del Vaticano",
+		"VC": "San Vicente y las Granadinas",
+		"VE": "Venezuela",
+		"VG": "Islas Vírgenes Británicas",
+		"VI": "Islas Vírgenes de EE. UU.",
+		"VN": "Vietnam",
+		"VU": "Vanuatu",
+		"WF": "Wallis y Futuna",
+		"WS": "Samoa",
+		"YE": "Yemen",
+		"YT": "Mayotte",
+		"ZA": "Sudáfrica",
+		"ZM": "Zambia",
+		"ZW": "Zimbabue",
+	},
+	"fr": {
+		"AD": "Andorre",
+		"AE": "Émirats arabes unis",
+		"AF": "Afghanistan",
+		"AG": "Antigua-et-Barbuda",
+		"AI": "Anguilla",
+		"AL": "Albanie",
+		"AM": "Arménie",
+		"AO": "Angola",
+		"AQ": "Antarctique",
+		"AR": "Argentine",
+		"AS": "Samoa américaines",
+		"AT": "Autriche",
+		"AU": "Australie",
+		"AW": "Aruba",
+		"AX": "Îles Åland",
+		"AZ": "Azerbaïdjan",
+		"BA": "Bosnie-Herzégovine",
+		"BB": "Barbade",
+		"BD": "Bangladesh",
+		"BE": "Belgique",
+		"BF": "Burkina Faso",
+		"BG": "Bulgarie",
+		"BH": "Bahreïn",
+		"BI": "Burundi",
+		"BJ": "Bénin",
+		"BL": "Saint-Barthélemy",
+		"BM": "Bermudes",
+		"BN": "Brunéi Darussalam",
+		"BO": "Bolivie",
+		"BQ": "Pays-Bas caribéens",
+		"BR": "Brésil",
+		"BS": "Bahamas",
+		"BT": "Bhoutan",
+		"BV": "Île Bouvet",
+		"BW": "Botswana",
+		"BY": "Biélorussie",
+		"BZ": "Belize",
+		"CA": "Canada",
+		"CC": "Îles Cocos",
+		"CD": "Congo-Kinshasa",
+		"CF": "République centrafricaine",
+		"CG": "Congo-Brazzaville",
+		"CH": "Suisse",
+		"CI": "Côte d’Ivoire",
+		"CK": "Îles Cook",
+		"CL": "Chili",
+		"CM": "Cameroun",
+		"CN": "Chine",
+		"CO": "Colombie",
+		"CR": "Costa Rica",
+		"CU": "Cuba",
+		"CV": "Cap-Vert",
+		"CW": "Curaçao",
+		"CX": "Île Christmas",
+		"CY": "Chypre",
+		"CZ": "Tchéquie",
+		"DE": "Allemagne",
+		"DJ": "Djibouti",
+		"DK": "Danemark",
+		"DM": "Dominique",
+		"DO": "République dominicaine",
+		"DZ": "Algérie",
+		"EC": "Équateur",
+		"EE": "Estonie",
+		"EG": "Égypte",
+		"EH": "Sahara occidental",
+		"ER": "Érythrée",
+		"ES": "Espagne",
+		"ET": "Éthiopie",
+		"FI": "Finlande",
+		"FJ": "Fidji",
+		"FK": "Îles Malouines",
+		"FM": "États fédérés de Micronésie",
+		"FO": "Îles Féroé",
+		"FR": "France",
+		"GA": "Gabon",
+		"GB": "Royaume-Uni",
+		"GD": "Grenade",
+		"GE": "Géorgie",
+		"GF": "Guyane française",
+		"GG": "Guernesey",
+		"GH": "Ghana",
+		"GI": "Gibraltar",
+		"GL": "Groenland",
+		"GM": "Gambie",
+		"GN": "Guinée",
+		"GP": "Guadeloupe",
+		"GQ": "Guinée équatoriale",
+		"GR": "Grèce",
+		"GS": "Géorgie du Sud et îles Sandwich du Sud",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guinée-Bissau",
+		"GY": "Guyana",
+		"HK": "R.A.S. chinoise de Hong Kong",
+		"HM": "Îles Heard et McDonald",
+		"HN": "Honduras",
+		"HR": "Croatie",
+		"HT": "Haïti",
+		"HU": "Hongrie",
+		"ID": "Indonésie",
+		"IE": "Irlande",
+		"IL": "Israël",
+		"IM": "Île de Man",
+		"IN": "Inde",
+		"IO": "Territoire britannique de l’océan Indien",
+		"IQ": "Irak",
+		"IR": "Iran",
+		"IS": "Islande",
+		"IT": "Italie",
+		"JE": "Jersey",
+		"JM": "Jamaïque",
+		"JO": "Jordanie",
+		"JP": "Japon",
+		"KE": "Kenya",
+		"KG": "Kirghizistan",
+		"KH": "Cambodge",
+		"KI": "Kiribati",
+		"KM": "Comores",
+		"KN": "Saint-Christophe-et-Niévès",
+		"KP": "Corée du Nord",
+		"KR": "Corée du Sud",
+		"KW": "Koweït",
+		"KY": "Îles Caïmans",
+		"KZ": "Kazakhstan",
+		"LA": "Laos",
+		"LB": "Liban",
+		"LC": "Sainte-Lucie",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Libéria",
+		"LS": "Lesotho",
+		"LT": "Lituanie",
+		"LU": "Luxembourg",
+		"LV": "Lettonie",
+		"LY": "Libye",
+		"MA": "Maroc",
+		"MC": "Monaco",
+		"MD": "Moldavie",
+		"ME": "Monténégro",
+		"MF": "Saint-Martin",
+		"MG": "Madagascar",
+		"MH": "Îles Marshall",
+		"MK": "Macédoine",
+		"ML": "Mali",
+		"MM": "Myanmar (Birmanie)",
+		"MN": "Mongolie",
+		"MO": "R.A.S. chinoise de Macao",
+		"MP": "Îles Mariannes du Nord",
+		"MQ": "Martinique",
+		"MR": "Mauritanie",
+		"MS": "Montserrat",
+		"MT": "Malte",
+		"MU": "Maurice",
+		"MV": "Maldives",
+		"MW": "Malawi",
+		"MX": "Mexique",
+		"MY": "Malaisie",
+		"MZ": "Mozambique",
+		"NA": "Namibie",
+		"NC": "Nouvelle-Calédonie",
+		"NE": "Niger",
+		"NF": "Île Norfolk",
+		"NG": "Nigéria",
+		"NI": "Nicaragua",
+		"NL": "Pays-Bas",
+		"NO": "Norvège",
+		"NP": "Népal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "Nouvelle-Zélande",
+		"OM": "Oman",
+		"PA": "Panama",
+		"PE": "Pérou",
+		"PF": "Polynésie française",
+		"PG": "Papouasie-Nouvelle-Guinée",
+		"PH": "Philippines",
+		"PK": "Pakistan",
+		"PL": "Pologne",
+		"PM": "Saint-Pierre-et-Miquelon",
+		"PN": "Îles Pitcairn",
+		"PR": "Porto Rico",
+		"PS": "Territoires palestiniens",
+		"PT": "Portugal",
+		"PW": "Palaos",
+		"PY": "Paraguay",
+		"QA": "Qatar",
+		"RE": "La Réunion",
+		"RO": "Roumanie",
+		"RS": "Serbie",
+		"RU": "Russie",
+		"RW": "Rwanda",
+		"SA": "Arabie saoudite",
+		"SB": "Îles Salomon",
+		"SC": "Seychelles",
+		"SD": "Soudan",
+		"SE": "Suède",
+		"SG": "Singapour",
+		"SH": "Sainte-Hélène",
+		"SI": "Slovénie",
+		"SJ": "Svalbard et Jan Mayen",
+		"SK": "Slovaquie",
+		"SL": "Sierra Leone",
+		"SM": "Saint-Marin",
+		"SN": "Sénégal",
+		"SO": "Somalie",
+		"SR": "Suriname",
+		"SS": "Soudan du Sud",
+		"ST": "Sao Tomé-et-Principe",
+		"SV": "Salvador",
+		"SX": "Saint-Martin (partie néerlandaise)",
+		"SY": "Syrie",
+		"SZ": "Swaziland",
+		"TC": "Îles Turques-et-Caïques",
+		"TD": "Tchad",
+		"TF": "Terres australes françaises",
+		"TG": "Togo",
+		"TH": "Thaïlande",
+		"TJ": "Tadjikistan",
+		"TK": "Tokélaou",
+		"TL": "Timor oriental",
+		"TM": "Turkménistan",
+		"TN": "Tunisie",
+		"TO": "Tonga",
+		"TR": "Turquie",
+		"TT": "Trinité-et-Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taïwan",
+		"TZ": "Tanzanie",
+		"UA": "Ukraine",
+		"UG": "Ouganda",
+		"UM": "Îles mineures éloignées des États-Unis",
+		"US": "États-Unis",
+		"UY": "Uruguay",
+		"UZ": "Ouzbékistan",
+		"VA": "État de la Cité du Vatican",
+		"VC": "Saint-Vincent-et-les-Grenadines",
+		"VE": "Venezuela",
+		"VG": "Îles Vierges britanniques",
+		"VI": "Îles Vierges des États-Unis",
+		"VN": "Vietnam",
+		"VU": "Vanuatu",
+		"WF": "Wallis-et-Futuna",
+		"WS": "Samoa",
+		"YE": "Yémen",
+		"YT": "Mayotte",
+		"ZA": "Afrique du Sud",
+		"ZM": "Zambie",
+		"ZW": "Zimbabwe",
+	},
+	"ja": {
+		"AD": "アンドラ",
+		"AE": "アラブ首長国連邦",
+		"AF": "アフガニスタン",
+		"AG": "アンティグア・バーブーダ",
+		"AI": "アンギラ",
+		"AL": "アルバニア",
+		"AM": "アルメニア",
+		"AO": "アンゴラ",
+		"AQ": "南極",
+		"AR": "アルゼンチン",
+		"AS": "米領サモア",
+		"AT": "オーストリア",
+		"AU": "オーストラリア",
+		"AW": "アルバ",
+		"AX": "オーランド諸島",
+		"AZ": "アゼルバイジャン",
+		"BA": "ボスニア・ヘルツェゴビナ",
+		"BB": "バルバドス",
+		"BD": "バングラデシュ",
+		"BE": "ベルギー",
+		"BF": "ブルキナファソ",
+		"BG": "ブルガリア",
+		"BH": "バーレーン",
+		"BI": "ブルンジ",
+		"BJ": "ベナン",
+		"BL": "サン・バルテルミー",
+		"BM": "バミューダ",
+		"BN": "ブルネイ",
+		"BO": "ボリビア",
+		"BQ": "オランダ領カリブ",
+		"BR": "ブラジル",
+		"BS": "バハマ",
+		"BT": "ブータン",
+		"BV": "ブーベ島",
+		"BW": "ボツワナ",
+		"BY": "ベラルーシ",
+		"BZ": "ベリーズ",
+		"CA": "カナダ",
+		"CC": "ココス(キーリング)諸島",
+		"CD": "コンゴ民主共和国(キンシャサ)",
+		"CF": "中央アフリカ共和国",
+		"CG": "コンゴ共和国(ブラザビル)",
+		"CH": "スイス",
+		"CI": "コートジボワール",
+		"CK": "クック諸島",
+		"CL": "チリ",
+		"CM": "カメルーン",
+		"CN": "中国",
+		"CO": "コロンビア",
+		"CR": "コスタリカ",
+		"CU": "キューバ",
+		"CV": "カーボベルデ",
+		"CW": "キュラソー",
+		"CX": "クリスマス島",
+		"CY": "キプロス",
+		"CZ": "チェコ",
+		"DE": "ドイツ",
+		"DJ": "ジブチ",
+		"DK": "デンマーク",
+		"DM": "ドミニカ国",
+		"DO": "ドミニカ共和国",
+		"DZ": "アルジェリア",
+		"EC": "エクアドル",
+		"EE": "エストニア",
+		"EG": "エジプト",
+		"EH": "西サハラ",
+		"ER": "エリトリア",
+		"ES": "スペイン",
+		"ET": "エチオピア",
+		"FI": "フィンランド",
+		"FJ": "フィジー",
+		"FK": "フォークランド諸島",
+		"FM": "ミクロネシア連邦",
+		"FO": "フェロー諸島",
+		"FR": "フランス",
+		"GA": "ガボン",
+		"GB": "イギリス",
+		"GD": "グレナダ",
+		"GE": "ジョージア",
+		"GF": "仏領ギアナ",
+		"GG": "ガーンジー",
+		"GH": "ガーナ",
+		"GI": "ジブラルタル",
+		"GL": "グリーンランド",
+		"GM": "ガンビア",
+		"GN": "ギニア",
+		"GP": "グアドループ",
+		"GQ": "赤道ギニア",
+		"GR": "ギリシャ",
+		"GS": "サウスジョージア・サウスサンドウィッチ諸島",
+		"GT": "グアテマラ",
+		"GU": "グアム",
+		"GW": "ギニアビサウ",
+		"GY": "ガイアナ",
+		"HK": "中華人民共和国香港特別行政区",
+		"HM": "ハード島・マクドナルド諸島",
+		"HN": "ホンジュラス",
+		"HR": "クロアチア",
+		"HT": "ハイチ",
+		"HU": "ハンガリー",
+		"ID": "インドネシア",
+		"IE": "アイルランド",
+		"IL": "イスラエル",
+		"IM": "マン島",
+		"IN": "インド",
+		"IO": "英領インド洋地域",
+		"IQ": "イラク",
+		"IR": "イラン",
+		"IS": "アイスランド",
+		"IT": "イタリア",
+		"JE": "ジャージー",
+		"JM": "ジャマイカ",
+		"JO": "ヨルダン",
+		"JP": "日本",
+		"KE": "ケニア",
+		"KG": "キルギス",
+		"KH": "カンボジア",
+		"KI": "キリバス",
+		"KM": "コモロ",
+		"KN": "セントクリストファー・ネーヴィス",
+		"KP": "北朝鮮",
+		"KR": "韓国",
+		"KW": "クウェート",
+		"KY": "ケイマン諸島",
+		"KZ": "カザフスタン",
+		"LA": "ラオス",
+		"LB": "レバノン",
+		"LC": "セントルシア",
+		"LI": "リヒテンシュタイン",
+		"LK": "スリランカ",
+		"LR": "リベリア",
+		"LS": "レソト",
+		"LT": "リトアニア",
+		"LU": "ルクセンブルク",
+		"LV": "ラトビア",
+		"LY": "リビア",
+		"MA": "モロッコ",
+		"MC": "モナコ",
+		"MD": "モルドバ",
+		"ME": "モンテネグロ",
+		"MF": "サン・マルタン",
+		"MG": "マダガスカル",
+		"MH": "マーシャル諸島",
+		"MK": "マケドニア",
+		"ML": "マリ",
+		"MM": "ミャンマー (ビルマ)",
+		"MN": "モンゴル",
+		"MO": "中華人民共和国マカオ特別行政区",
+		"MP": "北マリアナ諸島",
+		"MQ": "マルティニーク",
+		"MR": "モーリタニア",
+		"MS": "モントセラト",
+		"MT": "マルタ",
+		"MU": "モーリシャス",
+		"MV": "モルディブ",
+		"MW": "マラウイ",
+		"MX": "メキシコ",
+		"MY": "マレーシア",
+		"MZ": "モザンビーク",
+		"NA": "ナミビア",
+		"NC": "ニューカレドニア",
+		"NE": "ニジェール",
+		"NF": "ノーフォーク島",
+		"NG": "ナイジェリア",
+		"NI": "ニカラグア",
+		"NL": "オランダ",
+		"NO": "ノルウェー",
+		"NP": "ネパール",
+		"NR": "ナウル",
+		"NU": "ニウエ",
+		"NZ": "ニュージーランド",
+		"OM": "オマーン",
+		"PA": "パナマ",
+		"PE": "ペルー",
+		"PF": "仏領ポリネシア",
+		"PG": "パプアニューギニア",
+		"PH": "フィリピン",
+		"PK": "パキスタン",
+		"PL": "ポーランド",
+		"PM": "サンピエール島・ミクロン島",
+		"PN": "ピトケアン諸島",
+		"PR": "プエルトリコ",
+		"PS": "パレスチナ自治区",
+		"PT": "ポルトガル",
+		"PW": "パラオ",
+		"PY": "パラグアイ",
+		"QA": "カタール",
+		"RE": "レユニオン",
+		"RO": "ルーマニア",
+		"RS": "セルビア",
+		"RU": "ロシア",
+		"RW": "ルワンダ",
+		"SA": "サウジアラビア",
+		"SB": "ソロモン諸島",
+		"SC": "セーシェル",
+		"SD": "スーダン",
+		"SE": "スウェーデン",
+		"SG": "シンガポール",
+		"SH": "セントヘレナ",
+		"SI": "スロベニア",
+		"SJ": "スバールバル諸島・ヤンマイエン島",
+		"SK": "スロバキア",
+		"SL": "シエラレオネ",
+		"SM": "サンマリノ",
+		"SN": "セネガル",
+		"SO": "ソマリア",
+		"SR": "スリナム",
+		"SS": "南スーダン",
+		"ST": "サントメ・プリンシペ",
+		"SV": "エルサルバドル",
+		"SX": "シント・マールテン",
+		"SY": "シリア",
+		"SZ": "スワジランド",
+		"TC": "タークス・カイコス諸島",
+		"TD": "チャド",
+		"TF": "仏領極南諸島",
+		"TG": "トーゴ",
+		"TH": "タイ",
+		"TJ": "タジキスタン",
+		"TK": "トケラウ",
+		"TL": "東ティモール",
+		"TM": "トルクメニスタン",
+		"TN": "チュニジア",
+		"TO": "トンガ",
+		"TR": "トルコ",
+		"TT": "トリニダード・トバゴ",
+		"TV": "ツバル",
+		"TW": "台湾",
+		"TZ": "タンザニア",
+		"UA": "ウクライナ",
+		"UG": "ウガンダ",
+		"UM": "合衆国領有小離島",
+		"US": "アメリカ合衆国",
+		"UY": "ウルグアイ",
+		"UZ": "ウズベキスタン",
+		"VA": "バチカン市国",
+		"VC": "セントビンセント及びグレナディーン諸島",
+		"VE": "ベネズエラ",
+		"VG": "英領ヴァージン諸島",
+		"VI": "米領ヴァージン諸島",
+		"VN": "ベトナム",
+		"VU": "バヌアツ",
+		"WF": "ウォリス・フツナ",
+		"WS": "サモア",
+		"YE": "イエメン",
+		"YT": "マヨット",
+		"ZA": "南アフリカ",
+		"ZM": "ザンビア",
+		"ZW": "ジンバブエ",
+	},
+	"pt-BR": {
+		"AD": "Andorra",
+		"AE": "Emirados Árabes Unidos",
+		"AF": "Afeganistão",
+		"AG": "Antígua e Barbuda",
+		"AI": "Anguilla",
+		"AL": "Albânia",
+		"AM": "Armênia",
+		"AO": "Angola",
+		"AQ": "Antártida",
+		"AR": "Argentina",
+		"AS": "Samoa Americana",
+		"AT": "Áustria",
+		"AU": "Austrália",
+		"AW": "Aruba",
+		"AX": "Ilhas Aland",
+		"AZ": "Azerbaijão",
+		"BA": "Bósnia e Herzegovina",
+		"BB": "Barbados",
+		"BD": "Bangladesh",
+		"BE": "Bélgica",
+		"BF": "Burquina Faso",
+		"BG": "Bulgária",
+		"BH": "Bahrein",
+		"BI": "Burundi",
+		"BJ": "Benin",
+		"BL": "São Bartolomeu",
+		"BM": "Bermudas",
+		"BN": "Brunei",
+		"BO": "Bolívia",
+		"BQ": "Países Baixos Caribenhos",
+		"BR": "Brasil",
+		"BS": "Bahamas",
+		"BT": "Butão",
+		"BV": "Ilha Bouvet",
+		"BW": "Botsuana",
+		"BY": "Bielorrússia",
+		"BZ": "Belize",
+		"CA": "Canadá",
+		"CC": "Ilhas Cocos (Keeling)",
+		"CD": "Congo - Kinshasa",
+		"CF": "República Centro-Africana",
+		"CG": "Congo - Brazzaville",
+		"CH": "Suíça",
+		"CI": "Costa do Marfim",
+		"CK": "Ilhas Cook",
+		"CL": "Chile",
+		"CM": "Camarões",
+		"CN": "China",
+		"CO": "Colômbia",
+		"CR": "Costa Rica",
+		"CU": "Cuba",
+		"CV": "Cabo Verde",
+		"CW": "Curaçao",
+		"CX": "Ilha Christmas",
+		"CY": "Chipre",
+		"CZ": "Tchéquia",
+		"DE": "Alemanha",
+		"DJ": "Djibuti",
+		"DK": "Dinamarca",
+		"DM": "Dominica",
+		"DO": "República Dominicana",
+		"DZ": "Argélia",
+		"EC": "Equador",
+		"EE": "Estônia",
+		"EG": "Egito",
+		"EH": "Saara Ocidental",
+		"ER": "Eritreia",
+		"ES": "Espanha",
+		"ET": "Etiópia",
+		"FI": "Finlândia",
+		"FJ": "Fiji",
+		"FK": "Ilhas Malvinas",
+		"FM": "Micronésia",
+		"FO": "Ilhas Faroe",
+		"FR": "França",
+		"GA": "Gabão",
+		"GB": "Reino Unido",
+		"GD": "Granada",
+		"GE": "Geórgia",
+		"GF": "Guiana Francesa",
+		"GG": "Guernsey",
+		"GH": "Gana",
+		"GI": "Gibraltar",
+		"GL": "Groenlândia",
+		"GM": "Gâmbia",
+		"GN": "Guiné",
+		"GP": "Guadalupe",
+		"GQ": "Guiné Equatorial",
+		"GR": "Grécia",
+		"GS": "Ilhas Geórgia do Sul e Sandwich do Sul",
+		"GT": "Guatemala",
+		"GU": "Guam",
+		"GW": "Guiné-Bissau",
+		"GY": "Guiana",
+		"HK": "Hong Kong, RAE da China",
+		"HM": "Ilhas Heard e McDonald",
+		"HN": "Honduras",
+		"HR": "Croácia",
+		"HT": "Haiti",
+		"HU": "Hungria",
+		"ID": "Indonésia",
+		"IE": "Irlanda",
+		"IL": "Israel",
+		"IM": "Ilha de Man",
+		"IN": "Índia",
+		"IO": "Território Britânico do Oceano Índico",
+		"IQ": "Iraque",
+		"IR": "Irã",
+		"IS": "Islândia",
+		"IT": "Itália",
+		"JE": "Jersey",
+		"JM": "Jamaica",
+		"JO": "Jordânia",
+		"JP": "Japão",
+		"KE": "Quênia",
+		"KG": "Quirguistão",
+		"KH": "Camboja",
+		"KI": "Quiribati",
+		"KM": "Comores",
+		"KN": "São Cristóvão e Névis",
+		"KP": "Coreia do Norte",
+		"KR": "Coreia do Sul",
+		"KW": "Kuwait",
+		"KY": "Ilhas Cayman",
+		"KZ": "Cazaquistão",
+		"LA": "Laos",
+		"LB": "Líbano",
+		"LC": "Santa Lúcia",
+		"LI": "Liechtenstein",
+		"LK": "Sri Lanka",
+		"LR": "Libéria",
+		"LS": "Lesoto",
+		"LT": "Lituânia",
+		"LU": "Luxemburgo",
+		"LV": "Letônia",
+		"LY": "Líbia",
+		"MA": "Marrocos",
+		"MC": "Mônaco",
+		"MD": "Moldávia",
+		"ME": "Montenegro",
+		"MF": "São Martinho",
+		"MG": "Madagascar",
+		"MH": "Ilhas Marshall",
+		"MK": "Macedônia",
+		"ML": "Mali",
+		"MM": "Mianmar (Birmânia)",
+		"MN": "Mongólia",
+		"MO": "Macau, RAE da China",
+		"MP": "Ilhas Marianas do Norte",
+		"MQ": "Martinica",
+		"MR": "Mauritânia",
+		"MS": "Montserrat",
+		"MT": "Malta",
+		"MU": "Maurício",
+		"MV": "Maldivas",
+		"MW": "Malaui",
+		"MX": "México",
+		"MY": "Malásia",
+		"MZ": "Moçambique",
+		"NA": "Namíbia",
+		"NC": "Nova Caledônia",
+		"NE": "Níger",
+		"NF": "Ilha Norfolk",
+		"NG": "Nigéria",
+		"NI": "Nicarágua",
+		"NL": "Holanda",
+		"NO": "Noruega",
+		"NP": "Nepal",
+		"NR": "Nauru",
+		"NU": "Niue",
+		"NZ": "Nova Zelândia",
+		"OM": "Omã",
+		"PA": "Panamá",
+		"PE": "Peru",
+		"PF": "Polinésia Francesa",
+		"PG": "Papua-Nova Guiné",
+		"PH": "Filipinas",
+		"PK": "Paquistão",
+		"PL": "Polônia",
+		"PM": "São Pedro e Miquelão",
+		"PN": "Ilhas Pitcairn",
+		"PR": "Porto Rico",
+		"PS": "Territórios palestinos",
+		"PT": "Portugal",
+		"PW": "Palau",
+		"PY": "Paraguai",
+		"QA": "Catar",
+		"RE": "Reunião",
+		"RO": "Romênia",
+		"RS": "Sérvia",
+		"RU": "Rússia",
+		"RW": "Ruanda",
+		"SA": "Arábia Saudita",
+		"SB": "Ilhas Salomão",
+		"SC": "Seicheles",
+		"SD": "Sudão",
+		"SE": "Suécia",
+		"SG": "Singapura",
+		"SH": "Santa Helena",
+		"SI": "Eslovênia",
+		"SJ": "Svalbard e Jan Mayen",
+		"SK": "Eslováquia",
+		"SL": "Serra Leoa",
+		"SM": "San Marino",
+		"SN": "Senegal",
+		"SO": "Somália",
+		"SR": "Suriname",
+		"SS": "Sudão do Sul",
+		"ST": "São Tomé e Príncipe",
+		"SV": "El Salvador",
+		"SX": "Sint Maarten",
+		"SY": "Síria",
+		"SZ": "Suazilândia",
+		"TC": "Ilhas Turks e Caicos",
+		"TD": "Chade",
+		"TF": "Territórios Franceses do Sul",
+		"TG": "Togo",
+		"TH": "Tailândia",
+		"TJ": "Tadjiquistão",
+		"TK": "Tokelau",
+		"TL": "Timor-Leste",
+		"TM": "Turcomenistão",
+		"TN": "Tunísia",
+		"TO": "Tonga",
+		"TR": "Turquia",
+		"TT": "Trinidad e Tobago",
+		"TV": "Tuvalu",
+		"TW": "Taiwan",
+		"TZ": "Tanzânia",
+		"UA": "Ucrânia",
+		"UG": "Uganda",
+		"UM": "Ilhas Menores Distantes dos EUA",
+		"US": "Estados Unidos",
+		"UY": "Uruguai",
+		"UZ": "Uzbequistão",
+		"VA": "Cidade do Vaticano",
+		"VC": "São Vicente e Granadinas",
+		"VE": "Venezuela",
+		"VG": "Ilhas Virgens Britânicas",
+		"VI": "Ilhas Virgens Americanas",
+		"VN": "Vietnã",
+		"VU": "Vanuatu",
+		"WF": "Wallis e Futuna",
+		"WS": "Samoa",
+		"YE": "Iêmen",
+		"YT": "Mayotte",
+		"ZA": "África do Sul",
+		"ZM": "Zâmbia",
+		"ZW": "Zimbábue",
+	},
+	"ru": {
+		"AD": "Андорра",
+		"AE": "ОАЭ",
+		"AF": "Афганистан",
+		"AG": "Антигуа и Барбуда",
+		"AI": "Ангилья",
+		"AL": "Албания",
+		"AM": "Армения",
+		"AO": "Ангола",
+		"AQ": "Антарктида",
+		"AR": "Аргентина",
+		"AS": "Американское Самоа",
+		"AT": "Австрия",
+		"AU": "Австралия",
+		"AW": "Аруба",
+		"AX": "Аландские о-ва",
+		"AZ": "Азербайджан",
+		"BA": "Босния и Герцеговина",
+		"BB": "Барбадос",
+		"BD": "Бангладеш",
+		"BE": "Бельгия",
+		"BF": "Буркина-Фасо",
+		"BG": "Болгария",
+		"BH": "Бахрейн",
+		"BI": "Бурунди",
+		"BJ": "Бенин",
+		"BL": "Сен-Бартелеми",
+		"BM": "Бермудские о-ва",
+		"BN": "Бруней-Даруссалам",
+		"BO": "Боливия",
+		"BQ": "Бонэйр, Синт-Эстатиус и Саба",
+		"BR": "Бразилия",
+		"BS": "Багамы",
+		"BT": "Бутан",
+		"BV": "о-в Буве",
+		"BW": "Ботсвана",
+		"BY": "Беларусь",
+		"BZ": "Белиз",
+		"CA": "Канада",
+		"CC": "Кокосовые о-ва",
+		"CD": "Конго - Киншаса",
+		"CF": "Центрально-Африканская Республика",
+		"CG": "Конго - Браззавиль",
+		"CH": "Швейцария",
+		"CI": "Кот-д’Ивуар",
+		"CK": "Острова Кука",
+		"CL": "Чили",
+		"CM": "Камерун",
+		"CN": "Китай",
+		"CO": "Колумбия",
+		"CR": "Коста-Рика",
+		"CU": "Куба",
+		"CV": "Кабо-Верде",
+		"CW": "Кюрасао",
+		"CX": "о-в Рождества",
+		"CY": "Кипр",
+		"CZ": "Чехия",
+		"DE": "Германия",
+		"DJ": "Джибути",
+		"DK": "Дания",
+		"DM": "Доминика",
+		"DO": "Доминиканская Республика",
+		"DZ": "Алжир",
+		"EC": "Эквадор",
+		"EE": "Эстония",
+		"EG": "Египет",
+		"EH": "Западная Сахара",
+		"ER": "Эритрея",
+		"ES": "Испания",
+		"ET": "Эфиопия",
+		"FI": "Финляндия",
+		"FJ": "Фиджи",
+		"FK": "Фолклендские о-ва",
+		"FM": "Федеративные Штаты Микронезии",
+		"FO": "Фарерские о-ва",
+		"FR": "Франция",
+		"GA": "Габон",
+		"GB": "Великобритания",
+		"GD": "Гренада",
+		"GE": "Грузия",
+		"GF": "Французская Гвиана",
+		"GG": "Гернси",
+		"GH": "Гана",
+		"GI": "Гибралтар",
+		"GL": "Гренландия",
+		"GM": "Гамбия",
+		"GN": "Гвинея",
+		"GP": "Гваделупа",
+		"GQ": "Экваториальная Гвинея",
+		"GR": "Греция",
+		"GS": "Южная Георгия и Южные Сандвичевы о-ва",
+		"GT": "Гватемала",
+		"GU": "Гуам",
+		"GW": "Гвинея-Бисау",
+		"GY": "Гайана",
+		"HK": "Гонконг (САР)",
+		"HM": "о-ва Херд и Макдональд",
+		"HN": "Гондурас",
+		"HR": "Хорватия",
+		"HT": "Гаити",
+		"HU": "Венгрия",
+		"ID": "Индонезия",
+		"IE": "Ирландия",
+		"IL": "Израиль",
+		"IM": "о-в Мэн",
+		"IN": "Индия",
+		"IO": "Британская территория в Индийском океане",
+		"IQ": "Ирак",
+		"IR": "Иран",
+		"IS": "Исландия",
+		"IT": "Италия",
+		"JE": "Джерси",
+		"JM": "Ямайка",
+		"JO": "Иордания",
+		"JP": "Япония",
+		"KE": "Кения",
+		"KG": "Киргизия",
+		"KH": "Камбоджа",
+		"KI": "Кирибати",
+		"KM": "Коморы",
+		"KN": "Сент-Китс и Невис",
+		"KP": "КНДР",
+		"KR": "Республика Корея",
+		"KW": "Кувейт",
+		"KY": "Каймановы о-ва",
+		"KZ": "Казахстан",
+		"LA": "Лаос",
+		"LB": "Ливан",
+		"LC": "Сент-Люсия",
+		"LI": "Лихтенштейн",
+		"LK": "Шри-Ланка",
+		"LR": "Либерия",
+		"LS": "Лесото",
+		"LT": "Литва",
+		"LU": "Люксембург",
+		"LV": "Латвия",
+		"LY": "Ливия",
+		"MA": "Марокко",
+		"MC": "Монако",
+		"MD": "Молдова",
+		"ME": "Черногория",
+		"MF": "Сен-Мартен",
+		"MG": "Мадагаскар",
+		"MH": "Маршалловы Острова",
+		"MK": "Македония",
+		"ML": "Мали",
+		"MM": "Мьянма (Бирма)",
+		"MN": "Монголия",
+		"MO": "Макао (САР)",
+		"MP": "Северные Марианские о-ва",
+		"MQ": "Мартиника",
+		"MR": "Мавритания",
+		"MS": "Монтсеррат",
+		"MT": "Мальта",
+		"MU": "Маврикий",
+		"MV": "Мальдивы",
+		"MW": "Малави",
+		"MX": "Мексика",
+		"MY": "Малайзия",
+		"MZ": "Мозамбик",
+		"NA": "Намибия",
+		"NC": "Новая Каледония",
+		"NE": "Нигер",
+		"NF": "о-в Норфолк",
+		"NG": "Нигерия",
+		"NI": "Никарагуа",
+		"NL": "Нидерланды",
+		"NO": "Норвегия",
+		"NP": "Непал",
+		"NR": "Науру",
+		"NU": "Ниуэ",
+		"NZ": "Новая Зеландия",
+		"OM": "Оман",
+		"PA": "Панама",
+		"PE": "Перу",
+		"PF": "Французская Полинезия",
+		"PG": "Папуа — Новая Гвинея",
+		"PH": "Филиппины",
+		"PK": "Пакистан",
+		"PL": "Польша",
+		"PM": "Сен-Пьер и Микелон",
+		"PN": "острова Питкэрн",
+		"PR": "Пуэрто-Рико",
+		"PS": "Палестинские территории",
+		"PT": "Португалия",
+		"PW": "Палау",
+		"PY": "Парагвай",
+		"QA": "Катар",
+		"RE": "Реюньон",
+		"RO": "Румыния",
+		"RS": "Сербия",
+		"RU": "Россия",
+		"RW": "Руанда",
+		"SA": "Саудовская Аравия",
+		"SB": "Соломоновы Острова",
+		"SC": "Сейшельские Острова",
+		"SD": "Судан",
+		"SE": "Швеция",
+		"SG": "Сингапур",
+		"SH": "о-в Св. Елены",
+		"SI": "Словения",
+		"SJ": "Шпицберген и Ян-Майен",
+		"SK": "Словакия",
+		"SL": "Сьерра-Леоне",
+		"SM": "Сан-Марино",
+		"SN": "Сенегал",
+		"SO": "Сомали",
+		"SR": "Суринам",
+		"SS": "Южный Судан",
+		"ST": "Сан-Томе и Принсипи",
+		"SV": "Сальвадор",
+		"SX": "Синт-Мартен",
+		"SY": "Сирия",
+		"SZ": "Свазиленд",
+		"TC": "о-ва Тёркс и Кайкос",
+		"TD": "Чад",
+		"TF": "Французские Южные территории",
+		"TG": "Того",
+		"TH": "Таиланд",
+		"TJ": "Таджикистан",
+		"TK": "Токелау",
+		"TL": "Восточный Тимор",
+		"TM": "Туркменистан",
+		"TN": "Тунис",
+		"TO": "Тонга",
+		"TR": "Турция",
+		"TT": "Тринидад и Тобаго",
+		"TV": "Тувалу",
+		"TW": "Тайвань",
+		"TZ": "Танзания",
+		"UA": "Украина",
+		"UG": "Уганда",
+		"UM": "Внешние малые о-ва (США)",
+		"US": "Соединенные Штаты",
+		"UY": "Уругвай",
+		"UZ": "Узбекистан",
+		"VA": "Ватикан",
+		"VC": "Сент-Винсент и Гренадины",
+		"VE": "Венесуэла",
+		"VG": "Виргинские о-ва (Британские)",
+		"VI": "Виргинские о-ва (США)",
+		"VN": "Вьетнам",
+		"VU": "Вануату",
+		"WF": "Уоллис и Футуна",
+		"WS": "Самоа",
+		"YE": "Йемен",
+		"YT": "Майотта",
+		"ZA": "Южно-Африканская Республика",
+		"ZM": "Замбия",
+		"ZW": "Зимбабве",
+	},
+	"zh-CN": {
+		"AD": "安道尔",
+		"AE": "阿拉伯联合酋长国",
+		"AF": "阿富汗",
+		"AG": "安提瓜和巴布达",
+		"AI": "安圭拉",
+		"AL": "阿尔巴尼亚",
+		"AM": "亚美尼亚",
+		"AO": "安哥拉",
+		"AQ": "南极洲",
+		"AR": "阿根廷",
+		"AS": "美属萨摩亚",
+		"AT": "奥地利",
+		"AU": "澳大利亚",
+		"AW": "阿鲁巴",
+		"AX": "奥兰群岛",
+		"AZ": "阿塞拜疆",
+		"BA": "波斯尼亚和黑塞哥维那",
+		"BB": "巴巴多斯",
+		"BD": "孟加拉国",
+		"BE": "比利时",
+		"BF": "布基纳法索",
+		"BG": "保加利亚",
+		"BH": "巴林",
+		"BI": "布隆迪",
+		"BJ": "贝宁",
+		"BL": "圣巴泰勒米",
+		"BM": "百慕大",
+		"BN": "文莱",
+		"BO": "玻利维亚",
+		"BQ": "荷属加勒比区",
+		"BR": "巴西",
+		"BS": "巴哈马",
+		"BT": "不丹",
+		"BV": "布韦岛",
+		"BW": "博茨瓦纳",
+		"BY": "白俄罗斯",
+		"BZ": "伯利兹",
+		"CA": "加拿大",
+		"CC": "科科斯（基林）群岛",
+		"CD": "刚果（金）",
+		"CF": "中非共和国",
+		"CG": "刚果（布）",
+		"CH": "瑞士",
+		"CI": "科特迪瓦",
+		"CK": "库克群岛",
+		"CL": "智利",
+		"CM": "喀麦隆",
+		"CN": "中国",
+		"CO": "哥伦比亚",
+		"CR": "哥斯达黎加",
+		"CU": "古巴",
+		"CV": "佛得角",
+		"CW": "库拉索",
+		"CX": "圣诞岛",
+		"CY": "塞浦路斯",
+		"CZ": "捷克",
+		"DE": "德国",
+		"DJ": "吉布提",
+		"DK": "丹麦",
+		"DM": "多米尼克",
+		"DO": "多米尼加共和国",
+		"DZ": "阿尔及利亚",
+		"EC": "厄瓜多尔",
+		"EE": "爱沙尼亚",
+		"EG": "埃及",
+		"EH": "西撒哈拉",
+		"ER": "厄立特里亚",
+		"ES": "西班牙",
+		"ET": "埃塞俄比亚",
+		"FI": "芬兰",
+		"FJ": "斐济",
+		"FK": "福克兰群岛",
+		"FM": "密克罗尼西亚",
+		"FO": "法罗群岛",
+		"FR": "法国",
+		"GA": "加蓬",
+		"GB": "英国",
+		"GD": "格林纳达",
+		"GE": "格鲁吉亚",
+		"GF": "法属圭亚那",
+		"GG": "根西岛",
+		"GH": "加纳",
+		"GI": "直布罗陀",
+		"GL": "格陵兰",
+		"GM": "冈比亚",
+		"GN": "几内亚",
+		"GP": "瓜德罗普",
+		"GQ": "赤道几内亚",
+		"GR": "希腊",
+		"GS": "南乔治亚和南桑威奇群岛",
+		"GT": "危地马拉",
+		"GU": "关岛",
+		"GW": "几内亚比绍",
+		"GY": "圭亚那",
+		"HK": "中国香港特别行政区",
+		"HM": "赫德岛和麦克唐纳群岛",
+		"HN": "洪都拉斯",
+		"HR": "克罗地亚",
+		"HT": "海地",
+		"HU": "匈牙利",
+		"ID": "印度尼西亚",
+		"IE": "爱尔兰",
+		"IL": "以色列",
+		"IM": "马恩岛",
+		"IN": "印度",
+		"IO": "英属印度洋领地",
+		"IQ": "伊拉克",
+		"IR": "伊朗",
+		"IS": "冰岛",
+		"IT": "意大利",
+		"JE": "泽西岛",
+		"JM": "牙买加",
+		"JO": "约旦",
+		"JP": "日本",
+		"KE": "肯尼亚",
+		"KG": "吉尔吉斯斯坦",
+		"KH": "柬埔寨",
+		"KI": "基里巴斯",
+		"KM": "科摩罗",
+		"KN": "圣基茨和尼维斯",
+		"KP": "朝鲜",
+		"KR": "韩国",
+		"KW": "科威特",
+		"KY": "开曼群岛",
+		"KZ": "哈萨克斯坦",
+		"LA": "老挝",
+		"LB": "黎巴嫩",
+		"LC": "圣卢西亚",
+		"LI": "列支敦士登",
+		"LK": "斯里兰卡",
+		"LR": "利比里亚",
+		"LS": "莱索托",
+		"LT": "立陶宛",
+		"LU": "卢森堡",
+		"LV": "拉脱维亚",
+		"LY": "利比亚",
+		"MA": "摩洛哥",
+		"MC": "摩纳哥",
+		"MD": "摩尔多瓦",
+		"ME": "黑山",
+		"MF": "法属圣马丁",
+		"MG": "马达加斯加",
+		"MH": "马绍尔群岛",
+		"MK": "马其顿",
+		"ML": "马里",
+		"MM": "缅甸",
+		"MN": "蒙古",
+		"MO": "中国澳门特别行政区",
+		"MP": "北马里亚纳群岛",
+		"MQ": "马提尼克",
+		"MR": "毛里塔尼亚",
+		"MS": "蒙特塞拉特",
+		"MT": "马耳他",
+		"MU": "毛里求斯",
+		"MV": "马尔代夫",
+		"MW": "马拉维",
+		"MX": "墨西哥",
+		"MY": "马来西亚",
+		"MZ": "莫桑比克",
+		"NA": "纳米比亚",
+		"NC": "新喀里多尼亚",
+		"NE": "尼日尔",
+		"NF": "诺福克岛",
+		"NG": "尼日利亚",
+		"NI": "尼加拉瓜",
+		"NL": "荷兰",
+		"NO": "挪威",
+		"NP": "尼泊尔",
+		"NR": "瑙鲁",
+		"NU": "纽埃",
+		"NZ": "新西兰",
+		"OM": "阿曼",
+		"PA": "巴拿马",
+		"PE": "秘鲁",
+		"PF": "法属波利尼西亚",
+		"PG": "巴布亚新几内亚",
+		"PH": "菲律宾",
+		"PK": "巴基斯坦",
+		"PL": "波兰",
+		"PM": "圣皮埃尔和密克隆群岛",
+		"PN": "皮特凯恩群岛",
+		"PR": "波多黎各",
+		"PS": "巴勒斯坦领土",
+		"PT": "葡萄牙",
+		"PW": "帕劳",
+		"PY": "巴拉圭",
+		"QA": "卡塔尔",
+		"RE": "留尼汪",
+		"RO": "罗马尼亚",
+		"RS": "塞尔维亚",
+		"RU": "俄罗斯",
+		"RW": "卢旺达",
+		"SA": "沙特阿拉伯",
+		"SB": "所罗门群岛",
+		"SC": "塞舌尔",
+		"SD": "苏丹",
+		"SE": "瑞典",
+		"SG": "新加坡",
+		"SH": "圣赫勒拿",
+		"SI": "斯洛文尼亚",
+		"SJ": "斯瓦尔巴和扬马延",
+		"SK": "斯洛伐克",
+		"SL": "塞拉利昂",
+		"SM": "圣马力诺",
+		"SN": "塞内加尔",
+		"SO": "索马里",
+		"SR": "苏里南",
+		"SS": "南苏丹",
+		"ST": "圣多美和普林西比",
+		"SV": "萨尔瓦多",
+		"SX": "荷属圣马丁",
+		"SY": "叙利亚",
+		"SZ": "斯威士兰",
+		"TC": "特克斯和凯科斯群岛",
+		"TD": "乍得",
+		"TF": "法属南部领地",
+		"TG": "多哥",
+		"TH": "泰国",
+		"TJ": "塔吉克斯坦",
+		"TK": "托克劳",
+		"TL": "东帝汶",
+		"TM": "土库曼斯坦",
+		"TN": "突尼斯",
+		"TO": "汤加",
+		"TR": "土耳其",
+		"TT": "特立尼达和多巴哥",
+		"TV": "图瓦卢",
+		"TW": "台湾",
+		"TZ": "坦桑尼亚",
+		"UA": "乌克兰",
+		"UG": "乌干达",
+		"UM": "美国本土外小岛屿",
+		"US": "美国",
+		"UY": "乌拉圭",
+		"UZ": "乌兹别克斯坦",
+		"VA": "梵蒂冈",
+		"VC": "圣文森特和格林纳丁斯",
+		"VE": "委内瑞拉",
+		"VG": "英属维尔京群岛",
+		"VI": "美属维尔京群岛",
+		"VN": "越南",
+		"VU": "瓦努阿图",
+		"WF": "瓦利斯和富图纳",
+		"WS": "萨摩亚",
+		"YE": "也门",
+		"YT": "马约特",
+		"ZA": "南非",
+		"ZM": "赞比亚",
+		"ZW": "津巴布韦",
+	},
+}
+
+// subdivisionNames are the translations of the iso-codes project for the
+// subdivision names, keyed by language and ISO 3166-2 code. Names that are
+// the same as the English ones are left out.
+var subdivisionNames = map[string]map[string]string{
+	"de": {
+		"AE-AZ":  "Abu Dhabi",
+		"AE-DU":  "Dubai",
+		"AE-FU":  "Fudschaira",
+		"AE-RK":  "Ra’s al-Chaima",
+		"AE-SH":  "Schārdscha",
+		"AE-UQ":  "Umm al-Qaiwain",
+		"AF-BAL": "Balch",
+		"AF-BAM": "Bamiyan",
+		"AF-BDS": "Badakschān",
+		"AF-BGL": "Baglān",
+		"AF-DAY": "Dāikondī",
+		"AF-JOW": "Juzjān",
+		"AF-KDZ": "Kundus",
+		"AF-KHO": "Chost",
+		"AF-KNR": "Kunar",
+		"AF-LOG": "Lugar",
+		"AF-NIM": "Nīmrūs",
+		"AF-PAN": "Panjshīr",
+		"AF-PIA": "Paktīā",
+		"AF-SAR": "Sar-i Pul",
+		"AL-05":  "Gjirokastra",
+		"AL-06":  "Korça",
+		"AL-08":  "Lezha",
+		"AL-09":  "Dibra",
+		"AL-10":  "Shkodra",
+		"AL-11":  "Tirana",
+		"AL-12":  "Vlora",
+		"AM-AV":  "Armawir",
+		"AM-ER":  "Jerewan",
+		"AM-KT":  "Kotajk'",
+		"AM-SU":  "Sjunik'",
+		"AO-MAL": "Malanje",
+		"AR-C":   "Autonome Stadt Buenos Aires",
+		"AU-TAS": "Tasmanien",
+		"AZ-CUL": "Julfa",
+		"AZ-KAN": "Kengerli",
+		"AZ-NV":  "Nachitschewan",
+		"AZ-NX":  "Nachitschewan",
+		"BA-BIH": "Föderation Bosnien und Herzegowina",
+		"BA-BRC": "Brčko-Distrikt",
+		"BA-SRP": "Serbische Republik",
+		"BE-VBR": "Flämisch-Brabant",
+		"BE-VOV": "Ostflandern",
+		"BE-VWV": "Westflandern",
+		"BE-WAL": "Wallonne, Région",
+		"BE-WBR": "Wallonisch-Brabant",
+		"BE-WHT": "Hennegau",
+		"BE-WLG": "Lüttich",
+		"BE-WLX": "Luxemburg",
+		"BG-01":  "Blagoewgrad",
+		"BG-03":  "Warna",
+		"BG-04":  "Weliko Tarnowo",
+		"BG-05":  "Widin",
+		"BG-06":  "Wraza",
+		"BG-07":  "Gabrowo",
+		"BG-08":  "Dobritsch",
+		"BG-09":  "Kardschali",
+		"BG-10":  "Kjustendil",
+		"BG-11":  "Lowetsch",
+		"BG-13":  "Pasardschik",
+		"BG-15":  "Plewen",
+		"BG-16":  "Plowdiw",
+		"BG-17":  "Rasgrad",
+		"BG-18":  "Russe",
+		"BG-20":  "Sliwen",
+		"BG-21":  "Smoljan",
+		"BG-24":  "Stara Sagora",
+		"BG-25":  "Targowischte",
+		"BG-26":  "Chaskowo",
+		"BG-27":  "Schumen",
+		"BG-28":  "Jambol",
+		"BH-17":  "asch-Schamaliyya",
+		"BS-CK":  "Crooked Island und Long Cay",
+		"BS-FP":  "Freeport",
+		"BT-12":  "Chukha",
+		"BT-44":  "Lhuntse",
+		"BW-NE":  "Nordost",
+		"BW-NW":  "Nordwest",
+		"BW-SE":  "Südost",
+		"BW-SO":  "Süd",
+		"BY-MA":  "Mahiliou",
+		"BY-VI":  "Brest",
+		"CA-NL":  "Newfoundland und Labrador",
+		"CA-NT":  "Nordwest-Territorien",
+		"CA-QC":  "Québec",
+		"CF-KB":  "Nana-Grébizi",
+		"CH-GE":  "Genf",
+		"CH-NE":  "Neuenburg",
+		"CH-TI":  "Tessin",
+		"CH-VD":  "Waadt",
+		"CH-VS":  "Wallis",
+		"CM-NW":  "Nordwest",
+		"CN-AH":  "Anhui",
+		"CN-BJ":  "Peking",
+		"CN-CQ":  "Chongqing",
+		"CN-FJ":  "Fujian",
+		"CN-GD":  "Guangdong",
+		"CN-GS":  "Gansu",
+		"CN-GX":  "Guangxi",
+		"CN-GZ":  "Guizhou",
+		"CN-HA":  "Henan",
+		"CN-HB":  "Hubei",
+		"CN-HE":  "Hebei",
+		"CN-HI":  "Hainan",
+		"CN-HL":  "Heilongjiang",
+		"CN-HN":  "Hunan",
+		"CN-JL":  "Jilin",
+		"CN-JS":  "Jiangsu",
+		"CN-JX":  "Jiangxi",
+		"CN-LN":  "Liaoning",
+		"CN-NM":  "Nei Mongol",
+		"CN-NX":  "Ningxia",
+		"CN-QH":  "Qinghai",
+		"CN-SC":  "Sichuan",
+		"CN-SD":  "Shandong",
+		"CN-SH":  "Shanghai",
+		"CN-SN":  "Shaanxi",
+		"CN-SX":  "Shanxi",
+		"CN-TJ":  "Tianjin",
+		"CN-XJ":  "Xinjiang",
+		"CN-XZ":  "Tibet",
+		"CN-YN":  "Yunnan",
+		"CN-ZJ":  "Zhejiang",
+		"DJ-DJ":  "Dschibuti",
+		"DZ-16":  "Algier",
+		"EE-205": "Hiiu",
+		"EE-37":  "Harju",
+		"EE-39":  "Hiiu",
+		"EE-45":  "Ida-Viru",
+		"EE-50":  "Jõgeva",
+		"EE-52":  "Järva",
+		"EE-56":  "Lääne",
+		"EE-60":  "Lääne-Viru",
+		"EE-64":  "Põlva",
+		"EE-68":  "Pärnu",
+		"EE-71":  "Rapla",
+		"EE-714": "Saare",
+		"EE-74":  "Saare",
+		"EE-79":  "Tartu",
+		"EE-81":  "Valga",
+		"EE-84":  "Viljandi",
+		"EE-87":  "Võru",
+		"EG-BA":  "Al-Baḩr al-Aḩmar",
+		"ER-AN":  "Anseba",
+		"ES-AN":  "Andalusien",
+		"ES-AR":  "Aragonien",
+		"ES-AS":  "Asturien",
+		"ES-CB":  "Kantabrien",
+		"ES-CL":  "Kastilien und León",
+		"ES-CM":  "Kastilien-La Mancha",
+		"ES-CN":  "Kanaren",
+		"ES-MD":  "Madrid",
+		"ES-O":   "Asturien",
+		"ES-S":   "Kantabrien",
+		"ES-SS":  "Guipúzcoa",
+		"ES-Z":   "Saragossa",
+		"ET-SN":  "Southern Nations, Nationalities und Peoples",
+		"FI-02":  "Südkarelien",
+		"FI-03":  "Südösterbotten",
+		"FI-04":  "Südsavo",
+		"FI-07":  "Mittelösterbotten",
+		"FI-08":  "Mittelfinnland",
+		"FI-10":  "Lappland",
+		"FI-12":  "Österbotten",
+		"FI-13":  "Nordkarelien",
+		"FI-14":  "Nordösterbotten",
+		"FI-15":  "Nordsavo",
+		"FJ-08":  "Nadroga und Navosa",
+		"FR-20R": "Korsika",
+		"FR-973": "Französisch-Guayana",
+		"FR-GF":  "Französisch-Guayana",
+		"FR-IDF": "Île de France",
+		"FR-NC":  "Neukaledonien",
+		"FR-OCC": "Okzitanien",
+		"FR-PF":  "Französisch-Polynesien",
+		"FR-TF":  "Französische Südgebiete",
+		"GB-ABC": "Armagh City, Banbridge und Craigavon",
+		"GB-AGB": "Argyll und Bute",
+		"GB-AND": "Ards und North Down",
+		"GB-ANN": "Antrim und Newtownabbey",
+		"GB-BAS": "Bath und North East Somerset",
+		"GB-BCP": "Bournemouth, Christchurch und Poole",
+		"GB-BDG": "Barking und Dagenham",
+		"GB-BNH": "Brighton und Hove",
+		"GB-CCG": "Causeway Coast und Glens",
+		"GB-CHW": "Cheshire West und Chester",
+		"GB-DGY": "Dumfries und Galloway",
+		"GB-DRS": "Derry und Strabane",
+		"GB-FMO": "Fermanagh und Omagh",
+		"GB-HMF": "Hammersmith und Fulham",
+		"GB-KEC": "Kensington und Chelsea",
+		"GB-LBC": "Lisburn und Castlereagh",
+		"GB-MEA": "Mid und East Antrim",
+		"GB-NIR": "Nordirland",
+		"GB-NMD": "Newry, Mourne und Down",
+		"GB-PKN": "Perth und Kinross",
+		"GB-RCC": "Redcar und Cleveland",
+		"GB-SCT": "Schottland",
+		"GB-TFW": "Telford und Wrekin",
+		"GB-WNM": "Windsor und Maidenhead",
+		"GE-AB":  "Abchasien",
+		"GE-AJ":  "Adscharien",
+		"GE-GU":  "Gurien",
+		"GH-NE":  "Nordost",
+		"GQ-WN":  "Wele-Nzás",
+		"HR-01":  "Gespanschaft Zagreb",
+		"HR-02":  "Gespanschaft Krapina-Zagorje",
+		"HR-03":  "Gespanschaft Siska-Moslavina",
+		"HR-04":  "Gespanschaft Karlovac",
+		"HR-05":  "Gespanschaft Varaždin",
+		"HR-06":  "Gespanschaft Koprivnica-Križevci",
+		"HR-07":  "Gespanschaft Bjelovar-Bilogora",
+		"HR-08":  "Gespanschaft Primorje-Gorski",
+		"HR-09":  "Gespanschaft Lika-Senj",
+		"HR-10":  "Gespanschaft Virovitica-Podravina",
+		"HR-11":  "Gespanschaft Požega-Slawonien",
+		"HR-12":  "Gespanschaft Brod-Posavina",
+		"HR-13":  "Gespanschaft Zadar",
+		"HR-14":  "Gespanschaft Osijek-Baranja",
+		"HR-15":  "Gespanschaft Šibenik-Knin",
+		"HR-16":  "Gespanschaft Vukovar-Srijem",
+		"HR-17":  "Gespanschaft Split-Dalmatien",
+		"HR-18":  "Gespanschaft Istrien",
+		"HR-19":  "Gespanschaft Dubrovnik-Neretva",
+		"HR-20":  "Gespanschaft Međimurje",
+		"HR-21":  "Stadt Zagreb",
+		"ID-JW":  "Java",
+		"ID-MA":  "Molukken",
+		"ID-ML":  "Molukken",
+		"ID-SM":  "Sumatra",
+		"IN-AN":  "Andamanen und Nikobaren",
+		"IN-DH":  "Dādra und Nagar Haveli und Damān und Diu",
+		"IN-JK":  "Jammu und Kashmīr",
+		"IN-WB":  "Westbengalen",
+		"IR-05":  "Kermānschāh",
+		"IR-06":  "Chūzestān",
+		"IR-11":  "Sīstān und Belūtchistān",
+		"IR-18":  "Buschehr",
+		"IR-19":  "Zandschān",
+		"IR-23":  "Teherān",
+		"IT-25":  "Lombardei",
+		"IT-32":  "Trentino-Südtirol",
+		"IT-34":  "Venetien",
+		"IT-42":  "Ligurien",
+		"IT-52":  "Toskana",
+		"IT-55":  "Umbrien",
+		"IT-57":  "Marken",
+		"IT-62":  "Latium",
+		"IT-65":  "Abruzzen",
+		"IT-72":  "Kampanien",
+		"IT-75":  "Apulien",
+		"IT-77":  "Basilikata",
+		"IT-78":  "Kalabrien",
+		"IT-82":  "Sizilien",
+		"IT-88":  "Sardinien",
+		"IT-AG":  "Agrigent",
+		"IT-BZ":  "Bozen",
+		"IT-FI":  "Florenz",
+		"IT-GE":  "Genua",
+		"IT-GO":  "Görz",
+		"IT-MB":  "Monza und Brianza",
+		"IT-MI":  "Mailand",
+		"IT-MN":  "Mantua",
+		"IT-NA":  "Neapel",
+		"IT-PD":  "Padua",
+		"IT-PU":  "Pesaro und Urbino",
+		"IT-RM":  "Rom",
+		"IT-SR":  "Syrakus",
+		"IT-TA":  "Tarent",
+		"IT-TN":  "Trient",
+		"IT-TO":  "Turin",
+		"IT-TS":  "Triest",
+		"IT-VE":  "Venedig",
+		"JO-AJ":  "Adschlun",
+		"JO-AQ":  "Aqaba",
+		"JO-AT":  "At-Tafila",
+		"JO-JA":  "Dscharasch",
+		"JO-KA":  "Al-Karak",
+		"JO-MA":  "Al-Mafraq",
+		"JO-MN":  "Ma'an",
+		"KE-30":  "Nairobi",
+		"KG-O":   "Osch",
+		"KH-11":  "Mondulkiri",
+		"KH-14":  "Prey Veng",
+		"KH-15":  "Pursat",
+		"KH-16":  "Ratanakkiri",
+		"KH-17":  "Siem Reap",
+		"KH-20":  "Svay Rieng",
+		"KH-21":  "Takeo",
+		"KH-22":  "Oddar Meancheay",
+		"KI-G":   "Gilbertinseln",
+		"KI-L":   "Linieinseln",
+		"KI-P":   "Phoenixinseln",
+		"KP-06":  "Hwanghae-pukto",
+		"KW-MU":  "Mubarak al-Kabir",
+		"KZ-MAN": "Provinz Mangghystaū",
+		"KZ-PAV": "Provinz Pawlodar",
+		"KZ-VOS": "Provinz Ostkasachstan",
+		"KZ-ZHA": "Provinz Schambyl",
+		"LA-BL":  "Bolikhamsai",
+		"LA-KH":  "Khammuan",
+		"LA-LM":  "Luang Namtha",
+		"LA-LP":  "Luang Prabang",
+		"LA-OU":  "Oudômxay",
+		"LA-XA":  "Sayaburi",
+		"LA-XE":  "Sekong",
+		"LA-XS":  "Saysomboun",
+		"LK-91":  "Rathnapura",
+		"LU-LU":  "Luxemburg",
+		"LY-BA":  "Benghāzī",
+		"LY-DR":  "Darna",
+		"LY-JA":  "Al Dschabal al Achḑar",
+		"LY-JG":  "Al Dschabal al Gharbī",
+		"LY-JU":  "Al Dschufra",
+		"LY-KF":  "Al Kufra",
+		"LY-MB":  "Al Murgub",
+		"LY-MI":  "Mişrāta",
+		"LY-MJ":  "Al Mardsch",
+		"LY-MQ":  "Murzuk",
+		"LY-TB":  "Tripolis",
+		"LY-WD":  "Wādī al Ḩayā",
+		"LY-ZA":  "Az Zāwiya",
+		"MA-10":  "Guelmim-Oued Noun (teilweise EH)",
+		"MA-11":  "Laâyoune-Sakia El Hamra (teilweise EH)",
+		"MA-ASZ": "Assa-Zag (teilweise EH)",
+		"MA-ESM": "Es-Semara (teilweise EH)",
+		"MA-TAF": "Tarfaya (teilweise EH)",
+		"MA-TNT": "Tan-Tan (teilweise EH)",
+		"MC-MC":  "Monte Carlo",
+		"MD-SN":  "Autonome territoriale Einheit Transnistrien",
+		"MH-ALL": "Ailinginae",
+		"MH-JAB": "Jabwot",
+		"MH-L":   "Ralik-Kette",
+		"MH-NMK": "Namorik",
+		"MH-T":   "Ratak-Kette",
+		"ML-6":   "Timbuktu",
+		"MM-03":  "Magwe",
+		"MM-07":  "Irawadi",
+		"MM-16":  "Rakhaing",
+		"MN-035": "Orchon",
+		"MN-037": "Darchan-Uul",
+		"MN-039": "Chentii",
+		"MN-041": "Chöwsgöl",
+		"MN-043": "Chowd",
+		"MN-046": "Uws",
+		"MN-047": "Töw",
+		"MN-051": "Süchbaatar",
+		"MN-055": "Öwörchangai",
+		"MN-057": "Zawchan",
+		"MN-069": "Bajanchongor",
+		"MN-071": "Bajan-Ölgii",
+		"MN-073": "Archangai",
+		"MU-AG":  "Agalega-Inseln",
+		"MU-CC":  "»Cargados Carajos«-Schar",
+		"MU-RO":  "Rodrigues-Insel",
+		"MX-CMX": "Mexiko-Stadt",
+		"MY-04":  "Malakka",
+		"MY-07":  "Penang",
+		"MY-14":  "Bundesterritorium Kuala Lumpur",
+		"MY-15":  "Bundesterritorium Labuan",
+		"MY-16":  "Bundesterritorium Putrajaya",
+		"NL-NB":  "Nordbrabant",
+		"NL-NH":  "Nordholland",
+		"NL-ZH":  "Südholland",
+		"NO-21":  "Svalbard (Arktische Region)",
+		"NO-22":  "Jan Mayen (Arktische Region)",
+		"NP-DH":  "Dhaulagiri",
+		"NZ-CIT": "Chatham Islands Territorium",
+		"OM-BU":  "Al Buraimī",
+		"OM-MA":  "Maskat",
+		"OM-ZA":  "Az̧ Z̧āhira",
+		"OM-ZU":  "Dhofar",
+		"PH-14":  "Autonome Region Muslimisches Mindanao (ARMM)",
+		"PK-BA":  "Belutschistan",
+		"PK-JK":  "Azad Jammu und Kashmir",
+		"PL-02":  "Niederschlesien",
+		"PL-04":  "Kujawien-Pommern",
+		"PL-06":  "Lublin",
+		"PL-08":  "Lebus",
+		"PL-10":  "Łódz",
+		"PL-12":  "Kleinpolen",
+		"PL-14":  "Masowien",
+		"PL-16":  "Oppeln",
+		"PL-18":  "Karpatenvorland",
+		"PL-20":  "Podlachien",
+		"PL-22":  "Pommern",
+		"PL-24":  "Schlesien",
+		"PL-26":  "Heiligkreuz",
+		"PL-28":  "Ermland-Masuren",
+		"PL-30":  "Großpolen",
+		"PL-32":  "Westpommern",
+		"PS-DEB": "Dair al-Balah",
+		"PS-JEN": "Dschenin",
+		"PS-JRH": "Jericho und Al Aghwar",
+		"PS-KYS": "Chan Yunis",
+		"PS-NGZ": "Nordgaza",
+		"PT-11":  "Lissabon",
+		"PT-20":  "Autonome Region Azoren",
+		"PT-30":  "Autonome Region Madeira",
+		"QA-WA":  "al-Wakra",
+		"RO-B":   "Bukarest",
+		"RS-00":  "Belgrad",
+		"RS-01":  "Bezirk Severna Bačka",
+		"RS-02":  "Bezirk Srednji Banat",
+		"RS-03":  "Bezirk Severni Banat",
+		"RS-04":  "Bezirk Južni Bačka",
+		"RS-05":  "Bezirk Zapadna Bačka",
+		"RS-06":  "Bezirk Južna Bačka",
+		"RS-07":  "Bezirk Srem",
+		"RS-08":  "Bezirk Mačvan",
+		"RS-09":  "Bezirk Kolubara",
+		"RS-10":  "Bezirk Podunavlje",
+		"RS-11":  "Bezirk Braničevo",
+		"RS-12":  "Bezirk Šumadija",
+		"RS-13":  "Bezirk Pomoravlje",
+		"RS-14":  "Bezirk Bor",
+		"RS-15":  "Bezirk Zaječar",
+		"RS-16":  "Bezirk Zlatibor",
+		"RS-17":  "Bezirk Moraviča",
+		"RS-18":  "Bezirk Raška",
+		"RS-19":  "Bezirk Rasina",
+		"RS-20":  "Bezirk Nišava",
+		"RS-21":  "Bezirk Toplica",
+		"RS-22":  "Bezirk Pirot",
+		"RS-23":  "Bezirk Jablaniča",
+		"RS-24":  "Bezirk Pčinja",
+		"RS-25":  "Bezirk Kosovo",
+		"RS-26":  "Bezirk Peć",
+		"RS-27":  "Bezirk Prizren",
+		"RS-28":  "Bezirk Kosovska Mitrovica",
+		"RS-29":  "Bezirk Kosovo-Pomoravlje",
+		"RS-KM":  "Kosovo",
+		"RU-BA":  "Republik Baschkortostan",
+		"RU-CE":  "Republik Tschetschenien",
+		"RU-CHE": "Oblast Tscheljabinsk",
+		"RU-CHU": "Autonomer Kreis Tschuktschen",
+		"RU-CU":  "Republik Tschuwaschien",
+		"RU-DA":  "Republik Dagestan",
+		"RU-KAM": "Region Kamtschatka",
+		"RU-KC":  "Republik Karatschai-Tscherkessien",
+		"RU-KLU": "Oblast Kaluga",
+		"RU-KO":  "Republik Komi",
+		"RU-MOW": "Moskau",
+		"RU-NIZ": "Oblast Nischni Nowgorod",
+		"RU-SPE": "Sankt Peterburg",
+		"RU-TA":  "Republik Tatarstan",
+		"RU-VOR": "Oblast Woronesch",
+		"RU-ZAB": "Region Transbaikalien",
+		"RW-05":  "Süd",
+		"SA-05":  "Qasim",
+		"SA-10":  "Nadschran",
+		"SA-12":  "Dschauf",
+		"SB-CT":  "Hauptstadt (Honiara)",
+		"SB-RB":  "Rennell und Bellona",
+		"SG-01":  "Zentral Singapur",
+		"SG-02":  "Nordost",
+		"SG-03":  "Nordwest",
+		"SG-04":  "Südost",
+		"SG-05":  "Südwest",
+		"SK-BC":  "Bezirk Banská Bystrica",
+		"SK-BL":  "Preßburger Bezirk",
+		"SK-KI":  "Kaschauer Landschaftsverband",
+		"SK-NI":  "Neutraer Landschaftsverband",
+		"SK-PV":  "Eperieser Landschaftsverband",
+		"SK-TA":  "Tyrnauer Landschaftsverband",
+		"SK-TC":  "Trentschiner Landschaftsverband",
+		"SK-ZI":  "Silleiner Landschaftsverband",
+		"SL-S":   "Süd",
+		"SS-JG":  "Dschunqali",
+		"SY-DI":  "Dimaschq",
+		"SY-DY":  "Dair az-Zaur",
+		"SY-RA":  "Ar Raqqa",
+		"TD-TI":  "Tibestī",
+		"TH-S":   "Pattaya",
+		"TJ-KT":  "Chatlon",
+		"UA-40":  "Sewastopol",
+		"UM-67":  "Johnston-Atoll",
+		"UM-71":  "Midwayinseln",
+		"UM-76":  "Navassa-Insel",
+		"UM-79":  "Wake",
+		"UM-81":  "Baker-Insel",
+		"UM-84":  "Howland-Insel",
+		"UM-86":  "Jarvis-Insel",
+		"UM-89":  "Kingman-Riff",
+		"UM-95":  "Palmyra-Atoll",
+		"US-AS":  "Amerikanisch-Samoa",
+		"US-CA":  "Kalifornien",
+		"US-GA":  "Georgien",
+		"US-MP":  "Nördliche Marianen",
+		"YE-HD":  "Ḩaḑramaut",
+		"YE-HJ":  "Haddscha",
+		"YE-HU":  "Al-Ḩudaida",
+		"YE-JA":  "Dschauf",
+		"YE-LA":  "Lahidsch",
+		"YE-MR":  "Al-Mahra",
+		"YE-MW":  "Al-Maḩwīt",
+		"YE-RA":  "Raima",
+		"YE-SH":  "Schabwa",
+		"ZA-EC":  "Ostkap",
+		"ZA-FS":  "Freistaat",
+		"ZA-NC":  "Nordkap",
+		"ZA-NW":  "Nordwest",
+		"ZA-WC":  "Westkap",
+		"ZM-06":  "Nordwestprovinz",
+		"ZM-07":  "Süd",
+	},
+	"es": {
+		"AD-04":  "La Masaña",
+		"AD-06":  "San Julián de Loria",
+		"AD-07":  "Andorra la Vieja",
+		"AD-08":  "Las Escaldas-Engordany",
+		"AE-DU":  "Dubái",
+		"AE-FU":  "Fuyaira",
+		"AE-RK":  "Ras al-Khaimah",
+		"AF-KAB": "Kabul",
+		"AF-KAN": "Kandahar",
+		"AF-NAN": "Nangarjar",
+		"AF-NIM": "Nimruz",
+		"AF-NUR": "Nurestán",
+		"AF-PAN": "Panjshir",
+		"AF-PAR": "Paruán",
+		"AF-PIA": "Paktia",
+		"AF-PKA": "Paktiká",
+		"AF-SAM": "Samangan",
+		"AF-TAK": "Tajar",
+		"AF-URU": "Uruzgán",
+		"AF-WAR": "Vardak",
+		"AZ-QAZ": "Gazaj",
+		"BR-ES":  "Espíritu Santo",
+		"BR-MA":  "Marañón",
+		"BZ-BZ":  "Belice",
+		"CA-BC":  "Columbia Británica",
+		"CA-NB":  "Nuevo Brunswick",
+		"CA-NL":  "Terranova y Labrador",
+		"CA-NS":  "Nueva Escocia",
+		"CA-NT":  "Territorios del Noroeste",
+		"CA-PE":  "Isla del Príncipe Eduardo",
+		"CV-B":   "Islas de Barlovento",
+		"DJ-DJ":  "Yibuti",
+		"ES-BI":  "Vizcaya",
+		"ES-SS":  "Guipúzcoa",
+		"FR-90":  "Territorio de Belfort",
+		"FR-971": "Guadalupe",
+		"FR-GP":  "Guadalupe",
+		"FR-OCC": "Occitania",
+		"GN-ML":  "Malí",
+		"IT-21":  "Piamonte",
+		"IT-25":  "Lombardía",
+		"IT-32":  "Trentino-Alto Adigio",
+		"IT-34":  "Véneto",
+		"IT-45":  "Emilia-Romaña",
+		"IT-55":  "Umbría",
+		"IT-57":  "Marcas",
+		"IT-62":  "Lacio",
+		"IT-65":  "Abruzos",
+		"IT-75":  "Apulia",
+		"IT-88":  "Cerdeña",
+		"IT-BO":  "Bolonia",
+		"IT-FI":  "Florencia",
+		"IT-GE":  "Génova",
+		"NG-NI":  "Níger",
+		"NL-CW":  "Curazao",
+		"PE-ANC": "Áncash",
+		"PH-00":  "Región de la Capital Nacional",
+		"PH-01":  "Ilocos (Región I)",
+		"PH-02":  "Valle del Cagayán (Región II)",
+		"PH-03":  "Luzón Central (Región III)",
+		"PH-05":  "Bícol (Región V)",
+		"PH-06":  "Visayas Occidentales (Región VI)",
+		"PH-07":  "Visayas Centrales (Región VII)",
+		"PH-08":  "Visayas Orientales (Región VIII)",
+		"PH-09":  "Península de Zamboanga (Región IX)",
+		"PH-10":  "Mindanao del Norte (Región X)",
+		"TT-POS": "Puerto España",
+		"TZ-09":  "Kilimanyaro",
+		"UA-40":  "Sebastopol",
+		"UM-71":  "Midway, islas",
+		"UM-79":  "Wake, isla",
+		"US-AS":  "Samoa Estadounidense",
+		"US-DC":  "Distrito de Columbia",
+		"US-HI":  "Hawái",
+		"US-LA":  "Luisiana",
+		"US-MI":  "Míchigan",
+		"US-MP":  "Islas Marianas del Norte",
+		"US-MS":  "Misisipi",
+		"US-NC":  "Carolina del Norte",
+		"US-ND":  "Dakota del Norte",
+		"US-NH":  "Nuevo Hampshire",
+		"US-NJ":  "Nueva Jersey",
+		"US-NM":  "Nuevo México",
+		"US-NY":  "Nueva York",
+		"US-OR":  "Oregón",
+		"US-PA":  "Pensilvania",
+		"US-SC":  "Carolina del Sur",
+		"US-SD":  "Dakota del Sur",
+		"US-UM":  "Islas Ultramarinas Menores de Estados Unidos",
+		"US-WV":  "Virginia Occidental",
+	},
+	"fr": {
+		"AD-06":  "Saint Julià de Lòria",
+		"AD-07":  "Andorre la Vieille",
+		"AE-DU":  "Dubaï",
+		"AE-FU":  "Fujaïrah",
+		"AE-RK":  "Ras el Khaïmah",
+		"AE-SH":  "Ash Shariqah",
+		"AE-UQ":  "Oumm al Qaïwaïn",
+		"AF-BAM": "Bamyan",
+		"AF-BDG": "Badghis",
+		"AF-BDS": "Badakhchan",
+		"AF-BGL": "Baghlan",
+		"AF-DAY": "Daykundi",
+		"AF-FRA": "Farah",
+		"AF-FYB": "Faryab",
+		"AF-GHA": "Ghazni",
+		"AF-GHO": "Ghor",
+		"AF-HER": "Hérat",
+		"AF-JOW": "Jowzjan",
+		"AF-KAB": "Kaboul",
+		"AF-KAN": "Kandahar",
+		"AF-KAP": "Kapisa",
+		"AF-LAG": "Laghman",
+		"AF-LOG": "Logar",
+		"AF-NAN": "Nangarhar",
+		"AF-NIM": "Nimruz",
+		"AF-NUR": "Nourestan",
+		"AF-PAN": "Panchir",
+		"AF-PAR": "Parwan",
+		"AF-PIA": "Paktia",
+		"AF-PKA": "Paktika",
+		"AF-SAM": "Samangan",
+		"AF-SAR": "Sar-é Pol",
+		"AF-TAK": "Takhar",
+		"AF-URU": "Ourouzgan",
+		"AF-WAR": "Wardag",
+		"AF-ZAB": "Zaboul",
+		"AG-03":  "Saint-George",
+		"AG-04":  "Saint-John",
+		"AG-05":  "Saint-Mary",
+		"AG-06":  "Saint-Paul",
+		"AG-07":  "Saint-Peter",
+		"AG-08":  "Saint-Philip",
+		"AG-10":  "Barbade",
+		"AL-03":  "elbasan",
+		"AL-11":  "Tirana",
+		"AM-ER":  "Yerevan",
+		"AM-KT":  "Kotayk",
+		"AM-LO":  "Lori",
+		"AM-SU":  "Syunik",
+		"AM-VD":  "Vayots’ Dzor",
+		"AO-CNO": "Cuanza-Nord",
+		"AO-CUS": "Cuanza-Sud",
+		"AO-HUI": "Huila",
+		"AO-LNO": "Lunda-Nord",
+		"AO-LSU": "Lunda-Sud",
+		"AO-MAL": "Malanje",
+		"AO-UIG": "Uige",
+		"AR-C":   "Ville autonome de Buenos Aires",
+		"AR-E":   "Entre-Ríos",
+		"AR-V":   "Tierra del Fuego, Antártida, e Islas del Atlántico Sur",
+		"AR-X":   "Cordoba",
+		"AT-2":   "Carinthie",
+		"AT-3":   "Basse-Autriche",
+		"AT-4":   "Haute-Autriche",
+		"AT-5":   "Salzbourg",
+		"AT-6":   "Styrie",
+		"AT-7":   "Tyrol",
+		"AT-9":   "Vienne",
+		"AU-ACT": "Territoire de la capitale australienne",
+		"AU-NSW": "Nouvelle-Galles-du-Sud",
+		"AU-NT":  "Territoire du Nord",
+		"AU-SA":  "Australie-Méridionale",
+		"AU-TAS": "Tasmanie",
+		"AU-WA":  "Australie-Occidentale",
+		"AZ-ABS": "Absheron",
+		"AZ-AGA": "Agstafa",
+		"AZ-AGC": "Agjabadi",
+		"AZ-AGM": "Agdam",
+		"AZ-AGS": "Agdas",
+		"AZ-AGU": "Agsu",
+		"AZ-BA":  "Bakou",
+		"AZ-BAB": "Babak",
+		"AZ-BAL": "Balakan",
+		"AZ-BAR": "Barda",
+		"AZ-BEY": "Beylaqan",
+		"AZ-BIL": "Bilasuvar",
+		"AZ-CAB": "Jabrayil",
+		"AZ-CUL": "Julfa",
+		"AZ-DAS": "Daskasan",
+		"AZ-GA":  "Gandja",
+		"AZ-GAD": "Gadabay",
+		"AZ-GOY": "Göycay",
+		"AZ-HAC": "Hajigabul",
+		"AZ-IMI": "Imisli",
+		"AZ-ISM": "Ismayilli",
+		"AZ-KAL": "Kalbacar",
+		"AZ-KAN": "Kangarli",
+		"AZ-KUR": "Kürdamir",
+		"AZ-LA":  "Lankaran",
+		"AZ-LAC": "Lacın",
+		"AZ-LAN": "Lankaran",
+		"AZ-MAS": "Masalli",
+		"AZ-MI":  "Mingachevir",
+		"AZ-NEF": "Neftchala",
+		"AZ-NV":  "Nakhitchevan",
+		"AZ-NX":  "Nakhitchevan",
+		"AZ-OGU": "Oguz",
+		"AZ-QAB": "Qabala",
+		"AZ-QAX": "Qakh",
+		"AZ-QAZ": "Qazakh",
+		"AZ-QBI": "Qubadli",
+		"AZ-SA":  "Chaki",
+		"AZ-SAD": "Sadarak",
+		"AZ-SAH": "Shakhbuz",
+		"AZ-SAK": "Chaki",
+		"AZ-SAR": "Sharur",
+		"AZ-SAT": "Saatli",
+		"AZ-SBN": "Chabran",
+		"AZ-SIY": "Siyazan",
+		"AZ-SKR": "Shemkir",
+		"AZ-SM":  "Sumqayit",
+		"AZ-SMI": "Shamaxi",
+		"AZ-SMX": "Samukh",
+		"AZ-SR":  "Chirvan",
+		"AZ-SUS": "Susa",
+		"AZ-TAR": "Tartar",
+		"AZ-UCA": "Ujar",
+		"AZ-XA":  "Xankandi",
+		"AZ-XAC": "Khachmaz",
+		"AZ-XCI": "Khojali",
+		"AZ-XIZ": "Xizi",
+		"AZ-XVD": "Khojavend",
+		"AZ-YAR": "Yardymli",
+		"AZ-YE":  "Yevlakh",
+		"AZ-YEV": "Yevlakh",
+		"AZ-ZAN": "Zangilan",
+		"AZ-ZAR": "Zardab",
+		"BA-BIH": "Fédération de Bosnie et Herzégovine",
+		"BA-BRC": "District de Brčko",
+		"BA-SRP": "République serbe de Bosnie",
+		"BB-02":  "Saint-Andrew",
+		"BB-03":  "Saint-George",
+		"BB-04":  "Saint-James",
+		"BB-05":  "Saint-John",
+		"BB-06":  "Saint-Joseph",
+		"BB-07":  "Saint-Lucy",
+		"BB-08":  "Saint-Michael",
+		"BB-09":  "Saint-Peter",
+		"BB-10":  "Saint-Philip",
+		"BB-11":  "Saint-Thomas",
+		"BD-02":  "Laguna",
+		"BD-06":  "Barisal",
+		"BD-08":  "Comilla",
+		"BD-10":  "Chittagong",
+		"BD-13":  "Dacca",
+		"BD-22":  "Jessore",
+		"BD-24":  "Jaipurhat",
+		"BD-25":  "Jhalakati",
+		"BD-29":  "Khagrachari",
+		"BD-37":  "Fgura",
+		"BD-43":  "Nara",
+		"BD-45":  "Nawabganj",
+		"BD-63":  "Tanga",
+		"BD-A":   "Barisal",
+		"BD-B":   "Chittagong",
+		"BD-C":   "Dacca",
+		"BE-BRU": "Région de Bruxelles-Capitale",
+		"BE-VAN": "Anvers",
+		"BE-VBR": "Brabant-Flamand",
+		"BE-VLG": "Flamande, Région",
+		"BE-VLI": "Limourg",
+		"BE-VOV": "Flandre-Orientale",
+		"BE-VWV": "Flandre-Occidentale",
+		"BF-KMD": "Komandjoari",
+		"BF-ZOU": "Zoundéeogo",
+		"BG-02":  "Bourgas",
+		"BG-08":  "Dobritch",
+		"BG-09":  "Kardjali",
+		"BG-10":  "Kyoustendil",
+		"BG-11":  "Lovetch",
+		"BG-13":  "Pazardjik",
+		"BG-18":  "Roussé",
+		"BG-22":  "Sofia-ville",
+		"BG-25":  "Targovichte",
+		"BG-26":  "Khaskovo",
+		"BG-27":  "Choumen",
+		"BH-14":  "Al Janubiyah",
+		"BH-15":  "Al Muharraq",
+		"BH-17":  "Nord",
+		"BJ-LI":  "Litoral",
+		"BO-B":   "Beni",
+		"BO-P":   "Potosi",
+		"BQ-SE":  "Saint Eustache",
+		"BR-AM":  "Amazone",
+		"BR-AP":  "Amapa",
+		"BR-CE":  "Ceara",
+		"BR-DF":  "District fédéral",
+		"BR-ES":  "Espirito Santo",
+		"BR-GO":  "Goias",
+		"BR-MA":  "Maranhao",
+		"BR-PA":  "Para",
+		"BR-PB":  "Paraiba",
+		"BR-PE":  "Pernambouc",
+		"BR-PI":  "Piaui",
+		"BR-PR":  "Parana",
+		"BR-RO":  "Rondonia",
+		"BR-SC":  "Santa-Catarina",
+		"BR-SP":  "Sôo Paulo",
+		"BS-BY":  "Îles Berry",
+		"BS-CE":  "Eleuthera Central",
+		"BS-CK":  "Île Crooked et Long Cay",
+		"BS-CO":  "Abaco Central",
+		"BS-CS":  "Andros central",
+		"BS-EG":  "Grand Bahama orientale",
+		"BS-FP":  "Ville de Freeport",
+		"BS-MI":  "Île de Moore",
+		"BS-NE":  "Eleuthera septentrional",
+		"BS-NO":  "Abaco septentrional",
+		"BS-NS":  "Andros septentrional",
+		"BS-SA":  "Andros méridional",
+		"BS-SE":  "Eleuthera méridional",
+		"BS-SO":  "Abaco méridional",
+		"BS-WG":  "Grand Bahama occidentale",
+		"BT-12":  "Chukha",
+		"BT-42":  "Mongar",
+		"BT-44":  "Lhuntse",
+		"BT-TY":  "Trashiyangtse",
+		"BW-NE":  "Nord-Est",
+		"BW-NW":  "Nord-Ouest",
+		"BW-SE":  "Sud-Est",
+		"BW-SO":  "Sud",
+		"BY-BR":  "Voblast de Brest",
+		"BY-MA":  "Voblast de Mahiliow",
+		"BY-VI":  "Voblast de Vitebsk",
+		"CA-BC":  "Colombie-Britannique",
+		"CA-NB":  "Nouveau Brunswick",
+		"CA-NL":  "Terre-Neuve-et-Labrador",
+		"CA-NS":  "Nouvelle-Écosse",
+		"CA-NT":  "Territoires-du-Nord-Ouest",
+		"CA-PE":  "Île-du-Prince Édouard",
+		"CA-QC":  "Québec",
+		"CH-AG":  "Argovie",
+		"CH-AI":  "Appenzell Rhodes-Intérieures",
+		"CH-AR":  "Appenzell Rhodes-Extérieures",
+		"CH-BE":  "Berne",
+		"CH-BL":  "Bâle-Campagne",
+		"CH-BS":  "Bâle-Ville",
+		"CH-GL":  "Glaris",
+		"CH-GR":  "Grisons",
+		"CH-LU":  "Lucerne",
+		"CH-NW":  "Nidwald",
+		"CH-OW":  "Obwald",
+		"CH-SG":  "Saint-Gall",
+		"CH-SH":  "Schaffhouse",
+		"CH-SO":  "Soleure",
+		"CH-SZ":  "Schwytz",
+		"CH-TG":  "Thurgovie",
+		"CH-TI":  "Tessin",
+		"CH-ZG":  "Zoug",
+		"CH-ZH":  "Zurich",
+		"CL-AP":  "Arica et Parinacota",
+		"CL-LR":  "Los Rios",
+		"CL-RM":  "Région métropolitaine de Santiago",
+		"CL-TA":  "Tarapaca",
+		"CL-VS":  "Valparaiso",
+		"CM-EN":  "Extrême-Nord",
+		"CM-ES":  "Est",
+		"CM-LT":  "Litoral",
+		"CM-NO":  "Nord",
+		"CM-NW":  "Nord-Ouest",
+		"CM-OU":  "Ouest",
+		"CM-SU":  "Sud",
+		"CM-SW":  "Sud-Ouest",
+		"CO-AMA": "Amazone",
+		"CO-BOL": "Bolivar",
+		"CO-COR": "Cordoba",
+		"CO-DC":  "District de la capitale Bogota",
+		"CO-QUI": "Quindio",
+		"CO-SAP": "San Andrés y Providencia",
+		"CR-L":   "Limon",
+		"CU-07":  "Sancti Spiritus",
+		"CU-08":  "Ciego de Avila",
+		"CU-11":  "Holguin",
+		"CU-14":  "Guantanamo",
+		"CU-99":  "Île de la Juventud",
+		"CV-B":   "Îles de Barlavento",
+		"CV-CA":  "Santa-Catarina",
+		"CV-S":   "Îles de Sotavento",
+		"CZ-20":  "Bohême centrale",
+		"CZ-204": "Kolin",
+		"CZ-205": "Kutna Hora",
+		"CZ-206": "Melnik",
+		"CZ-207": "Mlada Boleslav",
+		"CZ-209": "Prague-Est",
+		"CZ-20A": "Prague-Ouest",
+		"CZ-20B": "Pribram",
+		"CZ-20C": "Rakovnik",
+		"CZ-31":  "Bohême du Sud",
+		"CZ-311": "Ceske Budejovice",
+		"CZ-312": "Cesky Krumlov",
+		"CZ-313": "Jindrichuv Hradec",
+		"CZ-314": "Pisek",
+		"CZ-317": "Tabor",
+		"CZ-32":  "Plzeň",
+		"CZ-321": "Domazlice",
+		"CZ-323": "Pilsen-ville",
+		"CZ-324": "Pilsen-Sud",
+		"CZ-325": "Pilsen-Nord",
+		"CZ-41":  "Karlovy Vary",
+		"CZ-412": "Carlsbad",
+		"CZ-42":  "Ústí nad Labem",
+		"CZ-421": "Decin",
+		"CZ-423": "Litomerice",
+		"CZ-427": "Usti nad Labem",
+		"CZ-51":  "liberec",
+		"CZ-511": "Ceska Lipa",
+		"CZ-52":  "Hradec Králové",
+		"CZ-521": "Hradec Kralové",
+		"CZ-522": "Jicin",
+		"CZ-523": "Nachod",
+		"CZ-524": "Rychnov nad Kneznou",
+		"CZ-53":  "Pardubice",
+		"CZ-534": "Usti nad Orlici",
+		"CZ-63":  "Région de Vysocina",
+		"CZ-631": "Havlickuv Brod",
+		"CZ-633": "Pelhrimov",
+		"CZ-634": "Trebic",
+		"CZ-635": "Zdar nad Sazavou",
+		"CZ-64":  "Moravie du Sud",
+		"CZ-642": "Brno-mesto",
+		"CZ-644": "Breclav",
+		"CZ-645": "Hodonin",
+		"CZ-646": "Vyskov",
+		"CZ-71":  "Olomouc",
+		"CZ-711": "Jesenik",
+		"CZ-713": "Prostejov",
+		"CZ-714": "Prerov",
+		"CZ-715": "Sumperk",
+		"CZ-72":  "Zlin",
+		"CZ-722": "Uherske Hradiste",
+		"CZ-723": "Vsetin",
+		"CZ-724": "Zlin",
+		"CZ-80":  "Moravie-Silésie",
+		"CZ-801": "Bruntal",
+		"CZ-803": "Karvina",
+		"CZ-804": "Novy Jicin",
+		"CZ-806": "Ostrava-ville",
+		"DE-BB":  "Brandebourg",
+		"DE-BW":  "Bade-Wurtemberg",
+		"DE-BY":  "Bavière",
+		"DE-HB":  "Brème",
+		"DE-HE":  "Hesse",
+		"DE-HH":  "Hambourg",
+		"DE-MV":  "Mecklembourg-Poméranie occidentale",
+		"DE-NI":  "Basse-Saxe",
+		"DE-NW":  "Rhénanie du Nord-Westfalie",
+		"DE-RP":  "Rhénanie-Palatinat",
+		"DE-SL":  "Sarre",
+		"DE-SN":  "Saxe",
+		"DE-ST":  "Saxe-Anhalt",
+		"DE-TH":  "Turinge",
+		"DJ-TA":  "Tadjoura",
+		"DK-81":  "Jutland septentrional",
+		"DK-82":  "Jutland central",
+		"DK-83":  "Sud-Danemark",
+		"DM-02":  "Saint-Andrew",
+		"DM-03":  "Saint-David",
+		"DM-04":  "Saint-George",
+		"DM-05":  "Saint-John",
+		"DM-06":  "Saint-Joseph",
+		"DM-07":  "Saint-Luke",
+		"DM-08":  "Saint-Mark",
+		"DM-09":  "Saint-Patrick",
+		"DM-10":  "Saint-Paul",
+		"DM-11":  "Saint-Peter",
+		"DO-01":  "District National.(Santo Domingo)",
+		"DO-14":  "Maria Trinidad Sanchez",
+		"DZ-04":  "Oum El-Bouaghi",
+		"DZ-32":  "El-Bayadh",
+		"DZ-36":  "El-Taref",
+		"DZ-45":  "Naâma",
+		"EC-B":   "Bolivar",
+		"EC-M":   "Manabi",
+		"EC-R":   "Los Rios",
+		"EC-U":   "Sucumbios",
+		"EC-W":   "Galapagos",
+		"EE-50":  "Jogevamaa",
+		"EE-87":  "Vorumaa",
+		"EG-ALX": "Al Iskandariyah",
+		"EG-ASN": "Aswan",
+		"EG-BA":  "Mer Rouge",
+		"EG-BNS": "Bani Suwayf",
+		"EG-C":   "Al Qahirah",
+		"EG-DK":  "Ad Daqahliyah",
+		"EG-FYM": "Al Fayyum",
+		"EG-GH":  "Al Gharbiyah",
+		"EG-GZ":  "Al Jizah",
+		"EG-JS":  "Janub Sina",
+		"EG-KB":  "Al Qalyubiyah",
+		"EG-KN":  "Qina",
+		"EG-MN":  "Al Minya",
+		"EG-MNF": "Al Minufiyah",
+		"EG-SHG": "Suhaj",
+		"EG-SHR": "Ash Sharqiyah",
+		"EG-WAD": "Al Wadi al Jadid",
+		"ES-AL":  "Almeria",
+		"ES-AN":  "Andalousie",
+		"ES-AR":  "Aragon",
+		"ES-AS":  "Asturies, principauté des",
+		"ES-AV":  "Avila",
+		"ES-BI":  "Biscaye",
+		"ES-C":   "La Corogne",
+		"ES-CA":  "Cadix",
+		"ES-CB":  "Cantabrique",
+		"ES-CC":  "Caceres",
+		"ES-CL":  "Castille et Léon",
+		"ES-CM":  "Castille-La Manche",
+		"ES-CN":  "Canaries",
+		"ES-CO":  "Cordoba",
+		"ES-EX":  "Extramadoure",
+		"ES-GR":  "Grenade",
+		"ES-J":   "Jaen",
+		"ES-LE":  "Léon",
+		"ES-MA":  "Malaga",
+		"ES-MC":  "Murcei, région de",
+		"ES-MD":  "Madrid, Communauté de",
+		"ES-MU":  "Murcie",
+		"ES-S":   "Cantabrique",
+		"ES-SA":  "Salamanque",
+		"ES-SE":  "Séville",
+		"ES-SG":  "Segovie",
+		"ES-SS":  "Guipuscoa",
+		"ES-TE":  "Téruel",
+		"ES-Z":   "Saragosse",
+		"ET-AA":  "Addis-Abeba",
+		"FI-02":  "Carélie du Sud",
+		"FI-03":  "Ostrobotnie du Sud",
+		"FI-04":  "Savonie du Sud",
+		"FI-07":  "Ostrobotnie-Centrale",
+		"FI-08":  "Finlande-Centrale",
+		"FI-09":  "Vallée de la Kymi",
+		"FI-10":  "Laponie",
+		"FI-12":  "Ostrobotnie",
+		"FJ-E":   "Est",
+		"FJ-N":   "Nord",
+		"FJ-W":   "Ouest",
+		"FR-95":  "Val-d’Oise",
+		"FR-GES": "Grand Est",
+		"FR-PAC": "Provence-Alpes-Côte d'Azur",
+		"FR-PDL": "Pays de la Loire",
+		"GB-ABE": "Aberdeen ville",
+		"GB-AGB": "Argyll et Bute",
+		"GB-AND": "Ards et North Down",
+		"GB-ANN": "Antrim et Newtownabbey",
+		"GB-BAS": "Bath et Somerset Nord-Est",
+		"GB-BBD": "Blackburn avec Darwen",
+		"GB-BDG": "Barking et Dagenham",
+		"GB-BNH": "Brighton et Hove",
+		"GB-BST": "Bristol ville",
+		"GB-CBF": "Bedfordshire central",
+		"GB-CCG": "Causeway Coast et Glens",
+		"GB-CHE": "Cheshire oriental",
+		"GB-CHW": "Cheshire occidental et Chester",
+		"GB-CMA": "Cumbrie",
+		"GB-CON": "Cornouailles",
+		"GB-DGY": "Dumfries et Galloway",
+		"GB-DND": "Dundee ville",
+		"GB-DRS": "Derry et Strabane",
+		"GB-EAY": "Ayrshire oriental",
+		"GB-EDH": "Edimbourg ville",
+		"GB-EDU": "Dunbartonshire oriental",
+		"GB-ELN": "Lothian oriental",
+		"GB-ERW": "Renfrewshire oriental",
+		"GB-ERY": "Riding of Yorkshire oriental",
+		"GB-ESX": "Sussex oriental",
+		"GB-FMO": "Fermanagh et Omagh",
+		"GB-GLG": "Glasgow ville",
+		"GB-HMF": "Hammersmith et Fulham",
+		"GB-IOS": "Îles Scilly",
+		"GB-IOW": "Île de Wight",
+		"GB-KEC": "Kensington et Chelsea",
+		"GB-KTT": "Kingston s/ Tamise",
+		"GB-LBC": "Lisburn et Castlereagh",
+		"GB-LND": "Londres ville",
+		"GB-MEA": "Mid et East Antrim",
+		"GB-NAY": "Ayrshire septentrional",
+		"GB-NEL": "Lincolnshire nord-est",
+		"GB-NET": "Newcastle s/ Tyne",
+		"GB-NLK": "Lanarkshire septentrional",
+		"GB-NLN": "Lincolnshire septentrional",
+		"GB-NMD": "Newry, Mourne et Down",
+		"GB-NSM": "Somerset septentrional",
+		"GB-NTY": "Tyneside septentrional",
+		"GB-NYK": "Yorkshire septentrional",
+		"GB-ORK": "Îles Orkney",
+		"GB-PKN": "Perth et Kinross",
+		"GB-RCC": "Redcar et Cleveland",
+		"GB-RIC": "Richmond s/ Tamise",
+		"GB-SAY": "Ayrshire méridional",
+		"GB-SGC": "Gloucestershire méridional",
+		"GB-SHN": "Sainte-Hélène",
+		"GB-SLK": "Lanarkshire méridional",
+		"GB-SOS": "Southend sur Mer",
+		"GB-STY": "Tyneside méridional",
+		"GB-TFW": "Telford et Wrekin",
+		"GB-WBK": "Berkshire occidental",
+		"GB-WDU": "Dunbartonshire occidental",
+		"GB-WLN": "Lothian occidental",
+		"GB-WNM": "Windsor et Maidenhead",
+		"GB-WSX": "Sussex occidental",
+		"GB-ZET": "Îles Shetland",
+		"GD-01":  "Saint-Andrew",
+		"GD-02":  "Saint-David",
+		"GD-03":  "Saint-George",
+		"GD-04":  "Saint-John",
+		"GD-05":  "Saint-Mark",
+		"GD-06":  "Saint-Patrick",
+		"GD-10":  "Îles Grenadines du Sud",
+		"GE-AB":  "Abkhazie",
+		"GE-AJ":  "Adjarie",
+		"GH-AA":  "Grand Accra",
+		"GH-EP":  "Est",
+		"GH-NE":  "Nord-Est",
+		"GH-NP":  "Nord",
+		"GH-UE":  "Haut Ghana oriental",
+		"GH-UW":  "Haut Ghana Occidental",
+		"GH-WP":  "Ouest",
+		"GL-KU":  "Municipalité de Kujalleq",
+		"GL-QE":  "Municipalité de Qeqqata",
+		"GL-SM":  "Municipalité de Sermersooq",
+		"GM-L":   "Rivière basse",
+		"GM-M":   "Rivière haute",
+		"GM-N":   "Rive nord",
+		"GM-U":   "Rive haute",
+		"GM-W":   "Ouest",
+		"GQ-WN":  "Wele-Nzás",
+		"GW-GA":  "Gabu",
+		"GW-L":   "Est",
+		"GW-N":   "Nord",
+		"GW-S":   "Sud",
+		"GY-EB":  "Berbice Oriental-Courantyne",
+		"GY-ES":  "Îles d'Essequibo-Demerara occidental",
+		"GY-UD":  "Haut-Demerara-Berbice",
+		"GY-UT":  "Haut-Takutu-Haut-Essequibo",
+		"HN-IB":  "Îles de la Baie",
+		"HR-01":  "Zagreb",
+		"HR-02":  "Krapina-Zagorje",
+		"HR-03":  "Sisak-Moslavina",
+		"HR-04":  "Karlovac",
+		"HR-05":  "Varaždin",
+		"HR-06":  "Koprivnica-Križevci",
+		"HR-07":  "Bjelovar-Bilogora",
+		"HR-08":  "Primorje-Gorski Kotar",
+		"HR-09":  "Lika-Senj",
+		"HR-10":  "Virovitica-Podravina",
+		"HR-11":  "Požega-Slavonie",
+		"HR-12":  "Brod-Posavina",
+		"HR-13":  "Zadar",
+		"HR-14":  "Osijek-Baranja",
+		"HR-15":  "Šibenik-Knin",
+		"HR-16":  "Vukovar-Syrmie",
+		"HR-17":  "Split-Dalmatie",
+		"HR-18":  "Istrie",
+		"HR-19":  "Dubrovnik-Neretva",
+		"HR-20":  "Međimurje",
+		"HR-21":  "Ville de Zagreb",
+		"ID-JB":  "Java-Ouest",
+		"ID-JI":  "Java-Est",
+		"ID-JK":  "Jakarta",
+		"ID-JT":  "Java-Centre",
+		"ID-JW":  "Java",
+		"ID-KB":  "Kalimantan-Ouest",
+		"ID-KI":  "Kalimantan-Est",
+		"ID-KR":  "Îles Riau",
+		"ID-KS":  "Kalimantan-Sud",
+		"ID-KT":  "Kalimantan-Centre",
+		"ID-MA":  "Moluques",
+		"ID-ML":  "Moluques",
+		"ID-MU":  "Moluques-Nord",
+		"ID-NB":  "Îles de la Sonde occidentales",
+		"ID-NT":  "Îles de la Sonde orientales",
+		"ID-SA":  "Sulawesi-Nord",
+		"ID-SG":  "Sulawesi du Sud-Est",
+		"ID-SL":  "Célèbes",
+		"ID-SM":  "Sumatra",
+		"ID-SN":  "Sulawesi-Sud",
+		"ID-SR":  "Sulawesi-Ouest",
+		"ID-ST":  "Sulawesi-Centre",
+		"ID-SU":  "Sumatra-Nord",
+		"IN-AN":  "Îles Andaman et Nicobar",
+		"IN-PB":  "Penjab",
+		"IN-PY":  "Pondichéry",
+		"IN-WB":  "Bengale occidental",
+		"IR-00":  "Markazi",
+		"IR-01":  "Gilan",
+		"IR-02":  "Mazandaran",
+		"IR-05":  "Kermanshah",
+		"IR-06":  "Khuzestan",
+		"IR-07":  "Fars",
+		"IR-08":  "Kerman",
+		"IR-10":  "Ispahan",
+		"IR-11":  "Sistan-o-Balouchestan",
+		"IR-12":  "Kurdistan",
+		"IR-13":  "Hamedan",
+		"IR-15":  "Lorestan",
+		"IR-16":  "Ilam",
+		"IR-18":  "Bushehr",
+		"IR-19":  "Zanjan",
+		"IR-20":  "Semnan",
+		"IR-22":  "Hormozgan",
+		"IR-23":  "Téhéran",
+		"IR-24":  "Ardabil",
+		"IR-26":  "Qazvin",
+		"IR-27":  "Golestan",
+		"IS-2":   "Péninsule méridionale",
+		"IS-3":   "Ouest",
+		"IS-4":   "Fjords de l'ouest",
+		"IS-5":   "Nord-Ouest",
+		"IS-6":   "Nord-est",
+		"IS-7":   "Est",
+		"IS-8":   "Sud",
+		"IT-21":  "Piémont",
+		"IT-25":  "Lombardie",
+		"IT-32":  "Trentin- Haut Adige",
+		"IT-34":  "Vénétie",
+		"IT-42":  "Ligurie",
+		"IT-45":  "Émilie-Romagne",
+		"IT-52":  "Toscane",
+		"IT-55":  "Ombrie",
+		"IT-62":  "Latium",
+		"IT-65":  "Abruzzes",
+		"IT-72":  "Campanie",
+		"IT-75":  "Pouilles",
+		"IT-77":  "Basilicate",
+		"IT-78":  "Calabre",
+		"IT-82":  "Sicile",
+		"IT-88":  "Sardaigne",
+		"IT-AG":  "Agrigente",
+		"IT-AL":  "Alexandrie",
+		"IT-AN":  "Ancône",
+		"IT-BG":  "Bergame",
+		"IT-BO":  "Bologne",
+		"IT-CE":  "Caserte",
+		"IT-CN":  "Cunéo",
+		"IT-CO":  "Côme",
+		"IT-CR":  "Crémone",
+		"IT-CT":  "Catane",
+		"IT-FE":  "Ferrare",
+		"IT-FI":  "Florence",
+		"IT-GE":  "Gênes",
+		"IT-LI":  "Livourne",
+		"IT-LU":  "Lucques",
+		"IT-MB":  "Monza et Brianza",
+		"IT-ME":  "Messine",
+		"IT-MI":  "Milan",
+		"IT-MN":  "Mantoue",
+		"IT-MO":  "Modène",
+		"IT-NA":  "Naples",
+		"IT-NO":  "Novare",
+		"IT-PA":  "Palerme",
+		"IT-PC":  "Piacence",
+		"IT-PD":  "Padoue",
+		"IT-PG":  "Pérouse",
+		"IT-PI":  "Pise",
+		"IT-PR":  "Parme",
+		"IT-PU":  "Pésaro et Urbino",
+		"IT-PV":  "Pavie",
+		"IT-RA":  "Ravenne",
+		"IT-RG":  "Ragouse",
+		"IT-RM":  "roma",
+		"IT-SA":  "Salerne",
+		"IT-SI":  "Sienne",
+		"IT-SR":  "Syracuse",
+		"IT-SV":  "Savone",
+		"IT-TA":  "Tarante",
+		"IT-TN":  "Trente",
+		"IT-TO":  "Turin",
+		"IT-TV":  "Trévise",
+		"IT-VA":  "Varèse",
+		"IT-VE":  "Venise",
+		"IT-VI":  "Vicence",
+		"IT-VR":  "Vérone",
+		"JM-02":  "Saint-Andrew",
+		"JM-03":  "Saint-Thomas",
+		"JM-05":  "Saint-Mary",
+		"JM-08":  "Saint-James",
+		"KH-15":  "Pothisat",
+		"KI-G":   "Îles Gilbert",
+		"KI-L":   "Îles Line",
+		"KI-P":   "Îles Phoenix",
+		"KP-05":  "Hwanghae méridional",
+		"KP-06":  "Hwanghae septentrional",
+		"KZ-MAN": "Manguistaou",
+		"KZ-PAV": "Pavlodar",
+		"KZ-VOS": "Kazakhstan oriental",
+		"KZ-ZHA": "Djamboul",
+		"LV-001": "Aglonas (commune)",
+		"LV-002": "Aizkraukles (commune)",
+		"LV-003": "Aizputes (commune)",
+		"LV-004": "Aknīstes (commune)",
+		"LV-005": "Alojas (commune)",
+		"LV-006": "Alsungas (commune)",
+		"LV-007": "Alūksnes (commune)",
+		"LV-008": "Amatas (commune)",
+		"LV-009": "Apes (commune)",
+		"LV-010": "Auces (commune)",
+		"LV-011": "Ādažu (commune)",
+		"LV-012": "Babītes (commune)",
+		"LV-013": "Baldones (commune)",
+		"LV-014": "Baltinavas (commune)",
+		"LV-015": "Balvu (commune)",
+		"LV-016": "Bauskas (commune)",
+		"LV-017": "Beverīnas (commune)",
+		"LV-018": "Brocēnu (commune)",
+		"LV-019": "Burtnieku (commune)",
+		"LV-020": "Carnikavas (commune)",
+		"LV-021": "Cesvaines (commune)",
+		"LV-022": "Cēsu (commune)",
+		"LV-023": "Ciblas (commune)",
+		"LV-024": "Dagdas (commune)",
+		"LV-025": "Daugavpils (commune)",
+		"LV-026": "Dobeles (commune)",
+		"LV-027": "Dundagas (commune)",
+		"LV-028": "Durbes (commune)",
+		"LV-029": "Engures (commune)",
+		"LV-030": "Ērgļu (commune)",
+		"LV-031": "Garkalnes (commune)",
+		"LV-032": "Grobiņas (commune)",
+		"LV-033": "Gulbenes (commune)",
+		"LV-034": "Iecavas (commune)",
+		"LV-035": "Ikšķiles (commune)",
+		"LV-036": "Ilūkstes (commune)",
+		"LV-037": "Inčukalna (commune)",
+		"LV-038": "Jaunjelgavas (commune)",
+		"LV-039": "Jaunpiebalgas (commune)",
+		"LV-040": "Jaunpils (commune)",
+		"LV-041": "Jelgavas (commune)",
+		"LV-042": "Jēkabpils (commune)",
+		"LV-043": "Kandavas (commune)",
+		"LV-044": "Kārsavas (commune)",
+		"LV-045": "Kocēnu (commune)",
+		"LV-046": "Kokneses (commune)",
+		"LV-047": "Krāslavas (commune)",
+		"LV-048": "Krimuldas (commune)",
+		"LV-049": "Krustpils (commune)",
+		"LV-050": "Kuldīgas (commune)",
+		"LV-051": "Ķeguma (commune)",
+		"LV-052": "Ķekavas (commune)",
+		"LV-053": "Lielvārdes (commune)",
+		"LV-054": "Limbažu (commune)",
+		"LV-055": "Līgatnes (commune)",
+		"LV-056": "Līvānu (commune)",
+		"LV-057": "Lubānas (commune)",
+		"LV-058": "Ludzas (commune)",
+		"LV-059": "Madonas (commune)",
+		"LV-060": "Mazsalacas (commune)",
+		"LV-061": "Mālpils (commune)",
+		"LV-062": "Mārupes (commune)",
+		"LV-063": "Mērsraga (commune)",
+		"LV-064": "Naukšēnu (commune)",
+		"LV-065": "Neretas (commune)",
+		"LV-066": "Nīcas (commune)",
+		"LV-067": "Ogres (commune)",
+		"LV-068": "Olaines (commune)",
+		"LV-069": "Ozolnieku (commune)",
+		"LV-070": "Pārgaujas (commune)",
+		"LV-071": "Pāvilostas (commune)",
+		"LV-072": "Pļaviņu (commune)",
+		"LV-073": "Preiļu (commune)",
+		"LV-074": "Priekules (commune)",
+		"LV-075": "Priekuļu (commune)",
+		"LV-076": "Raunas (commune)",
+		"LV-077": "Rēzeknes (commune)",
+		"LV-078": "Riebiņu (commune)",
+		"LV-079": "Rojas (commune)",
+		"LV-080": "Ropažu (commune)",
+		"LV-081": "Rucavas (commune)",
+		"LV-082": "Rugāju (commune)",
+		"LV-083": "Rundāles (commune)",
+		"LV-084": "Rūjienas (commune)",
+		"LV-085": "Salas (commune)",
+		"LV-086": "Salacgrīvas (commune)",
+		"LV-087": "Salaspils (commune)",
+		"LV-088": "Saldus (commune)",
+		"LV-089": "Saulkrastu (commune)",
+		"LV-090": "Sējas (commune)",
+		"LV-091": "Siguldas (commune)",
+		"LV-092": "Skrīveru (commune)",
+		"LV-093": "Skrundas (commune)",
+		"LV-094": "Smiltenes (commune)",
+		"LV-095": "Stopiņu (commune)",
+		"LV-096": "Strenču (commune)",
+		"LV-097": "Talsu (commune)",
+		"LV-098": "Tērvetes (commune)",
+		"LV-099": "Tukuma (commune)",
+		"LV-100": "Vaiņodes (commune)",
+		"LV-101": "Valkas (commune)",
+		"LV-102": "Varakļānu (commune)",
+		"LV-103": "Vārkavas (commune)",
+		"LV-104": "Vecpiebalgas (commune)",
+		"LV-105": "Vecumnieku (commune)",
+		"LV-106": "Ventspils (commune)",
+		"LV-107": "Viesītes (commune)",
+		"LV-108": "Viļakas (commune)",
+		"LV-109": "Viļānu (commune)",
+		"LV-110": "Zilupes (commune)",
+		"LY-BA":  "Benghazi",
+		"MA-10":  "Guelmim-Oued Noun (EH partiel)",
+		"MA-11":  "Laâyoune-Sakia El Hamra (EH partiel)",
+		"MA-ASZ": "Assa-Zag (EH partiel)",
+		"MA-CHE": "Chefchaouene",
+		"MA-ESM": "Es-Semara (EH partiel)",
+		"MA-TAF": "Tarfaya (EH partiel)",
+		"MA-TNT": "Tan-Tan (EH partiel)",
+		"MD-SN":  "Transnistrie",
+		"MG-T":   "Tananarive",
+		"MH-L":   "Chaîne de Ralik",
+		"MH-T":   "Chaîne de Ratak",
+		"MM-17":  "shan",
+		"MT-49":  "Saint-John",
+		"MT-60":  "La Valette",
+		"MU-AG":  "Îles Agalega",
+		"MU-RO":  "Île Rodrigues",
+		"MW-C":   "Région centrale",
+		"MW-N":   "Région septentrionale",
+		"MW-NB":  "Baie de Nkhata",
+		"MW-S":   "Région méridionale",
+		"MX-BCN": "Basse Californie",
+		"MX-BCS": "Basse Californie méridionale",
+		"MX-CMX": "Ville de Mexico",
+		"MX-COA": "État de Coahuila de Zaragoza",
+		"MX-MEX": "Mexico",
+		"MX-NLE": "Nouveau León",
+		"MY-14":  "Kuala Lumpur (territoire fédéral)",
+		"MY-15":  "Labuan (territoire fédéral)",
+		"MY-16":  "Putrajaya (territoire fédéral)",
+		"NE-1":   "Agadès",
+		"NG-RI":  "Rivières",
+		"NI-GR":  "Grenade",
+		"NI-LE":  "Léon",
+		"NI-NS":  "Nouvelle Ségovie",
+		"NI-SJ":  "Rivière San Juan",
+		"NL-BQ3": "Saint Eustache",
+		"NL-GE":  "Gueldre",
+		"NL-GR":  "Groningue",
+		"NL-LI":  "Limourg",
+		"NL-NB":  "Brabant septentrional",
+		"NL-NH":  "Hollande septentrionale",
+		"NL-SX":  "Saint-Martin",
+		"NL-ZE":  "Zélande",
+		"NL-ZH":  "Hollande méridionale",
+		"NO-21":  "Svalbard (région arctique)",
+		"NO-22":  "Jan Mayen (région arctique)",
+		"NP-3":   "Ouest",
+		"NP-4":   "Est",
+		"NZ-BOP": "Baie de Plenty",
+		"NZ-CIT": "Territoire des îles Chatham",
+		"NZ-HKB": "Baie de Hawke",
+		"NZ-NTL": "Pays du Nord",
+		"NZ-STL": "Pays du Sud",
+		"NZ-WTC": "Côte occidentale",
+		"OM-MA":  "Mascate",
+		"OM-WU":  "Al Wusta",
+		"PA-8":   "Panama",
+		"PG-EBR": "Nouvelle-Bretagne orientale",
+		"PG-EHG": "Highlands orientaux",
+		"PG-ESW": "Spik oriental",
+		"PG-GPK": "Golfe",
+		"PG-MBA": "Baie de Milne",
+		"PG-NCD": "Port-Moresby (district de la capitale)",
+		"PG-NIK": "Nouvelle-Irlande",
+		"PG-NPP": "Nord",
+		"PG-SHM": "Highlands méridionaux",
+		"PG-WBK": "Nouvelle-Bretagne occidentale",
+		"PG-WHM": "Highlands occidentaux",
+		"PG-WPD": "Ouest",
+		"PH-00":  "Région de la capitale nationale",
+		"PH-01":  "Ilocos (Région I)",
+		"PH-02":  "Vallée de Cagayan (Région II)",
+		"PH-03":  "Luzon central (Région III)",
+		"PH-05":  "Bicol (Région V)",
+		"PH-06":  "Visayas occidental (Région VI)",
+		"PH-07":  "Visayas central (Région VII)",
+		"PH-08":  "Visayas oriental (Région VIII)",
+		"PH-09":  "Péninsule de Zamboanga (Région IX)",
+		"PH-10":  "Mindanao septentrional (Région X)",
+		"PH-11":  "Davao (Région XI)",
+		"PH-12":  "Soccsksargen (Région XII)",
+		"PH-13":  "Caraga (Région XIII)",
+		"PH-14":  "Région autonome musulmane de Mindanao (ARMM)",
+		"PH-15":  "Région administrative de Cordillera (CAR)",
+		"PH-AGN": "Agusan du Nord",
+		"PH-AGS": "Agusan du Sud",
+		"PH-CAN": "Camarines Nord",
+		"PH-CAS": "Camarines Sud",
+		"PH-DAO": "Davao oriental",
+		"PH-DAS": "Davao du Sud",
+		"PH-DAV": "Davao du Nord",
+		"PH-DIN": "Îles Dinagat",
+		"PH-EAS": "Samar Oriental",
+		"PH-ILN": "Ilocos Nord",
+		"PH-ILS": "Ilocos Sud",
+		"PH-LAN": "Lanao du Nord",
+		"PH-LAS": "Lanao du Sud",
+		"PH-MDC": "Mindoro occidental",
+		"PH-MDR": "Mindoro oriental",
+		"PH-MOU": "Province de la Montagne",
+		"PH-MSC": "Misamis occidental",
+		"PH-MSR": "Misamis oriental",
+		"PH-NEC": "Negros occidental",
+		"PH-NER": "Negros oriental",
+		"PH-NSA": "Samar septentrional",
+		"PH-NUE": "Nouvelle-Ecija",
+		"PH-NUV": "Nouvelle-Biscaye",
+		"PH-PAM": "pampangan",
+		"PH-SCO": "Cotabato Sud",
+		"PH-SLE": "Leyte méridional",
+		"PH-SUN": "Surigao du Nord",
+		"PH-SUR": "Surigao du Sud",
+		"PH-ZAN": "Zamboanga du Nord",
+		"PH-ZAS": "Zamboanga du Sud",
+		"PK-BA":  "Baloutchistan",
+		"PK-PB":  "Penjab",
+		"PL-02":  "Basse-Silésie",
+		"PL-04":  "Cujavie-Poméranie",
+		"PL-06":  "Lublin",
+		"PL-08":  "Lubusz",
+		"PL-10":  "Łódź",
+		"PL-12":  "Petite-Pologne",
+		"PL-14":  "Mazovie",
+		"PL-16":  "Opole (Silésie centrale)",
+		"PL-18":  "Basses-Carpates",
+		"PL-20":  "Podlachie",
+		"PL-22":  "Poméranie",
+		"PL-24":  "Silésie (Haute-Silésie)",
+		"PL-26":  "Sainte-Croix",
+		"PL-28":  "Varmie-Mazurie",
+		"PL-30":  "Grande-Pologne",
+		"PL-32":  "Poméranie occidentale",
+		"PS-HBN": "Hébron",
+		"PS-JEM": "Jérusalem",
+		"PS-JEN": "Jénine",
+		"PS-NGZ": "Gaza du Nord",
+		"PS-TKM": "Tulkarem",
+		"PT-11":  "Lisbonne",
+		"PT-20":  "Açores (région autonome)",
+		"PT-30":  "Madère (région autonome)",
+		"PY-10":  "Haut-Paraná",
+		"PY-16":  "Haut-Paraguay",
+		"PY-3":   "Cordillère",
+		"RO-B":   "Bucarest",
+		"RS-00":  "Belgrade",
+		"RS-01":  "Bačka septentrionale",
+		"RS-02":  "Banatčka méridionale",
+		"RS-03":  "Banatčka septentrionale",
+		"RS-04":  "Južnobanat",
+		"RS-05":  "Bačka occidentale",
+		"RS-06":  "Južnobač",
+		"RS-07":  "Srem",
+		"RS-08":  "Mačvan",
+		"RS-09":  "Kolubara",
+		"RS-10":  "Podunavlje",
+		"RS-11":  "Braničevo",
+		"RS-12":  "Šumadija",
+		"RS-13":  "Pomoravlje",
+		"RS-14":  "Bor",
+		"RS-15":  "Zaječar",
+		"RS-16":  "Zlatibor",
+		"RS-17":  "Moraviča",
+		"RS-18":  "Raška",
+		"RS-19":  "Rasina",
+		"RS-20":  "Nišava",
+		"RS-21":  "Topliča",
+		"RS-22":  "Pirot",
+		"RS-23":  "Jablaniča",
+		"RS-24":  "Pčinja",
+		"RS-25":  "Kosovo",
+		"RS-26":  "Peć",
+		"RS-27":  "Prizren",
+		"RS-28":  "Kosovo Mitroviča",
+		"RS-29":  "Kosovo-Pomoravlje",
+		"RS-KM":  "Kosovo",
+		"RS-VO":  "Voïvodine",
+		"RU-BA":  "Bachkirie",
+		"RU-CE":  "Tchétchénie",
+		"RU-CHE": "Tchéliabinsk",
+		"RU-CHU": "Tchoukotka",
+		"RU-CU":  "Tchouvachie",
+		"RU-DA":  "Daguestan",
+		"RU-KAM": "Kamtchatka",
+		"RU-KC":  "Karatchaïévo-Tcherkessie",
+		"RU-KLU": "Kalouga",
+		"RU-KO":  "Komis",
+		"RU-MOW": "Moscou",
+		"RU-NIZ": "Nijni Novgorod",
+		"RU-SPE": "Saint-Pétersbourg",
+		"RU-TA":  "Tatarstan",
+		"RU-VOR": "Voronej",
+		"RU-ZAB": "Transbaïkalie",
+		"RW-02":  "Est",
+		"RW-03":  "Nord",
+		"RW-04":  "Ouest",
+		"RW-05":  "Sud",
+		"SA-04":  "Ash Sharqiyah",
+		"SB-CT":  "Honiara (territoire de la capitale)",
+		"SB-RB":  "Rennell et Bellona",
+		"SB-WE":  "Ouest",
+		"SC-03":  "Anse Étoile",
+		"SC-13":  "Grand'Anse Mahé",
+		"SC-14":  "Grand'Anse Praslin",
+		"SC-16":  "La Rivière Anglaise",
+		"SC-20":  "Pointe La Rue",
+		"SC-25":  "Roche Caïman",
+		"SD-NO":  "Nord",
+		"SG-01":  "Singapour centre",
+		"SG-02":  "Nord-Est",
+		"SG-03":  "Nord-Ouest",
+		"SG-04":  "Sud-Est",
+		"SG-05":  "Sud-Ouest",
+		"SH-HL":  "Sainte-Hélène",
+		"SK-BC":  "Banská Bystrica",
+		"SK-BL":  "Bratislava",
+		"SK-KI":  "Košice",
+		"SK-NI":  "Nitra",
+		"SK-PV":  "Prešov",
+		"SK-TA":  "Trnava",
+		"SK-TC":  "Trenčín",
+		"SK-ZI":  "Žilina",
+		"SL-E":   "Est",
+		"SL-N":   "Nord",
+		"SL-S":   "Sud",
+		"SL-W":   "Zone Ouest (Freetown)",
+		"SS-BN":  "Bahr el Ghazal du Nord",
+		"SS-BW":  "Bahr el Gazal occidental",
+		"SS-EC":  "Équateur central",
+		"SS-EE":  "Équateur Oriental",
+		"SS-EW":  "Équateur occidental",
+		"SS-LK":  "Lacs",
+		"SS-NU":  "Haut-Nil",
+		"SS-UY":  "Unité",
+		"SY-DI":  "Damas",
+		"TD-ND":  "Ville de Ndjamena",
+		"TD-TI":  "Tibesti",
+		"TH-75":  "Samdrup Jongkhar",
+		"TM-S":   "Achgabat",
+		"TT-POS": "Port d'Espagne",
+		"TZ-09":  "Kilimandjaro",
+		"UA-40":  "Municipalité de Sébastopol",
+		"UG-E":   "Est",
+		"UG-N":   "Nord",
+		"UG-W":   "Ouest",
+		"UM-67":  "Atoll Johnston",
+		"UM-71":  "Îles Midway",
+		"UM-76":  "Île Navassa",
+		"UM-79":  "Wake",
+		"UM-81":  "Île Baker",
+		"UM-84":  "Ile Howland",
+		"UM-86":  "Île Jarvis",
+		"UM-95":  "Atoll Palmyra",
+		"US-AS":  "Samoa américaines",
+		"US-CA":  "Californie",
+		"US-DC":  "District de Columbia",
+		"US-FL":  "Floride",
+		"US-GA":  "Géorgie",
+		"US-LA":  "Louisiane",
+		"US-MP":  "Îles Mariannes du Nord",
+		"US-NC":  "Caroline du Nord",
+		"US-ND":  "Dakota du Nord",
+		"US-NM":  "Nouveau-Mexique",
+		"US-PA":  "Pennsylvanie",
+		"US-PR":  "Porto Rico",
+		"US-SC":  "Caroline du Sud",
+		"US-SD":  "Dakota du Sud",
+		"US-UM":  "Îles mineures éloignées des États-Unis",
+		"US-VA":  "Virginie",
+		"US-VI":  "Îles Vierges des États-Unis",
+		"US-WV":  "Virginia occidentale",
+		"UY-FD":  "Floride",
+		"UZ-BU":  "Boukhara",
+		"UZ-JI":  "Jizzakh",
+		"UZ-SA":  "Samarcande",
+		"UZ-TK":  "Tachkent",
+		"UZ-TO":  "Tachkent",
+		"VC-02":  "Saint-Andrew",
+		"VC-03":  "Saint-David",
+		"VC-04":  "Saint-George",
+		"VC-05":  "Saint-Patrick",
+		"VE-F":   "Bolivar",
+		"VE-W":   "Dépendances fédérales",
+		"VE-Z":   "Amazone",
+		"VN-HN":  "Hanoi",
+		"VN-HP":  "Hai Phong",
+		"ZA-EC":  "Cap-Oriental",
+		"ZA-FS":  "État-Libre",
+		"ZA-NC":  "Cap-du-Nord",
+		"ZA-NW":  "Nord-Ouest",
+		"ZA-WC":  "Cap-Occidental",
+		"ZM-01":  "Ouest",
+		"ZM-03":  "Est",
+		"ZM-05":  "Nord",
+		"ZM-06":  "North-Ouest",
+		"ZM-07":  "Sud",
+		"ZW-MC":  "Mashonaland central",
+		"ZW-ME":  "Mashonaland oriental",
+		"ZW-MN":  "Matabeleland septentrional",
+		"ZW-MS":  "Matabeleland méridional",
+		"ZW-MW":  "Mashonaland occidental",
+	},
+	"ja": {
+		"AD-07":  "アンドララベリャ",
+		"AE-DU":  "ドバイ",
+		"AE-FU":  "フジャイラ",
+		"AE-RK":  "ラスアルカイマ",
+		"AE-SH":  "シャルジャ",
+		"AF-BAL": "バルフ",
+		"AF-BAM": "バーミヤン",
+		"AF-BDG": "バドギース",
+		"AF-BDS": "バダフシャーン",
+		"AF-BGL": "バグラーン",
+		"AF-DAY": "ダイクンディ",
+		"AF-FRA": "ファラー",
+		"AF-FYB": "ファリヤーブ",
+		"AF-GHA": "ガズニ",
+		"AF-GHO": "ゴール",
+		"AF-HEL": "ヘルマンド",
+		"AF-HER": "ヘラート",
+		"AF-JOW": "ジョウズジャーン",
+		"AF-KAB": "カブール",
+		"AF-KAN": "カンダハール",
+		"AF-KAP": "カピサ",
+		"AF-KDZ": "クンドゥーズ",
+		"AF-KHO": "ホースト",
+		"AF-LAG": "ラグマーン",
+		"AF-LOG": "ローガル",
+		"AF-NAN": "ナンガルハール",
+		"AF-NIM": "ニームローズ",
+		"AF-NUR": "ヌーリスタン",
+		"AF-PAR": "パルワーン",
+		"AF-PIA": "パクティア",
+		"AF-PKA": "パクティカ",
+		"AF-SAM": "サマンガーン",
+		"AF-SAR": "サリプル",
+		"AF-TAK": "タハール",
+		"AF-URU": "ウルズガン",
+		"AF-WAR": "ワルダック",
+		"AF-ZAB": "ザーボル",
+		"AG-03":  "セントジョージ",
+		"AG-04":  "セントジョン",
+		"AG-05":  "セントメアリー",
+		"AG-06":  "セントポール",
+		"AG-07":  "セントピーター",
+		"AG-08":  "セントフィリップ",
+		"AG-10":  "バーブーダ",
+		"AL-02":  "ドゥラス",
+		"AL-03":  "エルバサン",
+		"AL-04":  "フィエル",
+		"AL-06":  "コルチャ",
+		"AL-07":  "クカス",
+		"AL-10":  "シュコダル",
+		"AL-11":  "ティラナ",
+		"AL-12":  "ヴローラ",
+		"AM-AR":  "アララト",
+		"AM-AV":  "アルマヴィル",
+		"AM-ER":  "エレバン",
+		"AO-BGO": "ベンゴ",
+		"AO-BGU": "ベンゲラ",
+		"AO-BIE": "ビエ",
+		"AO-CAB": "カビンダ",
+		"AO-CNN": "クネネ",
+		"AO-HUA": "ワンボー",
+		"AO-HUI": "ウイラ",
+		"AO-LUA": "ルアンダ",
+		"AO-MAL": "マランジェ",
+		"AO-MOX": "モシコ",
+		"AO-NAM": "ナミベ",
+		"AO-UIG": "ウイジェ",
+		"AO-ZAI": "ザイール",
+		"AR-A":   "サルタ",
+		"AR-B":   "ブエノスアイレス",
+		"AR-D":   "サンルイス",
+		"AR-F":   "ラリオハ",
+		"AR-G":   "サンティアゴデルエストロ",
+		"AR-H":   "チャコ",
+		"AR-J":   "サンファン",
+		"AR-K":   "カタマルカ",
+		"AR-L":   "ラパンパ",
+		"AR-M":   "メンドサ",
+		"AR-N":   "ミシオネス",
+		"AR-P":   "フォルモサ",
+		"AR-R":   "リオネグロ",
+		"AR-S":   "サンタフェ",
+		"AR-U":   "チュブト",
+		"AR-V":   "ティエラデルフエゴ",
+		"AR-W":   "コリエンテス",
+		"AR-X":   "コルドバ",
+		"AR-Y":   "フフイ",
+		"AR-Z":   "サンタクルス",
+		"AT-1":   "ブルケンラント",
+		"AT-2":   "ケルンテン",
+		"AT-3":   "ニーダーエスターライヒ",
+		"AT-4":   "オーバーエスターライヒ",
+		"AT-5":   "ザルツブルク",
+		"AT-6":   "シュタイアーマルク",
+		"AT-7":   "ティロル",
+		"AT-8":   "フォーラールベルク",
+		"AT-9":   "ウィーン",
+		"AU-ACT": "オーストラリアキャピタルテリトリー",
+		"AU-NSW": "ニューサウスウェールズ",
+		"AU-NT":  "ノーザンテリトリー",
+		"AU-QLD": "クインズランド",
+		"AU-SA":  "サウスオーストラリア",
+		"AU-TAS": "タスマニア",
+		"AU-VIC": "ビクトリア",
+		"AU-WA":  "ウェスタンオーストラリア",
+		"AZ-BA":  "バクー",
+		"AZ-QBA": "クバ",
+		"AZ-SA":  "シェキ",
+		"AZ-SAK": "シェキ",
+		"AZ-SAL": "サルヤン",
+		"AZ-SM":  "スムカイト",
+		"BA-BIH": "ボスニアヘルツェゴビナ連邦",
+		"BA-SRP": "スルプスカ共和国",
+		"BB-03":  "セントジョージ",
+		"BB-05":  "セントジョン",
+		"BB-09":  "セントピーター",
+		"BB-10":  "セントフィリップ",
+		"BD-01":  "バンドルボン",
+		"BD-05":  "バゲルハット",
+		"BD-11":  "コックスバザール",
+		"BD-12":  "チュアダンガ",
+		"BD-13":  "ダッカ",
+		"BD-14":  "ディナジプル",
+		"BD-15":  "ファリドプル",
+		"BD-21":  "ジャマールプル",
+		"BD-27":  "クールナ",
+		"BD-30":  "クシュティア",
+		"BD-47":  "ノアカリ",
+		"BD-51":  "パトゥアカリ",
+		"BD-54":  "ラージシャヒ",
+		"BD-55":  "ラングプル",
+		"BD-60":  "シルヘット",
+		"BD-63":  "タンガイル",
+		"BD-C":   "ダッカ",
+		"BD-D":   "クールナ",
+		"BD-E":   "ラージシャヒ",
+		"BD-F":   "ラングプル",
+		"BD-G":   "シルヘット",
+		"BE-VAN": "アントワープ",
+		"BE-VBR": "フラームスブラバント",
+		"BE-VLI": "リンブルフ",
+		"BE-VOV": "オーストフラーンデレン",
+		"BE-VWV": "ウエストフラーンデレン",
+		"BE-WAL": "ワロン",
+		"BE-WBR": "ブラバントワロン",
+		"BE-WHT": "エノー",
+		"BE-WLG": "リエージュ",
+		"BE-WLX": "ルクセンブルク",
+		"BE-WNA": "ナミュール",
+		"BF-BAL": "バレ",
+		"BF-BAM": "バム",
+		"BF-BAN": "バンワ",
+		"BF-BAZ": "バゼーガ",
+		"BF-BGR": "ブグリバ",
+		"BF-BLG": "ブルグ",
+		"BF-BLK": "ブルキエムデ",
+		"BF-COM": "コモエ",
+		"BF-GAN": "ガンズルグ",
+		"BF-GNA": "グナグナ",
+		"BF-GOU": "グルマ",
+		"BF-HOU": "フエ",
+		"BF-IOB": "イオバ",
+		"BF-KAD": "カディオゴ",
+		"BF-KEN": "ケネドゥグ",
+		"BF-KMD": "コモンジャリ",
+		"BF-KMP": "コンピエンガ",
+		"BF-KOP": "クルペロゴ",
+		"BF-KOS": "コッシ",
+		"BF-KOW": "クルウェオゴ",
+		"BF-LER": "レラバ",
+		"BF-LOR": "ロルム",
+		"BF-MOU": "ムフン",
+		"BF-NAM": "ナメテンガ",
+		"BF-NAY": "ナヤラ",
+		"BF-NOU": "ヌンビエル",
+		"BF-OUB": "ウブリテンガ",
+		"BF-OUD": "ウダラン",
+		"BF-PAS": "パッソレ",
+		"BF-PON": "ポニ",
+		"BF-SEN": "セノ",
+		"BF-SIS": "シッシリ",
+		"BF-SMT": "サンマテンガ",
+		"BF-SNG": "サンギエ",
+		"BF-SOM": "スム",
+		"BF-SOR": "スル",
+		"BF-TAP": "タポア",
+		"BF-YAG": "ヤガ",
+		"BF-YAT": "ヤテンガ",
+		"BF-ZIR": "ジロ",
+		"BF-ZON": "ゾンドマ",
+		"BF-ZOU": "ズンドウェオゴ",
+		"BG-01":  "ブラゴエフグラード",
+		"BG-02":  "ブルガス",
+		"BG-03":  "ヴァルナ",
+		"BG-04":  "ヴェリコトゥルノヴォ",
+		"BG-05":  "ヴィディン",
+		"BG-06":  "ヴラツァ",
+		"BG-08":  "ドブリチ",
+		"BG-11":  "ロベチ",
+		"BG-12":  "モンタナ",
+		"BG-14":  "ペルニク",
+		"BG-15":  "プレヴェン",
+		"BG-16":  "プロヴディフ",
+		"BG-18":  "ルーセ",
+		"BG-20":  "スリヴェン",
+		"BG-23":  "ソフィア",
+		"BG-24":  "スタラザゴラ",
+		"BG-26":  "ハスコヴォ",
+		"BG-27":  "シューメン",
+		"BI-BB":  "ブバンザ",
+		"BI-BR":  "ブルリ",
+		"BI-CA":  "カンクゾ",
+		"BI-CI":  "チビトケ",
+		"BI-GI":  "ギテガ",
+		"BI-KI":  "キルンド",
+		"BI-KY":  "カヤンザ",
+		"BI-MA":  "マカンバ",
+		"BI-MU":  "ムランビア",
+		"BI-NG":  "ヌゴジ",
+		"BI-RY":  "ルイギ",
+		"BJ-AL":  "アリボリ",
+		"BJ-AQ":  "アトランティック",
+		"BJ-BO":  "ボルゴー",
+		"BJ-CO":  "コリネ",
+		"BJ-DO":  "ドンガ",
+		"BJ-LI":  "リトラル",
+		"BJ-MO":  "モノ",
+		"BJ-OU":  "ウェメ",
+		"BJ-PL":  "プラトー",
+		"BJ-ZO":  "ズー",
+		"BN-BE":  "ブライト",
+		"BN-BM":  "ブルネイムアラ",
+		"BN-TE":  "トゥンブロン",
+		"BN-TU":  "トゥトン",
+		"BO-C":   "コチャバンバ",
+		"BO-L":   "ラパス",
+		"BO-N":   "パンド",
+		"BO-O":   "オルロ",
+		"BO-P":   "ポトシ",
+		"BO-S":   "サンタクルス",
+		"BO-T":   "タリハ",
+		"BR-AC":  "アクレ",
+		"BR-AL":  "アラゴアス",
+		"BR-AM":  "アマゾナス",
+		"BR-AP":  "アマパ",
+		"BR-BA":  "バイア",
+		"BR-CE":  "セアラ",
+		"BR-ES":  "エスピリトサント",
+		"BR-GO":  "ゴイアス",
+		"BR-MA":  "マラニョン",
+		"BR-MG":  "ミナスジェライス",
+		"BR-MS":  "マトグロッソドスル",
+		"BR-MT":  "マトグロッソ",
+		"BR-PA":  "パラ",
+		"BR-PB":  "パライバ",
+		"BR-PE":  "ペルナンブコ",
+		"BR-PI":  "ピアウイ",
+		"BR-PR":  "パラナ",
+		"BR-RJ":  "リオデジャネイロ",
+		"BR-RN":  "リオグランデドノルテ",
+		"BR-RO":  "ロンドニア",
+		"BR-RR":  "ロライマ",
+		"BR-RS":  "リオグランデドスル",
+		"BR-SC":  "サンタカタリナ",
+		"BR-SE":  "セルジッペ",
+		"BR-SP":  "サンパウロ",
+		"BR-TO":  "トカンチンス",
+		"BS-AK":  "アクリンズ島",
+		"BS-CI":  "キャット島",
+		"BS-IN":  "イナグア島",
+		"BS-LI":  "ロング島",
+		"BS-MG":  "マヤグアナ島",
+		"BS-SS":  "サンサルバドル",
+		"BT-11":  "パロ",
+		"BT-12":  "チュカ",
+		"BT-15":  "ティンプー",
+		"BT-21":  "チラン",
+		"BT-22":  "タカナ",
+		"BT-23":  "プナカ",
+		"BT-24":  "ワンドゥポダン",
+		"BT-31":  "サルパン",
+		"BT-33":  "ブムタン",
+		"BT-34":  "シェムガン",
+		"BT-41":  "タシガン",
+		"BT-42":  "モンガル",
+		"BT-GA":  "ガサ",
+		"BT-TY":  "ヤンツェ",
+		"BW-CE":  "セントラル",
+		"BW-GH":  "ガンジ",
+		"BW-KG":  "ガラガティ",
+		"BW-KL":  "ガトレング",
+		"BW-KW":  "クウェネング",
+		"BZ-BZ":  "ベリーズ",
+		"BZ-CZL": "コロサル",
+		"BZ-OW":  "オレンジウォーク",
+		"BZ-TOL": "トレド",
+		"CA-AB":  "アルバータ",
+		"CA-BC":  "ブリティッシュコロンビア",
+		"CA-MB":  "マニトバ",
+		"CA-NB":  "ニューブランズウィック",
+		"CA-NL":  "ニューファンドランド=ラブラドル",
+		"CA-NS":  "ノヴァスコシア",
+		"CA-NT":  "ノースウェストテリトリーズ",
+		"CA-NU":  "ヌナブット",
+		"CA-ON":  "オンタリオ",
+		"CA-PE":  "プリンスエドワードアイランド",
+		"CA-QC":  "ケベック",
+		"CA-SK":  "サスカチェワン",
+		"CD-EQ":  "赤道州",
+		"CD-KN":  "キンシャサ",
+		"CD-MA":  "マニエマ州",
+		"CD-NK":  "北キブ州",
+		"CD-SK":  "南キブ州",
+		"CF-AC":  "ウハム州",
+		"CF-BB":  "バミンギバンゴラン州",
+		"CF-BGF": "バンギ",
+		"CF-BK":  "バスコト州",
+		"CF-HK":  "オートコト州",
+		"CF-HM":  "オームボム州",
+		"CF-HS":  "マンベレカデイ州",
+		"CF-LB":  "ロバイエ州",
+		"CF-MB":  "ムボム州",
+		"CF-NM":  "ナナメンベレ州",
+		"CF-OP":  "ウハムペンデ州",
+		"CF-UK":  "ワカ州",
+		"CF-VK":  "バカガ州州",
+		"CG-11":  "ブエンザ",
+		"CG-12":  "プール",
+		"CG-14":  "プラトー",
+		"CG-2":   "レクム",
+		"CG-5":   "クイール",
+		"CG-7":   "リクアラ",
+		"CG-9":   "ニアリ",
+		"CG-BZV": "ブラザビル",
+		"CH-AG":  "アールガウ",
+		"CH-AI":  "アペンツェルインナーローデン",
+		"CH-AR":  "アペンツェルアウサーローデン",
+		"CH-BE":  "ベルン",
+		"CH-BL":  "バーゼルランドシャフト",
+		"CH-BS":  "バーゼルシュタット",
+		"CH-GE":  "ジュネーブ",
+		"CH-GL":  "グラールス",
+		"CH-GR":  "グラウビュンデン",
+		"CH-JU":  "ジュラ",
+		"CH-LU":  "ルツェルン",
+		"CH-NE":  "ヌシャテル",
+		"CH-OW":  "オブワルデン",
+		"CH-SG":  "ザンクトガレン",
+		"CH-SH":  "シャフハウゼン",
+		"CH-SO":  "ソーロトゥルン",
+		"CH-SZ":  "シュウィーツ",
+		"CH-TI":  "ティチーノ",
+		"CH-UR":  "ウーリ",
+		"CH-VD":  "ボー",
+		"CH-VS":  "バレ",
+		"CH-ZG":  "ツーク",
+		"CH-ZH":  "チューリヒ",
+		"CI-CM":  "コモエ",
+		"CL-AN":  "アントファガスタ",
+		"CL-AT":  "アタカマ",
+		"CL-CO":  "コキンボ",
+		"CL-LL":  "ロスラゴス",
+		"CL-ML":  "マウレ",
+		"CL-TA":  "タラパカ",
+		"CL-VS":  "バルパライソ",
+		"CM-AD":  "アダマワ州",
+		"CM-EN":  "極北州",
+		"CM-ES":  "東部州",
+		"CM-LT":  "リトラル",
+		"CM-NO":  "北部州",
+		"CM-OU":  "西部州",
+		"CM-SU":  "南部州",
+		"CM-SW":  "南西州",
+		"CO-AMA": "アマゾナス",
+		"CO-ANT": "アンティオキア",
+		"CO-ARA": "アラウカ",
+		"CO-ATL": "アトランティコ",
+		"CO-BOL": "ボリバル",
+		"CO-CAL": "カルダス",
+		"CO-CAQ": "カケタ",
+		"CO-CAS": "カサナレ",
+		"CO-CAU": "カウカ",
+		"CO-CES": "セサール",
+		"CO-CHO": "チョコ",
+		"CO-COR": "コルドバ",
+		"CO-DC":  "ボゴタ",
+		"CO-GUA": "グアイニア",
+		"CO-GUV": "グアビアレ",
+		"CO-HUI": "ウイラ",
+		"CO-MAG": "マグダレナ",
+		"CO-MET": "メタ",
+		"CO-NAR": "ナリーニョ",
+		"CO-NSA": "ノンテデサンタンデール",
+		"CO-PUT": "プトゥマジョ",
+		"CO-QUI": "キンディオ",
+		"CO-RIS": "リサラルダ",
+		"CO-SAN": "サンタンデール",
+		"CO-SUC": "スクレ",
+		"CO-TOL": "トリマ",
+		"CO-VAU": "バウペス",
+		"CO-VID": "ビチャダ",
+		"CR-A":   "アラフエラ",
+		"CR-C":   "カルタゴ",
+		"CR-G":   "グアナカステ",
+		"CR-H":   "エレディア",
+		"CR-L":   "リモン",
+		"CR-P":   "プンタレナス",
+		"CR-SJ":  "サンホゼ",
+		"CU-04":  "マタンサス",
+		"CU-05":  "ビヤクララ",
+		"CU-06":  "シエンフエゴス",
+		"CU-07":  "サンクティスピリトゥス",
+		"CU-08":  "シエゴデアビラ",
+		"CU-09":  "カマグエイ",
+		"CU-10":  "ラストゥーナス",
+		"CU-11":  "オルギン",
+		"CU-12":  "グランマ",
+		"CU-13":  "サンティアゴデクーバ",
+		"CU-14":  "グアンタナモ",
+		"CU-99":  "ラフベントゥド島",
+		"CV-BV":  "ボアヴィスタ",
+		"CV-CA":  "サンタカタリナ",
+		"CV-CR":  "サンタクルス",
+		"CV-MA":  "マイオ島",
+		"CV-PR":  "プライア",
+		"CV-SL":  "サル",
+		"CV-SV":  "サンビセンテ島",
+		"CZ-311": "ブジョヨビツェ",
+		"CZ-32":  "プルゼニ",
+		"CZ-41":  "カルロバリ",
+		"CZ-412": "カルロバリ",
+		"CZ-42":  "ウースチー",
+		"CZ-425": "モスト",
+		"CZ-51":  "リベレツ",
+		"CZ-52":  "クラードベーフラデツ",
+		"CZ-53":  "パルドゥビツェ",
+		"CZ-532": "パルドゥビツェ",
+		"CZ-71":  "オロモウツ",
+		"CZ-712": "オロモウツ",
+		"CZ-72":  "ズリーン",
+		"DE-BB":  "ブランデンブルク",
+		"DE-BE":  "ベルリン",
+		"DE-BW":  "バーデンヴュルテンベルク",
+		"DE-BY":  "バイエルン",
+		"DE-HB":  "ブレーメン",
+		"DE-HE":  "ヘッセン",
+		"DE-HH":  "ハンブルク",
+		"DE-MV":  "メクレンブルク=フォアポンメルン",
+		"DE-NI":  "ニーダーザクセン",
+		"DE-NW":  "ノルトライン=ヴェストファーレン",
+		"DE-RP":  "ラインラントファルツ",
+		"DE-SH":  "シュレースヴィヒホルシュタイン",
+		"DE-SL":  "ザールラント",
+		"DE-SN":  "ザクセン",
+		"DE-ST":  "ザクセン=アンハルト",
+		"DE-TH":  "テューリンゲン",
+		"DJ-AR":  "アルタ",
+		"DJ-AS":  "アリサビエ",
+		"DJ-DI":  "ディキル",
+		"DJ-DJ":  "ジブチ",
+		"DJ-TA":  "タジュラ",
+		"DM-04":  "セントジョージ",
+		"DM-05":  "セントジョン",
+		"DM-10":  "セントポール",
+		"DM-11":  "セントピーター",
+		"DO-01":  "首都圏",
+		"DO-02":  "アスア",
+		"DO-04":  "バラオナ",
+		"DO-05":  "ダハボン",
+		"DO-06":  "ドゥアルテ",
+		"DO-09":  "エスパイジャット",
+		"DO-10":  "インディペンデンシア",
+		"DO-11":  "ラアルタグラシア",
+		"DO-12":  "ラロマーナ",
+		"DO-13":  "ラベーガ",
+		"DO-14":  "マリアトリニダードサンチェス",
+		"DO-15":  "モンテクリスティ",
+		"DO-16":  "ペデルナレス",
+		"DO-18":  "プエルトプラタ",
+		"DO-20":  "サマナ",
+		"DO-21":  "サンクリストバル",
+		"DO-22":  "サンファン",
+		"DO-23":  "サンペドロデマコリス",
+		"DO-24":  "サンチェスラミレス",
+		"DO-25":  "サンティアゴ",
+		"DO-27":  "バルベルデ",
+		"DO-28":  "モンセニョールノエル",
+		"DO-29":  "モンテプラタ",
+		"DO-30":  "アトマヨール",
+		"DZ-01":  "アドラル",
+		"DZ-02":  "シェリーフ",
+		"DZ-03":  "ラグアッツト",
+		"DZ-04":  "ウメルプアーギ",
+		"DZ-05":  "バトナ",
+		"DZ-06":  "ベジャイア",
+		"DZ-07":  "ビスクラ",
+		"DZ-08":  "ベシャル",
+		"DZ-09":  "ブリダ",
+		"DZ-10":  "ブイラ",
+		"DZ-12":  "テベサ",
+		"DZ-13":  "トレムセン",
+		"DZ-14":  "ティアレト",
+		"DZ-15":  "ティジウズ",
+		"DZ-16":  "アルジェ",
+		"DZ-17":  "ジェルファ",
+		"DZ-18":  "ジジェル",
+		"DZ-19":  "セティフ",
+		"DZ-20":  "サイダ",
+		"DZ-21":  "スキクダ",
+		"DZ-22":  "シディベルアベス",
+		"DZ-23":  "アナバ",
+		"DZ-24":  "ゲルマ",
+		"DZ-25":  "コンスタンティーヌ",
+		"DZ-26":  "メデア",
+		"DZ-27":  "モスタガネム",
+		"DZ-29":  "マスカラ",
+		"DZ-30":  "ワルグラ",
+		"DZ-31":  "オラン",
+		"DZ-32":  "エルバヤード",
+		"DZ-33":  "イリジ",
+		"DZ-34":  "ボルジブアレリジ",
+		"DZ-35":  "ブーメルデス",
+		"DZ-36":  "エルタルフ",
+		"DZ-37":  "ティンドーフ",
+		"DZ-38":  "ティセムシルト",
+		"DZ-39":  "エルウエッド",
+		"DZ-40":  "ヘンシュラ",
+		"DZ-41":  "スーカハラス",
+		"DZ-42":  "ティパザ",
+		"DZ-43":  "ミラ",
+		"DZ-44":  "アインデフラ",
+		"DZ-45":  "ナーマ",
+		"DZ-46":  "アインテムシェント",
+		"DZ-47":  "ガルダイア",
+		"DZ-48":  "ルリザンヌ",
+		"EC-A":   "アスアイ",
+		"EC-B":   "ボリバル",
+		"EC-C":   "カルチ",
+		"EC-D":   "オレジャナ",
+		"EC-E":   "エスメラルダス",
+		"EC-F":   "カニャル",
+		"EC-G":   "グアヤス",
+		"EC-H":   "チンボラソ",
+		"EC-I":   "インバブラ",
+		"EC-L":   "ロハ",
+		"EC-M":   "マナビ",
+		"EC-N":   "ナポ",
+		"EC-O":   "エルオロ",
+		"EC-P":   "ピチンチャ",
+		"EC-T":   "トゥングラウア",
+		"EC-U":   "スクンビオス",
+		"EC-W":   "ガラパゴス",
+		"EC-X":   "コトパクシ",
+		"EC-Y":   "パスタサ",
+		"EE-205": "ヒーウマー",
+		"EE-39":  "ヒーウマー",
+		"EE-714": "サーレマー",
+		"EE-74":  "サーレマー",
+		"EG-ASN": "アスワン",
+		"EG-BA":  "紅海",
+		"EG-BNS": "ベニスエフ",
+		"EG-FYM": "ファユーム",
+		"EG-GH":  "ガルビーヤ",
+		"EG-KN":  "キーナ",
+		"EG-MN":  "ミニヤ",
+		"EG-SHG": "ソハーグ",
+		"EG-SUZ": "スエズ",
+		"ES-AB":  "アルバセテ",
+		"ES-AL":  "アルメリア",
+		"ES-AN":  "アンダルシア",
+		"ES-AR":  "アラゴン",
+		"ES-AS":  "アストゥリアス",
+		"ES-AV":  "アビラ",
+		"ES-BA":  "バダホス",
+		"ES-BU":  "ブルゴス",
+		"ES-CA":  "カディス",
+		"ES-CB":  "カンタブリア",
+		"ES-CC":  "カセレス",
+		"ES-CE":  "セウタ",
+		"ES-CL":  "カスティーリャレオン",
+		"ES-CM":  "カスティーリャラマンチャ",
+		"ES-CN":  "カナリア",
+		"ES-CO":  "コルドバ",
+		"ES-CR":  "シウダーレアル",
+		"ES-CU":  "クエンカ",
+		"ES-EX":  "エストレマドゥーラ",
+		"ES-GC":  "ラスパルマス",
+		"ES-GR":  "グラナダ",
+		"ES-GU":  "グアダラハラ",
+		"ES-H":   "ウエルバ",
+		"ES-HU":  "ウエスカ",
+		"ES-J":   "ハエン",
+		"ES-LE":  "レオン",
+		"ES-LO":  "ラリオハ",
+		"ES-M":   "マドリード",
+		"ES-MA":  "マラガ",
+		"ES-MC":  "ムルシア",
+		"ES-MD":  "マドリード州",
+		"ES-ML":  "メリリヤ",
+		"ES-MU":  "ムルシア",
+		"ES-O":   "アストゥリアス",
+		"ES-P":   "パレンシア",
+		"ES-RI":  "ラリオハ",
+		"ES-S":   "カンタブリア",
+		"ES-SA":  "サラマンカ",
+		"ES-SE":  "セビーリャ",
+		"ES-SG":  "セゴビア",
+		"ES-SO":  "ソリア",
+		"ES-TE":  "テルエル",
+		"ES-TF":  "サンタクルス・デ・テネリフェ",
+		"ES-TO":  "トレド",
+		"ES-VA":  "バリャドリード",
+		"ES-Z":   "サラゴーサ",
+		"ES-ZA":  "サモラ",
+		"FI-10":  "ラッピ",
+		"FJ-C":   "セントラル",
+		"FJ-R":   "ロトゥーマ",
+		"FM-KSA": "コスラエ",
+		"FM-PNI": "ポーンペイ",
+		"FM-YAP": "ヤップ",
+		"FR-20R": "コルス (コルシカ)",
+		"FR-39":  "ジュラ",
+		"FR-75":  "パリ",
+		"FR-971": "グアドループ",
+		"FR-972": "マルティニーク",
+		"FR-976": "マヨット",
+		"FR-BRE": "ブルターニュ",
+		"FR-GP":  "グアドループ",
+		"FR-IDF": "イルドフランス",
+		"FR-MQ":  "マルティニーク",
+		"FR-YT":  "マヨット",
+		"GA-1":   "エスチュエール",
+		"GA-2":   "オートオグエ",
+		"GA-3":   "モアイヤンオグエ",
+		"GA-4":   "ングニエ",
+		"GA-5":   "ニヤンガ",
+		"GA-6":   "オグエイビンド",
+		"GA-7":   "オグエロロ",
+		"GA-8":   "オグエマリティーム",
+		"GA-9":   "ワォルンテム",
+		"GB-ABD": "アバディーンシア",
+		"GB-ABE": "アバディーン",
+		"GB-AGB": "アーガイル及びビュート",
+		"GB-ANS": "アンガス",
+		"GB-BAS": "バス及び北西サマセット",
+		"GB-BBD": "ブラックバーン with ダーウェン",
+		"GB-BDF": "ベッドフォード",
+		"GB-BDG": "バーキング及びダゲナム",
+		"GB-BEN": "ブレント",
+		"GB-BEX": "ベクスリー",
+		"GB-BGW": "ブレナウ グウェント",
+		"GB-BIR": "バーミンガム",
+		"GB-BKM": "バッキンガムシャー",
+		"GB-BNE": "バーネット",
+		"GB-BNH": "ブリントンアンドホーブ",
+		"GB-BNS": "バーンズリー",
+		"GB-BOL": "ボールトン",
+		"GB-BPL": "ブラックプール",
+		"GB-BRC": "ブラックネル フォレスト",
+		"GB-BRD": "ブラッドフォード",
+		"GB-BRY": "ブロムリー",
+		"GB-BST": "ブリストル",
+		"GB-BUR": "ベリー",
+		"GB-CAM": "ケンブリッジシャー",
+		"GB-CLK": "クラックマナンシア",
+		"GB-CMA": "カンブリア",
+		"GB-CMD": "カムデン",
+		"GB-CON": "コーンウォール",
+		"GB-COV": "コヴェントリー",
+		"GB-CRY": "クロイドン",
+		"GB-CWY": "コンウェー",
+		"GB-DAL": "ダーリントン",
+		"GB-DBY": "ダービーシャー",
+		"GB-DER": "ダービー",
+		"GB-DEV": "デボン",
+		"GB-DGY": "ダムフリースアンドギャラウェイ",
+		"GB-DNC": "ドンカスター",
+		"GB-DND": "ダンディーシティ",
+		"GB-DOR": "ドーセット",
+		"GB-DUD": "ダッドリー",
+		"GB-EAL": "イーリング",
+		"GB-EAY": "東エアーシア",
+		"GB-EDH": "エディンバラ",
+		"GB-EDU": "東ダンバートンシャー",
+		"GB-ERW": "東レンフルーシャー",
+		"GB-ERY": "ヨークシャー州イーストライディング",
+		"GB-ESX": "東サセックス",
+		"GB-GLG": "グラスゴー",
+		"GB-GLS": "グロスターシャー",
+		"GB-GRE": "グリニッジ",
+		"GB-HAM": "ハンプシャー",
+		"GB-HEF": "ヘレフォードシャー",
+		"GB-LAN": "ランカシャー",
+		"GB-LIV": "リヴァプール",
+		"GB-LND": "ロンドン",
+		"GB-MAN": "マンチェスター",
+		"GB-MDB": "ミドルズブラ",
+		"GB-MIK": "ミルトンキーンズ",
+		"GB-NET": "ニューカッスル",
+		"GB-NFK": "ノーフォーク",
+		"GB-NTH": "ノーサンプトンシャー",
+		"GB-PLY": "プリマス",
+		"GB-POR": "ポーツマス",
+		"GB-PTE": "ピーターバラ",
+		"GB-SND": "サンダーランド",
+		"GB-STH": "サウサンプトン",
+		"GB-WLV": "ウルヴァーハンプトン",
+		"GB-YOR": "ヨーク",
+		"GD-03":  "セントジョージ",
+		"GD-04":  "セントジョン",
+		"GE-AB":  "アブハジア",
+		"GE-AJ":  "アジァリア",
+		"GH-AA":  "グレーターアクラ",
+		"GH-AH":  "アシャンティ",
+		"GH-CP":  "セントラル",
+		"GH-TV":  "ヴォルタ",
+		"GH-UE":  "アッパーイースト",
+		"GH-UW":  "アッパーウエスト",
+		"GM-B":   "バンジュール",
+		"GN-B":   "ボケ",
+		"GN-BE":  "ベイラ",
+		"GN-BF":  "ボッファ",
+		"GN-BK":  "ボケ",
+		"GN-C":   "コナクリ",
+		"GN-CO":  "コヤ",
+		"GN-D":   "キンディア",
+		"GN-DB":  "ダボラ",
+		"GN-DI":  "ディンギライ",
+		"GN-DL":  "ダラバ",
+		"GN-DU":  "デブレカ",
+		"GN-F":   "ファラナ",
+		"GN-FA":  "ファラナ",
+		"GN-FO":  "フォレカリア",
+		"GN-FR":  "フリア",
+		"GN-GA":  "ガワアル",
+		"GN-GU":  "ゲケドゥー",
+		"GN-K":   "カンカン",
+		"GN-KA":  "カンカン",
+		"GN-KB":  "クビア",
+		"GN-KD":  "キンディア",
+		"GN-KE":  "ケルアネ",
+		"GN-KN":  "クンダラ",
+		"GN-KO":  "クルーサ",
+		"GN-KS":  "キシドゥーグー",
+		"GN-L":   "ラベー",
+		"GN-LA":  "ラベー",
+		"GN-LE":  "レルーマ",
+		"GN-LO":  "ローラ",
+		"GN-M":   "マムー",
+		"GN-MC":  "マセンタ",
+		"GN-MD":  "マンディアナ",
+		"GN-ML":  "マリ",
+		"GN-MM":  "マムー",
+		"GN-N":   "ゼレコレ",
+		"GN-NZ":  "ゼレコレ",
+		"GN-PI":  "ピタ",
+		"GN-SI":  "シギリ",
+		"GN-TE":  "テリメレ",
+		"GN-TO":  "トゥゲ",
+		"GN-YO":  "ヨムー",
+		"GT-AV":  "アルタベラパス",
+		"GT-BV":  "バハベラパス",
+		"GT-CM":  "チマルテナンゴ",
+		"GT-CQ":  "チキムラ",
+		"GT-ES":  "エスクイントラ",
+		"GT-GU":  "グアテマラ",
+		"GT-HU":  "フェヴェテナンゴ",
+		"GT-IZ":  "イザバル",
+		"GT-JA":  "ハラパ",
+		"GT-JU":  "フティアパ",
+		"GT-PE":  "ペテン",
+		"GT-PR":  "エルプレグレソ",
+		"GT-QC":  "キチュ",
+		"GT-QZ":  "ケサルテナンゴ",
+		"GT-RE":  "レタルレウ",
+		"GT-SA":  "サカテペケス",
+		"GT-SM":  "サンマルコス",
+		"GT-SO":  "ソロラ",
+		"GT-SR":  "サンタロサ",
+		"GT-SU":  "スチテペケス",
+		"GT-TO":  "トトニカパン",
+		"GT-ZA":  "サカパ",
+		"GW-BA":  "バファター",
+		"GW-BM":  "ビオンボ",
+		"GW-BS":  "ビサウ",
+		"GW-CA":  "カシュウ",
+		"GW-GA":  "ガブー",
+		"GW-OI":  "オイオ",
+		"GW-QU":  "キナラ",
+		"GW-TO":  "トンバリ",
+		"GY-BA":  "バリマワイニ",
+		"GY-CU":  "クユニマザルニ",
+		"GY-DE":  "デメララマハイカ",
+		"GY-EB":  "イーストバービーズコレンタイン",
+		"GY-ES":  "エセキポアイランズウエストデメララ",
+		"GY-MA":  "マハイカバービーズ",
+		"GY-PM":  "ポメルーンスペナーム",
+		"GY-PT":  "ポタロシパルニ",
+		"GY-UD":  "アッパーデメララバービーズ",
+		"GY-UT":  "アッパータクトゥアッパーエセキポ",
+		"HN-AT":  "アトランティダ",
+		"HN-CH":  "チョルテカ",
+		"HN-CL":  "コロン",
+		"HN-CM":  "コマイアグア",
+		"HN-CP":  "コパン",
+		"HN-CR":  "コルテス",
+		"HN-EP":  "エルパライソ",
+		"HN-FM":  "フランシスコモラサン",
+		"HN-GD":  "グラシアスアディオス",
+		"HN-IN":  "インティブカ",
+		"HN-LE":  "レンピラ",
+		"HN-LP":  "ラパス",
+		"HN-OC":  "オコテペク",
+		"HN-OL":  "オランチョ",
+		"HN-SB":  "サンタバルバラ",
+		"HN-YO":  "ヨロ",
+		"HT-AR":  "アルトヴィン",
+		"HT-NE":  "北東県",
+		"HT-NO":  "北西県",
+		"HU-BA":  "バラニャ",
+		"HU-BE":  "ベーケーシュ",
+		"HU-BK":  "バーチキシュクン",
+		"HU-BU":  "ブダペスト",
+		"HU-BZ":  "ボルショドアバウジゼムプレーン",
+		"HU-CS":  "チョングラード",
+		"HU-DE":  "デブレツェン",
+		"HU-FE":  "フェイエール",
+		"HU-GS":  "ジェールモションショプロン",
+		"HU-HB":  "ハイデュービハル",
+		"HU-HE":  "ヘベシュ",
+		"HU-JN":  "ヤースナジクンソルノク",
+		"HU-KE":  "コマーロムエステルゴム",
+		"HU-MI":  "ミシュコルツ",
+		"HU-NO":  "ノーグラード",
+		"HU-PE":  "ペシュト",
+		"HU-SO":  "ソモジ",
+		"HU-SZ":  "サボルチサトマールベレグ",
+		"HU-TO":  "トルナ",
+		"HU-VA":  "バシュ",
+		"HU-VE":  "ベスフレーム",
+		"HU-VM":  "ベスフレーム",
+		"HU-ZA":  "ザラ",
+		"ID-AC":  "アチェ",
+		"ID-BA":  "バリ",
+		"ID-BE":  "ブンクル",
+		"ID-GO":  "ゴロンタロ",
+		"ID-JA":  "ジャンビ",
+		"ID-JB":  "ジャワ州",
+		"ID-JI":  "東ジャワ",
+		"ID-JT":  "中部ジャワ",
+		"ID-JW":  "ジャワ",
+		"ID-KA":  "カリマンタン",
+		"ID-KB":  "カリマンタン州",
+		"ID-KI":  "東カリマンタン",
+		"ID-KS":  "南カリマンタン",
+		"ID-KT":  "中部カリマンタン",
+		"ID-LA":  "ランポン",
+		"ID-MA":  "マルク",
+		"ID-ML":  "マルク",
+		"ID-MU":  "北マルク",
+		"ID-NB":  "ヌサトゥンガラ州",
+		"ID-NT":  "東ヌサトゥンガラ",
+		"ID-NU":  "ヌサトゥンガラ",
+		"ID-PA":  "パプア",
+		"ID-PB":  "パプア州",
+		"ID-PP":  "パプア",
+		"ID-RI":  "リアウ",
+		"ID-SA":  "北スラウェシ",
+		"ID-SG":  "東南スラウェシ",
+		"ID-SL":  "スラウェシ",
+		"ID-SM":  "スマトラ",
+		"ID-SN":  "南スラウェシ",
+		"ID-SR":  "スラウェシ州",
+		"ID-ST":  "中部スラウェシ",
+		"ID-SU":  "北スマトラ",
+		"ID-YO":  "ジョクジャカルタ",
+		"IE-CE":  "クレア",
+		"IE-CO":  "コーク",
+		"IE-D":   "ダブリン",
+		"IE-DL":  "ドニゴール",
+		"IE-G":   "ゴルウェー",
+		"IE-KE":  "キルデア",
+		"IE-KK":  "キルケニ",
+		"IE-KY":  "ケリー",
+		"IE-LD":  "ロングフォード",
+		"IE-LK":  "リムリック",
+		"IE-LM":  "リートリム",
+		"IE-MH":  "ミース",
+		"IE-MN":  "モナガン",
+		"IE-MO":  "メーオー",
+		"IE-OY":  "オファリー",
+		"IE-RN":  "ロスコモン",
+		"IE-SO":  "スライゴ",
+		"IE-TA":  "ティペレアリ",
+		"IE-WD":  "ウォーターフォード",
+		"IE-WH":  "ウェストミース",
+		"IE-WW":  "ウィックロー",
+		"IE-WX":  "ウェクスフォード",
+		"IN-AN":  "アンダマン=ニコバル諸島",
+		"IN-AP":  "アンドラプラデシュ",
+		"IN-AS":  "アッサム",
+		"IN-DL":  "デリー",
+		"IN-GA":  "ゴア",
+		"IN-KL":  "ケーララ",
+		"IN-LD":  "ラクシャドウィープ",
+		"IN-MN":  "マニプル",
+		"IN-MP":  "マッディアプラデシュ",
+		"IN-MZ":  "ミゾラム",
+		"IN-PB":  "パンジャーブ",
+		"IN-SK":  "シッキム",
+		"IN-TR":  "トリプラ",
+		"IN-UP":  "ウッタルプラデシュ",
+		"IN-WB":  "ウェストベンガル",
+		"IR-00":  "マルキャズィ",
+		"IR-01":  "ギーラーン",
+		"IR-02":  "マーザンダラン",
+		"IR-05":  "ケルマンシャー",
+		"IR-06":  "フーゼスタン",
+		"IR-07":  "ファールス",
+		"IR-08":  "ケルマーン",
+		"IR-10":  "イスファハン",
+		"IR-11":  "シスタンバルスチスタン",
+		"IR-12":  "クルディスタン",
+		"IR-13":  "ハマダーン",
+		"IR-15":  "ロレスタン",
+		"IR-16":  "イーラーム",
+		"IR-18":  "ブシエール",
+		"IR-19":  "ザンジャーン",
+		"IR-20":  "セムナーン",
+		"IR-21":  "ヤズド",
+		"IR-22":  "ホルモズガン",
+		"IR-23":  "テヘラン",
+		"IR-24":  "アルダビール",
+		"IR-25":  "コム",
+		"IR-26":  "ガズヴィーン",
+		"IR-27":  "ゴレスタン",
+		"IS-3":   "ベストゥルラント",
+		"IS-4":   "ベストフィルジル",
+		"IS-5":   "ノルズルラントベストラ",
+		"IS-6":   "ノルズルラントエイストラ",
+		"IS-7":   "オイストゥルラント",
+		"IS-8":   "スーズルラント",
+		"IT-21":  "ピエモンテ",
+		"IT-25":  "ロンバルディア",
+		"IT-32":  "トレンティノ=アルトアディジェ",
+		"IT-34":  "ヴェネト",
+		"IT-42":  "リグーリア",
+		"IT-45":  "エミリア=ロマーニャ",
+		"IT-52":  "トスカーナ",
+		"IT-55":  "ウンブリア",
+		"IT-57":  "マルケ",
+		"IT-62":  "ラツィオ",
+		"IT-65":  "アブルッツォ",
+		"IT-67":  "モリーゼ",
+		"IT-72":  "カンパニア",
+		"IT-75":  "プーリア",
+		"IT-77":  "バジリカータ",
+		"IT-78":  "カラブリア",
+		"IT-82":  "シチリア",
+		"IT-88":  "サルデーニャ",
+		"IT-AG":  "アグリジェント",
+		"IT-AN":  "アンコーナ",
+		"IT-AP":  "アスコリピチェーノ",
+		"IT-AQ":  "ラクイラ",
+		"IT-AR":  "アレッツォ",
+		"IT-AT":  "アスティ",
+		"IT-AV":  "アヴェリーノ",
+		"IT-BA":  "バリ",
+		"IT-BG":  "ベルガモ",
+		"IT-BN":  "ベネヴェント",
+		"IT-BO":  "ボローニャ",
+		"IT-BR":  "ブリンディジ",
+		"IT-BS":  "ブレシア",
+		"IT-CA":  "カリアリ",
+		"IT-CH":  "キエティ",
+		"IT-CL":  "カルタニセッタ",
+		"IT-CN":  "クーネオ",
+		"IT-CO":  "コモ",
+		"IT-CS":  "コゼンツァ",
+		"IT-CT":  "カターニア",
+		"IT-CZ":  "カタンザーロ",
+		"IT-EN":  "エンナ",
+		"IT-FE":  "フェラーラ",
+		"IT-FG":  "フォッジャ",
+		"IT-FI":  "フィレンツェ",
+		"IT-FR":  "フロジノーネ",
+		"IT-GE":  "ジェノバ",
+		"IT-IS":  "イゼルニア",
+		"IT-KR":  "クロトーネ",
+		"IT-LE":  "レッチェ",
+		"IT-LI":  "リヴォルノ",
+		"IT-MC":  "マチェラータ",
+		"IT-ME":  "メッシーナ",
+		"IT-MI":  "ミラノ",
+		"IT-MO":  "モデナ",
+		"IT-MT":  "マテーラ",
+		"IT-NA":  "ナポリ",
+		"IT-NO":  "ノヴァーラ",
+		"IT-PA":  "パレルモ",
+		"IT-PC":  "ピアチェンツァ",
+		"IT-PD":  "パドヴァ",
+		"IT-PE":  "ペスカーラ",
+		"IT-PG":  "ペルージャ",
+		"IT-PR":  "パルマ",
+		"IT-PZ":  "ポテンツァ",
+		"IT-RA":  "ラヴェンナ",
+		"IT-RC":  "レッジョディカラブリア",
+		"IT-RG":  "ラグーサ",
+		"IT-RM":  "ローマ",
+		"IT-RN":  "リミニ",
+		"IT-SA":  "サレルノ",
+		"IT-SI":  "シエナ",
+		"IT-SO":  "ソンドリオ",
+		"IT-SR":  "シラクーサ",
+		"IT-SS":  "サッサリ",
+		"IT-SV":  "サヴォーナ",
+		"IT-TA":  "タラント",
+		"IT-TN":  "トレント",
+		"IT-TO":  "トリノ",
+		"IT-TP":  "トラパニ",
+		"IT-TS":  "トリエステ",
+		"IT-TV":  "トレヴィーゾ",
+		"IT-UD":  "ウーディネ",
+		"IT-VE":  "ヴェネツィア",
+		"IT-VI":  "ヴィチェンツァ",
+		"IT-VR":  "ヴェローナ",
+		"IT-VT":  "ヴィテルボ",
+		"JM-01":  "キングストン",
+		"JM-04":  "ポートランド",
+		"JM-05":  "セントメアリー",
+		"JM-06":  "セントアン",
+		"JM-07":  "トリローニー",
+		"JM-09":  "ハノーバー",
+		"JM-10":  "ウェストモアランド",
+		"JM-11":  "セントエリザベス",
+		"JM-12":  "マンチェスター",
+		"JM-13":  "クラレンドン",
+		"JM-14":  "セントキャサリン",
+		"JO-AJ":  "アジュルン",
+		"JO-AQ":  "アカバ",
+		"JO-AT":  "タフィーラ",
+		"JO-IR":  "イルビド",
+		"JO-JA":  "ジャラシュ",
+		"JO-KA":  "カラク",
+		"JO-MA":  "マフラク",
+		"JO-MD":  "マーダバー",
+		"JO-MN":  "マアーン",
+		"JP-01":  "北海道",
+		"JP-02":  "青森",
+		"JP-03":  "岩手",
+		"JP-04":  "宮城",
+		"JP-05":  "秋田",
+		"JP-06":  "山形",
+		"JP-07":  "福島",
+		"JP-08":  "茨城",
+		"JP-09":  "栃木",
+		"JP-10":  "群馬",
+		"JP-11":  "埼玉",
+		"JP-12":  "千葉",
+		"JP-13":  "東京",
+		"JP-14":  "神奈川",
+		"JP-15":  "新潟",
+		"JP-16":  "富山",
+		"JP-17":  "石川",
+		"JP-18":  "福井",
+		"JP-19":  "山梨",
+		"JP-20":  "長野",
+		"JP-21":  "岐阜",
+		"JP-22":  "静岡",
+		"JP-23":  "愛知",
+		"JP-24":  "三重",
+		"JP-25":  "滋賀",
+		"JP-26":  "京都",
+		"JP-27":  "大阪",
+		"JP-28":  "兵庫",
+		"JP-29":  "奈良",
+		"JP-30":  "和歌山",
+		"JP-31":  "鳥取",
+		"JP-32":  "島根",
+		"JP-33":  "岡山",
+		"JP-34":  "広島",
+		"JP-35":  "山口",
+		"JP-36":  "徳島",
+		"JP-37":  "香川",
+		"JP-38":  "愛媛",
+		"JP-39":  "高知",
+		"JP-40":  "福岡",
+		"JP-41":  "佐賀",
+		"JP-42":  "長崎",
+		"JP-43":  "熊本",
+		"JP-44":  "大分",
+		"JP-45":  "宮崎",
+		"JP-46":  "鹿児島",
+		"JP-47":  "沖縄",
+		"KE-04":  "ブシア",
+		"KG-B":   "バトケン",
+		"KG-N":   "ナルイン",
+		"KG-O":   "オシ",
+		"KG-T":   "タラス",
+		"KH-11":  "モンドルキリ",
+		"KH-12":  "プノンペン",
+		"KH-13":  "プレアビヒア",
+		"KH-14":  "プレイベーン",
+		"KH-15":  "プルサット",
+		"KH-16":  "ラタナキリ",
+		"KH-17":  "シエムリアプ",
+		"KH-20":  "スバイリエン",
+		"KH-21":  "タケオ",
+		"KH-22":  "オッドーミアンチェイ",
+		"KH-4":   "コンポンチュナン",
+		"KH-7":   "カンポット",
+		"KI-G":   "ギルバート諸島",
+		"KI-L":   "ライン諸島",
+		"KI-P":   "フェニックス諸島",
+		"KN-K":   "セントキッツ",
+		"KN-N":   "ネーヴィス",
+		"KP-04":  "チャガンド(慈江道)",
+		"KP-05":  "ファンヘナムド(黄海南道)",
+		"KP-06":  "ファンヘブクド(黄海北道)",
+		"KW-FA":  "ファルワーニーヤ",
+		"KZ-ALA": "アルマティ",
+		"KZ-MAN": "マンギスタウ州",
+		"KZ-PAV": "パブロダル州",
+		"LA-AT":  "アタプー",
+		"LA-BK":  "ボケオ",
+		"LA-CH":  "チャンパサック",
+		"LA-HO":  "フワパン",
+		"LA-KH":  "カムアン",
+		"LA-LM":  "ルアンナムタ",
+		"LA-LP":  "ルアンパバーン",
+		"LA-OU":  "ウドムサイ",
+		"LA-PH":  "ポーンサリー",
+		"LA-SL":  "サラワン",
+		"LA-SV":  "サバナケット",
+		"LA-XA":  "サイヤブリ",
+		"LA-XE":  "セコン",
+		"LB-BH":  "バールベック",
+		"LC-03":  "チョイセル",
+		"LI-01":  "バルツェルス",
+		"LI-04":  "マウレン",
+		"LI-07":  "シャーン",
+		"LI-11":  "ファドゥーツ",
+		"LK-91":  "ラトナプラ",
+		"LR-BG":  "ボング",
+		"LR-BM":  "ボミ",
+		"LR-CM":  "グランドケープマウント",
+		"LR-GB":  "グランドバッサ",
+		"LR-GG":  "グランドゲデー",
+		"LR-GK":  "グランドクルー",
+		"LR-LO":  "ロファ",
+		"LR-MG":  "マルギビ",
+		"LR-MO":  "モントセラド",
+		"LR-MY":  "メリーランド",
+		"LR-NI":  "ニンバ",
+		"LR-SI":  "シノー",
+		"LS-A":   "マセル",
+		"LS-E":   "マフェテング",
+		"LS-F":   "モハレスフーク",
+		"LS-H":   "カチャズネック",
+		"LS-J":   "モコトロング",
+		"LU-DI":  "ディーキルヒ",
+		"LU-GR":  "グレーベンマッハー",
+		"LU-LU":  "ルクセンブルク",
+		"LV-025": "ダウガフピルス",
+		"LV-DGV": "ダウガフピルス",
+		"LV-JEL": "エルガヴァ",
+		"LV-JKB": "エカプピルス",
+		"LV-LPX": "リエパヤ",
+		"LV-REZ": "レゼクネ",
+		"LV-RIX": "リガ",
+		"LY-BA":  "ベンガジ",
+		"LY-DR":  "ダルナ",
+		"LY-GT":  "ガート",
+		"LY-KF":  "クフラ",
+		"LY-MI":  "ミスラータ",
+		"LY-MQ":  "ムルズーク",
+		"LY-NL":  "ナールート",
+		"LY-SB":  "セブハー",
+		"LY-SR":  "スルト",
+		"LY-ZA":  "ザーウィア",
+		"MA-AZI": "アジラル",
+		"MA-FIG": "フィギグ",
+		"MA-GUE": "ゲルミン",
+		"MA-IFR": "イフラーヌ",
+		"MA-JDI": "ジャディーダ",
+		"MA-KEN": "ケニトラ",
+		"MA-KHO": "クーリブガ",
+		"MA-LAR": "ララシェ",
+		"MA-NAD": "ナドル",
+		"MA-RAB": "ラバト",
+		"MA-SAF": "サフィー",
+		"MA-SET": "セッタート",
+		"MA-TAO": "タウナト",
+		"MA-TAT": "タタ",
+		"MA-TAZ": "タザ",
+		"MA-TET": "テトワン",
+		"MA-TIZ": "ティズニト",
+		"MC-CO":  "ラコンダミーヌ",
+		"MC-FO":  "フォンビエイユ",
+		"MC-MC":  "モンテカルロ",
+		"MD-BA":  "バルツィ",
+		"MD-CU":  "キシナウ",
+		"ME-12":  "ニクシッチ",
+		"ME-16":  "ポドゴリツァ",
+		"MG-A":   "トアマシナ",
+		"MG-D":   "アンツィラナナ",
+		"MG-F":   "フィアナランツォア",
+		"MG-M":   "マハジャンガ",
+		"MG-T":   "アンタナナリボ",
+		"MG-U":   "トリアラ",
+		"MH-KWA": "クワジャリン",
+		"MH-L":   "ラリク列島",
+		"MH-MAJ": "マジュロ",
+		"MH-MAL": "マロエラプ",
+		"MH-T":   "ラタク列島",
+		"MK-303": "デバル",
+		"MK-310": "オフリド",
+		"MK-312": "ストルガ",
+		"MK-501": "ビトラ",
+		"MK-508": "プリレップ",
+		"MK-604": "ゴスティバル",
+		"MK-609": "テトボ",
+		"MK-703": "クマノボ",
+		"MK-802": "アラチノボ",
+		"ML-1":   "カエス",
+		"ML-2":   "クリコロ",
+		"ML-3":   "シカソ",
+		"ML-4":   "セグー",
+		"ML-5":   "モプティ",
+		"ML-6":   "トンブクトゥ",
+		"ML-7":   "ガオ",
+		"ML-8":   "キダル",
+		"ML-BKO": "バマコ",
+		"MM-01":  "サガイン",
+		"MM-02":  "バゴー",
+		"MM-03":  "マグウェ",
+		"MM-04":  "マンダレー",
+		"MM-05":  "タニンダリー",
+		"MM-06":  "ヤンゴン",
+		"MM-07":  "エーヤワディー",
+		"MM-11":  "カチン",
+		"MM-12":  "カヤー",
+		"MM-14":  "チン",
+		"MM-15":  "モン",
+		"MM-16":  "ラカイン",
+		"MM-17":  "シャン",
+		"MN-067": "ブルガン",
+		"MN-069": "バヤンホンゴル",
+		"MR-01":  "ホッドエッシャルギ",
+		"MR-03":  "アッサバ",
+		"MR-04":  "ゴルゴル",
+		"MR-05":  "ブラクナ",
+		"MR-06":  "トラルザ",
+		"MR-07":  "アドラル",
+		"MR-09":  "タガント",
+		"MR-10":  "ギディマカ",
+		"MR-11":  "ティリスゼムール",
+		"MR-12":  "インシリ",
+		"MT-04":  "ビルキルカーラ",
+		"MT-28":  "マルサシュロック",
+		"MT-47":  "サフィー",
+		"MT-49":  "セントジョン",
+		"MT-60":  "バレッタ",
+		"MU-PL":  "ポートルイス",
+		"MV-MLE": "マレ",
+		"MW-C":   "中部",
+		"MW-LI":  "リロングウェ",
+		"MW-N":   "北部",
+		"MW-S":   "南部",
+		"MX-AGU": "アグアスカリエンテス",
+		"MX-BCN": "バハカリフォルニア",
+		"MX-BCS": "バハカリフォルニアスル",
+		"MX-CAM": "カンペチェ",
+		"MX-CHH": "チワワ",
+		"MX-CHP": "チアパス",
+		"MX-COL": "コリマ",
+		"MX-DUR": "ドゥランゴ",
+		"MX-GRO": "ゲレロ",
+		"MX-GUA": "グアナフアト",
+		"MX-HID": "イダルゴ",
+		"MX-JAL": "ハリスコ",
+		"MX-MEX": "メキシコ",
+		"MX-MOR": "モレロス",
+		"MX-NAY": "ナヤリット",
+		"MX-NLE": "ヌエボレオン",
+		"MX-OAX": "オアハカ",
+		"MX-PUE": "プエブラ",
+		"MX-QUE": "ケレタロ",
+		"MX-ROO": "キンタナロー",
+		"MX-SIN": "シナロア",
+		"MX-SLP": "サンルイスポトシ",
+		"MX-SON": "ソノラ",
+		"MX-TAB": "タバスコ",
+		"MX-TAM": "タマウリパス",
+		"MX-TLA": "トラスカラ",
+		"MX-YUC": "ユカタン",
+		"MX-ZAC": "サカテカス",
+		"MY-01":  "ジョホール",
+		"MY-03":  "クランタン",
+		"MY-05":  "ヌグリスンビラン",
+		"MY-06":  "パハン",
+		"MY-09":  "ペルリス",
+		"MY-10":  "スランゴール",
+		"MY-11":  "トレンガヌ",
+		"MY-12":  "サバ",
+		"MY-13":  "サラワク",
+		"MZ-A":   "ニアサ",
+		"MZ-B":   "マニカ",
+		"MZ-G":   "ガザ",
+		"MZ-I":   "イニャンバネ",
+		"MZ-L":   "マプト",
+		"MZ-MPM": "マプト",
+		"MZ-P":   "カーボデルガード",
+		"MZ-S":   "ソファラ",
+		"MZ-T":   "テテ",
+		"NA-ER":  "エロンゴ",
+		"NA-HA":  "ハルダプ",
+		"NA-KH":  "ホマス",
+		"NA-KU":  "ケネネ",
+		"NA-OD":  "オチョゾンデュパ",
+		"NA-OH":  "オマヘケ",
+		"NA-ON":  "オシャナ",
+		"NA-OS":  "オムサティ",
+		"NA-OT":  "オシコト",
+		"NA-OW":  "オハンウェナ",
+		"NE-1":   "アガデズ",
+		"NE-2":   "ディファ",
+		"NE-3":   "ドーソ",
+		"NE-4":   "マラディ",
+		"NE-5":   "タウア",
+		"NE-6":   "ティラベリ",
+		"NE-7":   "ジンデル",
+		"NE-8":   "ニアメ",
+		"NG-AB":  "アビア",
+		"NG-AD":  "アダマワ",
+		"NG-AK":  "アクワイボム",
+		"NG-AN":  "アナンブラ",
+		"NG-BA":  "バウチ",
+		"NG-BE":  "ベルエ",
+		"NG-BO":  "ボルノ",
+		"NG-BY":  "バイエルサ",
+		"NG-CR":  "クロスリバー",
+		"NG-DE":  "デルタ",
+		"NG-EB":  "エボニー",
+		"NG-ED":  "エド",
+		"NG-EK":  "エキティ",
+		"NG-EN":  "エヌーグー",
+		"NG-GO":  "ゴンベ",
+		"NG-IM":  "イモ",
+		"NG-JI":  "ジガワ",
+		"NG-KD":  "カドゥナ",
+		"NG-KE":  "ケビ",
+		"NG-KN":  "カノ",
+		"NG-KO":  "コギ",
+		"NG-KT":  "カッシナ",
+		"NG-KW":  "クワラ",
+		"NG-LA":  "ラゴス",
+		"NG-NI":  "ナイジャー",
+		"NG-OG":  "オグン",
+		"NG-ON":  "オンド",
+		"NG-OS":  "オスン",
+		"NG-OY":  "オヨ",
+		"NG-PL":  "プラトー",
+		"NG-RI":  "リバーズ",
+		"NG-SO":  "ソコト",
+		"NG-TA":  "タラバ",
+		"NG-YO":  "ヨベ",
+		"NG-ZA":  "ザンファラ",
+		"NI-BO":  "ボアコ",
+		"NI-CA":  "カラソ",
+		"NI-CI":  "チナンデガ",
+		"NI-CO":  "チョンタレス",
+		"NI-ES":  "エステリ",
+		"NI-GR":  "グラナダ",
+		"NI-JI":  "ヒノテガ",
+		"NI-LE":  "レオン",
+		"NI-MD":  "マドリス",
+		"NI-MN":  "マナグア",
+		"NI-MS":  "マサヤ",
+		"NI-MT":  "マタガルパ",
+		"NI-NS":  "ヌエバセゴビア",
+		"NI-RI":  "リバス",
+		"NI-SJ":  "リオサンファン",
+		"NL-AW":  "アルーバ",
+		"NL-CW":  "キュラソー",
+		"NL-DR":  "ドレンテ",
+		"NL-FL":  "フレーフォラント",
+		"NL-GE":  "ヘルデルラント",
+		"NL-GR":  "フローニンゲン",
+		"NL-LI":  "リンブルフ",
+		"NL-NB":  "ノールトブラーバント",
+		"NL-NH":  "ノールトホラント",
+		"NL-OV":  "オーフェルアイセル",
+		"NL-UT":  "ユトレヒト",
+		"NL-ZE":  "ゼーラント",
+		"NL-ZH":  "ザイトホラント",
+		"NO-03":  "オスロ",
+		"NO-11":  "ローガラン",
+		"NO-15":  "メーレオロムスダール",
+		"NO-18":  "ノールラン",
+		"NP-1":   "セントラル",
+		"NP-LU":  "ルンビニ",
+		"NR-02":  "アナバル",
+		"NR-04":  "アニバレ",
+		"NR-14":  "ヤレン",
+		"NZ-AUK": "オークランド",
+		"NZ-BOP": "ベイオブプレンティ",
+		"NZ-CAN": "カンタベリー",
+		"NZ-HKB": "ホークスベイ",
+		"NZ-MWT": "マナワツウォンガヌイ",
+		"NZ-NTL": "ノースランド",
+		"NZ-OTA": "オターゴ",
+		"NZ-STL": "サウスランド",
+		"NZ-WGN": "ウェリントン",
+		"NZ-WKO": "ワイカト",
+		"NZ-WTC": "ウエストコースト",
+		"OM-MA":  "マスカット",
+		"OM-MU":  "ムサンダム",
+		"OM-ZA":  "ザーヒラ",
+		"OM-ZU":  "ズファール",
+		"PA-1":   "ボカスデルトロ",
+		"PA-2":   "コクレ",
+		"PA-3":   "コロン",
+		"PA-4":   "チリキ",
+		"PA-5":   "ダリエン",
+		"PA-6":   "エレーラ",
+		"PA-7":   "ロスサントス",
+		"PA-8":   "パナマ",
+		"PA-9":   "ベアグアス",
+		"PA-EM":  "エンベラ",
+		"PA-NB":  "ノーベブグレ",
+		"PE-ANC": "アンカシュ",
+		"PE-ARE": "アレキパ",
+		"PE-AYA": "アヤクチョ",
+		"PE-CAJ": "カハマルカ",
+		"PE-CAL": "カリャオ憲法特別市",
+		"PE-HUC": "ワヌコ",
+		"PE-HUV": "ワンカベリカ",
+		"PE-ICA": "イカ",
+		"PE-LAL": "ラリベルタッド",
+		"PE-LAM": "ランバイエケ",
+		"PE-LIM": "リマ",
+		"PE-LOR": "ロレト",
+		"PE-MDD": "マドレデディオス",
+		"PE-MOQ": "モケグア",
+		"PE-PAS": "パスコ",
+		"PE-PIU": "ピウラ",
+		"PE-PUN": "プノ",
+		"PE-TAC": "タクナ",
+		"PE-TUM": "トゥンペス",
+		"PE-UCA": "ウカヤリ",
+		"PG-CPK": "チンブ",
+		"PG-CPM": "セントラル",
+		"PG-EBR": "東ニューブリテン",
+		"PG-EHG": "東ハイランド",
+		"PG-EPW": "エンガ",
+		"PG-ESW": "東セピック",
+		"PG-GPK": "ガルフ",
+		"PG-MBA": "ミルンベイ",
+		"PG-MPL": "モロベ",
+		"PG-MPM": "マダン",
+		"PG-MRL": "マヌス島",
+		"PG-NCD": "首都特別区 (ポートモレスビー)",
+		"PG-NIK": "ニューアイルランド",
+		"PG-NSB": "ブーゲンビル",
+		"PG-SHM": "南ハイランド",
+		"PG-WBK": "西ニューブリテン",
+		"PG-WHM": "西ハイランド",
+		"PH-11":  "ダバオ",
+		"PH-AGN": "北アグサン",
+		"PH-AGS": "南アグサン",
+		"PH-BOH": "ボホール",
+		"PH-BTG": "バタンガス",
+		"PH-BUK": "ブキドノン",
+		"PH-CAM": "カミギン",
+		"PH-CEB": "セブ",
+		"PH-DAS": "南ダバオ",
+		"PH-DIN": "ディナガットアイランズ",
+		"PH-ILI": "イロイロ",
+		"PH-ISA": "イサベラ",
+		"PH-LAN": "北ラナオ",
+		"PH-LAS": "南ラナオ",
+		"PH-LEY": "レイテ",
+		"PH-MAG": "マギンダナオ",
+		"PH-MAS": "マスバテ",
+		"PH-MSC": "西ミサミス",
+		"PH-MSR": "東ミサミス",
+		"PH-NER": "東ネグロス",
+		"PH-QUE": "ケソン",
+		"PH-SAR": "サランガニ",
+		"PH-SCO": "南コタバト",
+		"PH-SLE": "南レイテ",
+		"PH-SLU": "スールー",
+		"PH-SUK": "スルタンクダラット",
+		"PH-SUN": "北スリガオ",
+		"PH-SUR": "南スリガオ",
+		"PH-ZAN": "北サンボアンガ",
+		"PH-ZAS": "南サンボアンガ",
+		"PH-ZSI": "サンボアンガシブガイ",
+		"PK-BA":  "バルチスターン",
+		"PK-GB":  "ギルギットバルチスタン",
+		"PK-IS":  "イスラマバード",
+		"PK-KP":  "ハイバルパフトゥンハー",
+		"PK-PB":  "パンジャーブ",
+		"PK-SD":  "シンド",
+		"PL-02":  "ドルノシロンスク",
+		"PL-04":  "クヤウスコポモジェ",
+		"PL-08":  "ルブスコ",
+		"PL-12":  "マウォポルスカ",
+		"PL-18":  "ポドカルパチ",
+		"PL-20":  "ポドラスカ",
+		"PL-28":  "ワルミンスコマズーリ",
+		"PL-32":  "ザホドニオポモジェ",
+		"PS-BTH": "ベツレヘム",
+		"PS-GZA": "ガザ",
+		"PS-HBN": "ヘブロン",
+		"PS-JEM": "エルサレム",
+		"PS-QQA": "カルキリヤ",
+		"PT-01":  "アヴェイロ",
+		"PT-02":  "ベジャ",
+		"PT-03":  "ブラガ",
+		"PT-04":  "ブラガンサ",
+		"PT-05":  "カステロブランコ",
+		"PT-06":  "コインブラ",
+		"PT-07":  "エヴォラ",
+		"PT-08":  "ファロ",
+		"PT-09":  "グアルダ",
+		"PT-10":  "レイリア",
+		"PT-11":  "リスボン",
+		"PT-12":  "ポルタレグレ",
+		"PT-13":  "ポルト",
+		"PT-14":  "サンタレン",
+		"PT-15":  "セトゥーヴァル",
+		"PT-16":  "ビアナドカステロ",
+		"PT-17":  "ヴィラレアル",
+		"PT-18":  "ビゼウ",
+		"PW-010": "アンガウル",
+		"PW-150": "コロール",
+		"PW-350": "ペリリュー",
+		"PY-1":   "コンセプシオン",
+		"PY-10":  "アルトパラナ",
+		"PY-11":  "セントラル",
+		"PY-12":  "ニェエンプケ",
+		"PY-13":  "アマンバイ",
+		"PY-14":  "カネンディユ",
+		"PY-15":  "プレジデンテアイエス",
+		"PY-16":  "アルトパラグアイ",
+		"PY-19":  "ボケロン",
+		"PY-2":   "サンペドロ",
+		"PY-3":   "コルディエラ",
+		"PY-4":   "グアイラ",
+		"PY-5":   "カーグア",
+		"PY-6":   "カアサパ",
+		"PY-7":   "イタプア",
+		"PY-8":   "ミシオネス",
+		"PY-9":   "パラグアリ",
+		"PY-ASU": "アスンシオン",
+		"QA-WA":  "ワクラ",
+		"RO-AB":  "アルバ",
+		"RO-AG":  "アルジェシュ",
+		"RO-AR":  "アラド",
+		"RO-B":   "ブカレスト",
+		"RO-BC":  "バカウ",
+		"RO-BH":  "ビホル",
+		"RO-BN":  "ビストリツァナサウド",
+		"RO-BR":  "ブライラ",
+		"RO-BT":  "ボトシャニ",
+		"RO-BV":  "ブラショブ",
+		"RO-BZ":  "ブザウ",
+		"RO-CJ":  "クルジュ",
+		"RO-CS":  "カラシュセベリン",
+		"RO-CT":  "コンスタンツァ",
+		"RO-CV":  "コバスナ",
+		"RO-DB":  "ドゥンボビツァ",
+		"RO-DJ":  "ドルジュ",
+		"RO-GJ":  "ゴルジュ",
+		"RO-GL":  "ガラツィ",
+		"RO-GR":  "ジュルジュ",
+		"RO-HD":  "フネドアラ",
+		"RO-HR":  "ハルギータ",
+		"RO-IL":  "ヤロミツァ",
+		"RO-IS":  "ヤシ",
+		"RO-MH":  "メヘディンツ",
+		"RO-MM":  "マラムレシュ",
+		"RO-MS":  "ムレシュ",
+		"RO-NT":  "ネアムツ",
+		"RO-OT":  "オルト",
+		"RO-PH":  "プラホバ",
+		"RO-SB":  "シビウ",
+		"RO-SJ":  "サラジュ",
+		"RO-SM":  "サトゥマーレ",
+		"RO-SV":  "スチャバ",
+		"RO-TL":  "トゥルチャ",
+		"RO-TM":  "ティミシュ",
+		"RO-TR":  "テレオルマン",
+		"RO-VL":  "バルチャ",
+		"RO-VN":  "ブランチャ",
+		"RO-VS":  "バスルイ",
+		"RS-KM":  "コソボ-Metohija",
+		"RS-VO":  "ヴォイヴォディナ",
+		"RU-CE":  "チェチェン共和国",
+		"RU-DA":  "ダゲスタン共和国",
+		"RU-KAM": "カムチャツカ",
+		"RU-KC":  "カラチャイチェルケス共和国",
+		"RU-KO":  "コミ共和国",
+		"SA-05":  "カシーム",
+		"SA-07":  "タブーク",
+		"SA-10":  "ナジュラーン",
+		"SA-12":  "ジャウフ",
+		"SB-CE":  "セントラル",
+		"SB-CH":  "チョイセル",
+		"SB-GU":  "ガダルカナル",
+		"SB-ML":  "マライタ",
+		"SK-BC":  "バンスカービストリツァ",
+		"SK-BL":  "ブラチスラバ",
+		"SK-KI":  "コシツェ",
+		"SK-NI":  "ニトラ",
+		"SK-PV":  "プレショフ",
+		"SK-TA":  "トルナバ",
+		"SK-TC":  "トレンチーン",
+		"SK-ZI":  "ジリナ",
+		"SM-09":  "セッラバッレ",
+		"SN-DB":  "ジュルベル",
+		"SN-DK":  "ダカール",
+		"SN-FK":  "ファティック",
+		"SN-KA":  "カフリン",
+		"SN-KD":  "コルダ",
+		"SN-KE":  "ケドゥグ",
+		"SN-KL":  "カオラック",
+		"SN-LG":  "ルガ",
+		"SN-MT":  "マタム",
+		"SN-SE":  "セジュ",
+		"SN-SL":  "サン・ルイ",
+		"SN-TC":  "タンバクンダ",
+		"SN-TH":  "チェス",
+		"SN-ZG":  "ジガンショール",
+		"SO-BK":  "バコール",
+		"SO-BR":  "バリ",
+		"SO-BY":  "バイ",
+		"SO-GA":  "ガルグドゥード",
+		"SO-GE":  "ゲド",
+		"SO-JD":  "ジュバダデヘ",
+		"SO-JH":  "ジュバダホーセ",
+		"SO-MU":  "ムドゥグ",
+		"SO-NU":  "ヌガール",
+		"SO-SD":  "シャベーラハデヘ",
+		"SO-SH":  "シャベーラハホーセ",
+		"SO-TO":  "トクデール",
+		"SO-WO":  "ウォコーイガルベード",
+		"SR-BR":  "ブロコポンド",
+		"SR-CM":  "コメウィーネ",
+		"SR-CR":  "コロニー",
+		"SR-MA":  "マロウィーネ",
+		"SR-NI":  "ニッケリエ",
+		"SR-PM":  "パラマリボ",
+		"SR-PR":  "パラ",
+		"SR-SA":  "サラマクカ",
+		"SR-SI":  "シパリウィニ",
+		"SR-WA":  "ワニカ",
+		"ST-P":   "プリンシペ",
+		"SV-AH":  "アウアチャパン",
+		"SV-CA":  "カバニャス",
+		"SV-CH":  "チャラテナンゴ",
+		"SV-CU":  "クスカトラン",
+		"SV-LI":  "ラリベルタッド",
+		"SV-MO":  "モラサン",
+		"SV-PA":  "ラパス",
+		"SV-SA":  "サンタ・アナ",
+		"SV-SM":  "サン・ミゲル",
+		"SV-SO":  "ソンソナテ",
+		"SV-SS":  "サンサルバドル",
+		"SV-SV":  "サン・ビセンテ",
+		"SV-UN":  "ラウニオン",
+		"SV-US":  "ウスルタン",
+		"SY-DI":  "ダマスカス",
+		"SY-DY":  "デリゾール",
+		"SY-ID":  "イドゥリブ",
+		"SY-RA":  "ラッカ",
+		"SZ-MA":  "マンジーニ",
+		"TG-P":   "プラトー",
+		"TH-11":  "サムットプラカーン",
+		"TH-12":  "ノンタブリー",
+		"TH-13":  "パトンターニー",
+		"TH-14":  "プラナコンシータマラート,",
+		"TH-15":  "アントーン",
+		"TH-16":  "ロッブリー",
+		"TH-17":  "シンブリー",
+		"TH-18":  "チャイナート",
+		"TH-19":  "サラブリー",
+		"TH-20":  "チョンブリー",
+		"TH-21":  "ラヨーン",
+		"TH-22":  "チャンタブリー",
+		"TH-23":  "トラート",
+		"TH-24":  "チャチェンサオ",
+		"TH-25":  "プラチンブリー",
+		"TH-26":  "ナコンナヨーク",
+		"TH-27":  "サケオ",
+		"TH-30":  "ナコンラチャシマ",
+		"TH-31":  "ブリラム",
+		"TH-32":  "スリン",
+		"TH-33":  "シーサケット",
+		"TH-34":  "ウボンラチャタニー",
+		"TH-35":  "ヤソートーン",
+		"TH-36":  "チャイヤプーム",
+		"TH-37":  "アムナートチャルン",
+		"TH-39":  "ノーンブアランプー",
+		"TH-40":  "コーンケーン",
+		"TH-41":  "ウドンタニー",
+		"TH-42":  "ルーイ",
+		"TH-43":  "ノーンカーイ",
+		"TH-44":  "マハーサーラーカム",
+		"TH-45":  "ロイエット",
+		"TH-46":  "カラシン",
+		"TH-47":  "サコンナコン",
+		"TH-48":  "ナコンパノム",
+		"TH-49":  "ムクダハーン",
+		"TH-50":  "チェンマイ",
+		"TH-51":  "ランパーン",
+		"TH-52":  "ランパーン",
+		"TH-53":  "ウタラディット",
+		"TH-54":  "プレー",
+		"TH-55":  "ナン",
+		"TH-56":  "パヤオ",
+		"TH-57":  "チェンライ",
+		"TH-58":  "メーホーンソン",
+		"TH-60":  "ナコンサワン",
+		"TH-61":  "ウタイタニー",
+		"TH-62":  "カンペーンペット",
+		"TH-63":  "ターク",
+		"TH-64":  "スコータイ",
+		"TH-65":  "ピサヌローク",
+		"TH-66":  "ピチット",
+		"TH-67":  "ペチャブーン",
+		"TH-70":  "ラッブリー",
+		"TH-71":  "カンチャナブリ",
+		"TH-72":  "スパンブリー",
+		"TH-73":  "ナコンパトム",
+		"TH-74":  "サムットサコーン",
+		"TH-75":  "サムットソンクラム",
+		"TH-76":  "ペッブリー",
+		"TH-77":  "プラチュアップキリカン",
+		"TH-80":  "ナコンシータマラート",
+		"TH-81":  "クラビー",
+		"TH-82":  "パンガー",
+		"TH-83":  "プーケット",
+		"TH-84":  "スラーターニー",
+		"TH-85":  "ラノーン",
+		"TH-86":  "チュムポーン",
+		"TH-90":  "ソンクラー",
+		"TH-91":  "サトゥーン",
+		"TH-92":  "トラン",
+		"TH-93":  "パッタルン",
+		"TH-94":  "パッタニー",
+		"TH-95":  "ヤラー",
+		"TH-96":  "ナラティワート",
+		"TJ-KT":  "ハトロン",
+		"TJ-SU":  "スド",
+		"TL-AL":  "アイレウ",
+		"TL-AN":  "アイナロ",
+		"TL-BA":  "バウカウ",
+		"TL-BO":  "ボボナロ",
+		"TL-CO":  "コバリマ",
+		"TL-DI":  "ディリ",
+		"TL-ER":  "エルメラ",
+		"TL-MF":  "マヌファイ",
+		"TL-MT":  "マナトゥトゥ",
+		"TM-A":   "アハル",
+		"TM-B":   "バルカン",
+		"TM-D":   "ダシュハウズ",
+		"TM-L":   "レバプ",
+		"TM-M":   "マリー",
+		"TM-S":   "アシガバット",
+		"TN-11":  "チュニス",
+		"TN-13":  "ベンアルース",
+		"TN-14":  "マヌーバ",
+		"TN-21":  "ナブール",
+		"TN-22":  "ザグアン",
+		"TN-23":  "ビゼルト",
+		"TN-31":  "ベジャ",
+		"TN-32":  "ジャンドゥーバ",
+		"TN-33":  "ケフ",
+		"TN-34":  "シリアナ",
+		"TN-41":  "カイロアン",
+		"TN-42":  "カスリン",
+		"TN-43":  "シディブジット",
+		"TN-51":  "スース",
+		"TN-52":  "モナスティール",
+		"TN-53":  "マハディア",
+		"TN-61":  "スファックス",
+		"TN-71":  "ガフサ",
+		"TN-72":  "トズール",
+		"TN-81":  "ガベス",
+		"TN-83":  "タタウィン",
+		"TO-01":  "エウア",
+		"TO-02":  "ハアパイ",
+		"TO-04":  "トンガタプ",
+		"TO-05":  "ババウ",
+		"TR-01":  "アダナ",
+		"TR-02":  "アドゥヤマン",
+		"TR-03":  "アフヨン",
+		"TR-04":  "アール",
+		"TR-05":  "アマスヤ",
+		"TR-06":  "アンカラ",
+		"TR-07":  "アンタルヤ",
+		"TR-08":  "アルトヴィン",
+		"TR-09":  "アイドゥン",
+		"TR-10":  "バルケシル",
+		"TR-11":  "ビレジック",
+		"TR-12":  "ビンギョル",
+		"TR-13":  "ビトリス",
+		"TR-14":  "ボル",
+		"TR-15":  "ブルドゥル",
+		"TR-16":  "ブルサ",
+		"TR-17":  "チャナッカレ",
+		"TR-18":  "チャンクル",
+		"TR-19":  "チョルム",
+		"TR-20":  "デニズリ",
+		"TR-21":  "ディヤルバクル",
+		"TR-22":  "エディルネ",
+		"TR-23":  "エラズ",
+		"TR-24":  "エルジンジャン",
+		"TR-25":  "エルズルム",
+		"TR-26":  "エスキシェヒル",
+		"TR-27":  "ガジアンテップ",
+		"TR-28":  "ギレスン",
+		"TR-29":  "ギュミュシュネ",
+		"TR-30":  "ハッカーリ",
+		"TR-31":  "ハタイ",
+		"TR-32":  "イスパルタ",
+		"TR-34":  "イスタンブール",
+		"TR-35":  "イズミール",
+		"TR-36":  "カルス",
+		"TR-37":  "カスタモヌ",
+		"TR-38":  "カイセリ",
+		"TR-39":  "クルクラレリ",
+		"TR-40":  "クルシェヒル",
+		"TR-41":  "コジャエリ",
+		"TR-42":  "コンヤ",
+		"TR-43":  "キュタヒヤ",
+		"TR-44":  "マラティア",
+		"TR-45":  "マニサ",
+		"TR-46":  "カラマンマラシュ",
+		"TR-47":  "マルディン",
+		"TR-48":  "ムーラ",
+		"TR-49":  "ムシュ",
+		"TR-50":  "ネウシェヒル",
+		"TR-51":  "ニーデ",
+		"TR-52":  "オルデュ",
+		"TR-53":  "リゼ",
+		"TR-54":  "サカルヤ",
+		"TR-55":  "サムスン",
+		"TR-56":  "シイルト",
+		"TR-57":  "シノップ",
+		"TR-58":  "シワス",
+		"TR-59":  "テキルダー",
+		"TR-60":  "トカト",
+		"TR-61":  "トラブゾン",
+		"TR-62":  "トゥンジェリ",
+		"TR-63":  "シャンルウルファ",
+		"TR-64":  "ウシャク",
+		"TR-65":  "ヴァン",
+		"TR-66":  "ヨズガット",
+		"TR-67":  "ゾングルダク",
+		"TR-68":  "アクサライ",
+		"TR-69":  "バイブルト",
+		"TR-70":  "カラマン",
+		"TR-71":  "クルツカレ",
+		"TR-72":  "バトマン",
+		"TR-73":  "シュルナク",
+		"TR-74":  "バルトゥン",
+		"TR-75":  "アルダハン",
+		"TR-76":  "イーディル",
+		"TR-77":  "ヤロバ",
+		"TR-78":  "カラビュク",
+		"TR-79":  "キリス",
+		"TR-80":  "オスマニエ",
+		"TR-81":  "デュズジェ",
+		"TT-ARI": "アリマ",
+		"TT-CHA": "チャグアナス",
+		"TT-POS": "ポートオブスペイン",
+		"TT-SFO": "サンフェルナンド",
+		"TV-FUN": "フナフティ",
+		"TV-NIT": "ニウタオ",
+		"TV-NKF": "ヌクフェタウ",
+		"TV-NKL": "ヌクライライ",
+		"TV-NMA": "ナヌーメア",
+		"TV-NUI": "ヌイ",
+		"TV-VAI": "ヴァイトゥプ",
+		"TW-CHA": "彰化",
+		"TW-CYI": "嘉義",
+		"TW-CYQ": "嘉義",
+		"TW-HSQ": "新竹",
+		"TW-HSZ": "新竹",
+		"TW-HUA": "花蓮",
+		"TW-KHH": "高雄",
+		"TW-MIA": "苗栗",
+		"TW-NAN": "南投",
+		"TW-PEN": "澎湖",
+		"TW-PIF": "屏東",
+		"TW-TAO": "桃園",
+		"TW-TNN": "台南",
+		"TW-TPE": "台北",
+		"TW-TTT": "台東",
+		"TW-TXG": "台中",
+		"TW-YUN": "雲林",
+		"TZ-01":  "アルーシャ",
+		"TZ-03":  "ドドマ",
+		"TZ-04":  "イリンガ",
+		"TZ-05":  "カゲラ",
+		"TZ-08":  "キゴマ",
+		"TZ-09":  "キリマンジャロ",
+		"TZ-12":  "リンディ",
+		"TZ-13":  "マラ",
+		"TZ-14":  "ムベヤ",
+		"TZ-16":  "モロゴロ",
+		"TZ-17":  "ムトワラ",
+		"TZ-20":  "ルークワ",
+		"TZ-21":  "ルブマ",
+		"TZ-22":  "シニャンガ",
+		"TZ-23":  "シンギダ",
+		"TZ-24":  "タボラ",
+		"TZ-25":  "タンガ",
+		"UG-101": "カランガラ",
+		"UG-102": "カンパラ",
+		"UG-103": "キボガ",
+		"UG-104": "ルウェロ",
+		"UG-105": "マサカ",
+		"UG-106": "ムピギ",
+		"UG-107": "ムベンデ",
+		"UG-108": "ムコノ",
+		"UG-109": "ナカソンゴラ",
+		"UG-110": "ラカイ",
+		"UG-111": "センバブレ",
+		"UG-112": "カユンガ",
+		"UG-113": "ワキソ",
+		"UG-115": "ミチャナ",
+		"UG-116": "ナカセケ",
+		"UG-201": "ブギリ",
+		"UG-202": "ブシア",
+		"UG-203": "イガンガ",
+		"UG-204": "ジンジャ",
+		"UG-205": "カムリ",
+		"UG-206": "カプチョールワ",
+		"UG-207": "カタクイ",
+		"UG-208": "クミ",
+		"UG-209": "ムバレ",
+		"UG-210": "パッリサ",
+		"UG-211": "ソロティ",
+		"UG-212": "トロロ",
+		"UG-213": "カベラマイド",
+		"UG-214": "マユゲ",
+		"UG-215": "シロンコ",
+		"UG-216": "アムリア",
+		"UG-217": "ブダカ",
+		"UG-221": "ブタレジャ",
+		"UG-222": "カリロ",
+		"UG-223": "マナフワ",
+		"UG-224": "ナムトゥンバ",
+		"UG-301": "アジュマニ",
+		"UG-302": "アパク",
+		"UG-303": "アルア",
+		"UG-304": "グル",
+		"UG-305": "キトゥグム",
+		"UG-306": "コティド",
+		"UG-307": "リラ",
+		"UG-308": "モロト",
+		"UG-309": "モヨ",
+		"UG-310": "ネビ",
+		"UG-311": "ナカピリピリティ",
+		"UG-312": "パデル",
+		"UG-313": "ユンベ",
+		"UG-314": "アビム",
+		"UG-315": "アモラタル",
+		"UG-316": "アムル",
+		"UG-317": "ドコロ",
+		"UG-318": "カボング",
+		"UG-319": "コボコ",
+		"UG-320": "マナチャ",
+		"UG-321": "オヤム",
+		"UG-401": "ブンディブギョ",
+		"UG-402": "ブシェニ",
+		"UG-403": "ホイマ",
+		"UG-404": "カバレ",
+		"UG-405": "カバロレ",
+		"UG-406": "カセセ",
+		"UG-407": "キバーレ",
+		"UG-408": "キソロ",
+		"UG-409": "マシンディ",
+		"UG-410": "ムバララ",
+		"UG-411": "ヌトゥンガモ",
+		"UG-412": "ルクンギリ",
+		"UG-413": "カムウェンゲ",
+		"UG-414": "カヌング",
+		"UG-415": "キエンジョジョ",
+		"UG-416": "ブリリサ",
+		"UG-417": "イバンダ",
+		"UG-418": "イシンギロ",
+		"UG-419": "キルフラ",
+		"UG-C":   "セントラル",
+		"UM-67":  "ジョンストン環礁",
+		"UM-71":  "ミッドウェイ諸島",
+		"UM-76":  "ナヴァッサ島",
+		"UM-79":  "ウェーク島",
+		"UM-81":  "ベーカー島",
+		"UM-84":  "ハウランド島",
+		"UM-86":  "ジャーヴィス島",
+		"UM-89":  "キングマン岩礁",
+		"UM-95":  "パルミラ環礁",
+		"US-AK":  "アラスカ",
+		"US-AL":  "アラバマ",
+		"US-AR":  "アーカンソー",
+		"US-AS":  "米領サモア",
+		"US-AZ":  "アリゾナ",
+		"US-CA":  "カリフォルニア",
+		"US-CO":  "コロラド",
+		"US-CT":  "コネティカット",
+		"US-DC":  "コロンビア特別区",
+		"US-DE":  "デラウェア",
+		"US-FL":  "フロリダ",
+		"US-GA":  "ジョージア",
+		"US-GU":  "グアム",
+		"US-HI":  "ハワイ",
+		"US-IA":  "アイオワ",
+		"US-ID":  "アイダホ",
+		"US-IL":  "イリノイ",
+		"US-IN":  "インディアナ",
+		"US-KS":  "カンザス",
+		"US-KY":  "ケンタッキー",
+		"US-LA":  "ルイジアナ",
+		"US-MA":  "マサチューセッツ",
+		"US-MD":  "メリーランド",
+		"US-ME":  "メーン",
+		"US-MI":  "ミシガン",
+		"US-MN":  "ミネソタ",
+		"US-MO":  "ミズーリ",
+		"US-MP":  "北マリアナ諸島",
+		"US-MS":  "ミシシッピ",
+		"US-MT":  "モンタナ",
+		"US-NC":  "ノースカロライナ",
+		"US-ND":  "ノースダコタ",
+		"US-NE":  "ネブラスカ",
+		"US-NH":  "ニューハンプシャー",
+		"US-NJ":  "ニュージャージー",
+		"US-NM":  "ニューメキシコ",
+		"US-NV":  "ネヴァダ",
+		"US-NY":  "ニューヨーク",
+		"US-OH":  "オハイオ",
+		"US-OK":  "オクラホマ",
+		"US-OR":  "オレゴン",
+		"US-PA":  "ペンシルヴェニア",
+		"US-PR":  "プエルトリコ",
+		"US-RI":  "ロードアイランド",
+		"US-SC":  "サウスカロライナ",
+		"US-SD":  "サウスダコタ",
+		"US-TN":  "テネシー",
+		"US-TX":  "テキサス",
+		"US-UM":  "アメリカ合衆国外諸島",
+		"US-UT":  "ユタ",
+		"US-VA":  "ヴァージニア",
+		"US-VT":  "ヴァーモント",
+		"US-WA":  "ワシントン",
+		"US-WI":  "ウィスコンシン",
+		"US-WV":  "ウェストヴァージニア",
+		"US-WY":  "ワイオミング",
+		"UY-AR":  "アルテイガス",
+		"UY-CA":  "カネローネス",
+		"UY-CL":  "セロラルゴ",
+		"UY-CO":  "コロニア",
+		"UY-DU":  "ドゥラスノ",
+		"UY-FD":  "フロリダ",
+		"UY-FS":  "フロレス",
+		"UY-LA":  "ラバイェハ",
+		"UY-MA":  "マルドナド",
+		"UY-MO":  "モンテビデオ",
+		"UY-PA":  "パイサンドゥ",
+		"UY-RN":  "リオネグロ",
+		"UY-RO":  "ロチャ",
+		"UY-RV":  "リベラ",
+		"UY-SA":  "サルト",
+		"UY-SJ":  "サンホゼ",
+		"UY-SO":  "ソリアノ",
+		"UY-TA":  "タクアレンボ",
+		"UY-TT":  "トレインタ ィ トレス",
+		"UZ-AN":  "アンジャン",
+		"UZ-NG":  "ナマンガン",
+		"UZ-NW":  "ナボイ",
+		"UZ-QA":  "カシュカダリア",
+		"UZ-SA":  "サマルカンド",
+		"UZ-SI":  "シルダリャ",
+		"UZ-SU":  "スルハンダリア",
+		"UZ-TK":  "タシケント",
+		"UZ-TO":  "タシケント",
+		"UZ-XO":  "ホレズム",
+		"VC-04":  "セントジョージ",
+		"VC-06":  "グレナディーン",
+		"VE-C":   "アプレ",
+		"VE-D":   "アラゲグア",
+		"VE-E":   "バリナス",
+		"VE-F":   "ボリバル",
+		"VE-G":   "カラボボ",
+		"VE-H":   "コジュデス",
+		"VE-I":   "ファルコン",
+		"VE-J":   "グアリコ",
+		"VE-K":   "ララ",
+		"VE-L":   "メリダ",
+		"VE-M":   "ミランダ",
+		"VE-N":   "モナガス",
+		"VE-O":   "ヌエヴァ･エスパルタ",
+		"VE-P":   "ポルトゲサ",
+		"VE-R":   "スクレ",
+		"VE-S":   "タチラ",
+		"VE-T":   "トルヒヨ",
+		"VE-U":   "ヤラクイ",
+		"VE-V":   "スリア",
+		"VE-Y":   "デルタ・アマクロ",
+		"VE-Z":   "アマゾナス",
+		"VN-01":  "ライチャウ",
+		"VN-02":  "ラオカイ",
+		"VN-03":  "ハジアン",
+		"VN-04":  "カオバン",
+		"VN-05":  "ソンラ",
+		"VN-06":  "イェンバイ",
+		"VN-07":  "トゥエンクァン",
+		"VN-09":  "ランソン",
+		"VN-13":  "クァンニン",
+		"VN-18":  "ニンビン",
+		"VN-20":  "タイビン",
+		"VN-21":  "タンホア",
+		"VN-22":  "ゲーアン",
+		"VN-24":  "クァンビン",
+		"VN-25":  "クァンチ",
+		"VN-27":  "クァンナム",
+		"VN-28":  "コントゥム",
+		"VN-29":  "クァンガイ",
+		"VN-30":  "ジアライ",
+		"VN-31":  "ビンディン",
+		"VN-32":  "フーイェン",
+		"VN-34":  "コンホア",
+		"VN-35":  "ラムドン",
+		"VN-36":  "ニントゥアン",
+		"VN-37":  "テイニン",
+		"VN-39":  "ドンナイ",
+		"VN-40":  "ビントゥアン",
+		"VN-41":  "ロンアン",
+		"VN-44":  "アンザン",
+		"VN-45":  "ドンタプ",
+		"VN-46":  "ティエンジアン",
+		"VN-49":  "ビンロン",
+		"VN-50":  "ベンチェ",
+		"VN-51":  "チャビン",
+		"VN-52":  "ソクチャン",
+		"VN-53":  "バクカン",
+		"VN-54":  "バクジアン",
+		"VN-55":  "バクリュウ",
+		"VN-56":  "バクニン",
+		"VN-57":  "ビンドゥオン",
+		"VN-58":  "ビンフォク",
+		"VN-59":  "カマウ",
+		"VN-63":  "ハナム",
+		"VN-66":  "フンイェン",
+		"VN-68":  "フート",
+		"VN-69":  "タイグエン",
+		"VN-DN":  "ダナン",
+		"VN-HN":  "ハノイ",
+		"VN-HP":  "ハイフォン",
+		"WS-PA":  "パラウリ",
+		"YE-DH":  "ダマール",
+		"YE-HD":  "ハドラマウト",
+		"YE-HJ":  "ハッジャ",
+		"YE-HU":  "ホデイダ",
+		"YE-IB":  "イップ",
+		"YE-JA":  "ジャウフ",
+		"YE-LA":  "ラヘジ",
+		"YE-MR":  "アルマハラ",
+		"YE-MW":  "アルマハウィット",
+		"YE-RA":  "ライマ",
+		"YE-SH":  "シャブワ",
+		"ZA-EC":  "東ケープ",
+		"ZA-FS":  "自由州",
+		"ZA-GP":  "ガウテング",
+		"ZA-KZN": "クワズールーナタール",
+		"ZA-LP":  "リンポポ",
+		"ZA-MP":  "マプマランガ",
+		"ZA-NC":  "北ケープ",
+		"ZA-WC":  "西ケープ",
+		"ZM-02":  "セントラル",
+		"ZM-04":  "ルアプラ",
+		"ZM-06":  "北西部州",
+		"ZM-08":  "コッパーベルト",
+		"ZM-09":  "ルサカ",
+		"ZW-BU":  "ブラワヨ",
+		"ZW-HA":  "ハラレ",
+		"ZW-MA":  "マニカランド",
+		"ZW-MC":  "マショナランド・セントラル",
+		"ZW-ME":  "マショナランド・イースト",
+		"ZW-MI":  "ミッドランズ",
+		"ZW-MN":  "北マタベランド",
+		"ZW-MS":  "南マタベランド",
+		"ZW-MV":  "マスビンゴ",
+		"ZW-MW":  "マショナランド・ウエスト",
+	},
+	"pt-BR": {
+		"BE-WLX": "Luxemburgo",
+		"IN-SK":  "Siquim",
+		"LU-LU":  "Luxemburgo",
+		"UM-71":  "Ilhas Midway",
+		"UM-79":  "Ilha Wake",
+	},
+	"ru": {
+		"AD-02":  "Канильо",
+		"AD-03":  "Энкамп",
+		"AD-04":  "Ла-Массана",
+		"AD-05":  "Ордино",
+		"AD-06":  "Сан-Жулиа-де-Лория",
+		"AD-07":  "Андорра-ла-Велья",
+		"AD-08":  "Эскальдес-Энгордань",
+		"AE-AJ":  "Аджман",
+		"AE-AZ":  "Абу-Даби",
+		"AE-DU":  "Дубай",
+		"AE-FU":  "Эль-Фуджайра",
+		"AE-RK":  "Рас эль-Хайма",
+		"AE-SH":  "Шарджа",
+		"AE-UQ":  "Умм эль-Кайвайн",
+		"AF-BAL": "Балх",
+		"AF-BAM": "Бамиан",
+		"AF-BDG": "Бадгис",
+		"AF-BDS": "Бадахшан",
+		"AF-BGL": "Баглан",
+		"AF-DAY": "Дайкунди",
+		"AF-FRA": "Фарах",
+		"AF-FYB": "Фарьяб",
+		"AF-GHA": "Газни",
+		"AF-GHO": "Гор",
+		"AF-HEL": "Гильменд",
+		"AF-HER": "Герат",
+		"AF-JOW": "Джаузджан",
+		"AF-KAB": "Кабул",
+		"AF-KAN": "Кандагар",
+		"AF-KAP": "Каписа",
+		"AF-KDZ": "Кундуз",
+		"AF-KHO": "Хост",
+		"AF-KNR": "Кунар",
+		"AF-LAG": "Лагман",
+		"AF-LOG": "Логар",
+		"AF-NAN": "Нангархар",
+		"AF-NIM": "Нимроз",
+		"AF-NUR": "Нуристан",
+		"AF-PAN": "Панджшер",
+		"AF-PAR": "Парван",
+		"AF-PIA": "Пактия",
+		"AF-PKA": "Пактика",
+		"AF-SAM": "Саманган",
+		"AF-SAR": "Сари-Пуль",
+		"AF-TAK": "Тахар",
+		"AF-URU": "Урузган",
+		"AF-WAR": "Вардак",
+		"AF-ZAB": "Забуль",
+		"AG-03":  "Сент-Джордж",
+		"AG-04":  "Сент-Джон",
+		"AG-05":  "Сент-Мэри",
+		"AG-06":  "Сент-Пол",
+		"AG-07":  "Сент-Питер",
+		"AG-08":  "Сент-Филип",
+		"AG-10":  "Барбуда",
+		"AG-11":  "Редонда",
+		"AL-01":  "Берат",
+		"AL-02":  "Дуррес",
+		"AL-03":  "Эльбасан",
+		"AL-04":  "Фиери",
+		"AL-05":  "Гирокастра",
+		"AL-06":  "Корча",
+		"AL-07":  "Кукес",
+		"AL-08":  "Лежа",
+		"AL-09":  "Дибра",
+		"AL-10":  "Шкодер",
+		"AL-11":  "Тирана",
+		"AL-12":  "Влёра",
+		"AM-AG":  "Арагацотнская область",
+		"AM-AR":  "Араратская область",
+		"AM-AV":  "Армавирская область",
+		"AM-ER":  "Ереван",
+		"AM-GR":  "Гехаркуникская область",
+		"AM-KT":  "Котайкская область",
+		"AM-SH":  "Ширакская область",
+		"AM-SU":  "Сюникская область",
+		"AM-TV":  "Тавушская область",
+		"AM-VD":  "Вайоцдзорская область",
+		"AO-BGO": "Бенго",
+		"AO-BGU": "Бенгела",
+		"AO-BIE": "Бие",
+		"AO-CAB": "Кабинда",
+		"AO-CCU": "Квандо-Кубанго",
+		"AO-CNN": "Кунене",
+		"AO-CNO": "Северная Кванза",
+		"AO-CUS": "Южная Кванза",
+		"AO-HUA": "Уамбо",
+		"AO-HUI": "Уила",
+		"AO-LNO": "Северная Лунда",
+		"AO-LSU": "Южная Лунда",
+		"AO-LUA": "Луанда",
+		"AO-MAL": "Маланже",
+		"AO-MOX": "Мошико",
+		"AO-NAM": "Намибе",
+		"AO-UIG": "Уиже",
+		"AO-ZAI": "Заире",
+		"AR-A":   "Сальта",
+		"AR-B":   "Буэнос-Айрес",
+		"AR-C":   "Автономный город Буэнос-Айрес",
+		"AR-D":   "Сан-Луис",
+		"AR-E":   "Энтре-Риос",
+		"AR-F":   "Риоха",
+		"AR-G":   "Сантьяго-дель-Эстеро",
+		"AR-H":   "Чако",
+		"AR-J":   "Сан-Хуан",
+		"AR-K":   "Катамарка",
+		"AR-L":   "Ла-Пампа",
+		"AR-M":   "Мендоса",
+		"AR-N":   "Мисьонес",
+		"AR-P":   "Формоса",
+		"AR-Q":   "Неукен",
+		"AR-R":   "Рио-Негро",
+		"AR-S":   "Санта-Фе",
+		"AR-T":   "Тукуман",
+		"AR-U":   "Чубут",
+		"AR-V":   "Огненная Земля",
+		"AR-W":   "Корриентес",
+		"AR-X":   "Кордова",
+		"AR-Y":   "Жужуй",
+		"AR-Z":   "Санта-Крус",
+		"AT-1":   "Бургенланд",
+		"AT-2":   "Каринтия",
+		"AT-3":   "Нижняя Австрия",
+		"AT-4":   "Верхняя Австрия",
+		"AT-5":   "Зальцбург",
+		"AT-6":   "Штирия",
+		"AT-7":   "Тироль",
+		"AT-8":   "Форарльберг",
+		"AT-9":   "Вена",
+		"AU-ACT": "Австралийская столичная территория",
+		"AU-NSW": "Новый Южный Уэльс",
+		"AU-NT":  "Северная территория",
+		"AU-QLD": "Квинсленд",
+		"AU-SA":  "Южная Австралия",
+		"AU-TAS": "Тасмания",
+		"AU-VIC": "Виктория",
+		"AU-WA":  "Западная Австралия",
+		"AZ-ABS": "Абшеронский район",
+		"AZ-AGA": "Акстафинский район",
+		"AZ-AGC": "Агджабединский район",
+		"AZ-AGM": "Агдамский район",
+		"AZ-AGS": "Агдашаский район",
+		"AZ-AGU": "Ахсуйский район",
+		"AZ-AST": "Астаринский район",
+		"AZ-BA":  "Баку",
+		"AZ-BAB": "Бабекский район",
+		"AZ-BAL": "Белоканский район",
+		"AZ-BAR": "Бардинский район",
+		"AZ-BEY": "Бейлаганский район",
+		"AZ-BIL": "Билясуварский район",
+		"AZ-CAB": "Джебраильский район",
+		"AZ-CAL": "Джалилабадский район",
+		"AZ-CUL": "Джульфинский район",
+		"AZ-DAS": "Дашкесанский район",
+		"AZ-FUZ": "Фузулинский район",
+		"AZ-GA":  "Гянджа",
+		"AZ-GAD": "Кедабекский район",
+		"AZ-GOR": "Геранбойский район",
+		"AZ-GOY": "Геокчайский район",
+		"AZ-GYG": "Гёйгёльский район",
+		"AZ-HAC": "Аджикабульский район",
+		"AZ-IMI": "Имишлинский район",
+		"AZ-ISM": "Исмаиллинский район",
+		"AZ-KAL": "Кельбаджарский район",
+		"AZ-KAN": "Кенгерлинский район",
+		"AZ-KUR": "Кюрдамирский район",
+		"AZ-LA":  "Ленкорань",
+		"AZ-LAC": "Лачинский район",
+		"AZ-LAN": "Ленкорань",
+		"AZ-LER": "Лерикский район",
+		"AZ-MAS": "Масаллинский район",
+		"AZ-MI":  "Мингечаур",
+		"AZ-NA":  "Нафталан",
+		"AZ-NEF": "Нефтечалинский район",
+		"AZ-NV":  "Нахичевань",
+		"AZ-NX":  "Нахичевань",
+		"AZ-OGU": "Огузский район",
+		"AZ-ORD": "Ордубадский район",
+		"AZ-QAB": "Габалинский район",
+		"AZ-QAX": "Кахский район",
+		"AZ-QAZ": "Казахский район",
+		"AZ-QBA": "Губинский район",
+		"AZ-QBI": "Кубатлинский район",
+		"AZ-QOB": "Гобустанский район",
+		"AZ-QUS": "Кусарский район",
+		"AZ-SA":  "Шекинский район",
+		"AZ-SAB": "Сабирабадский район",
+		"AZ-SAD": "Садаракский район",
+		"AZ-SAH": "Шахбузский район",
+		"AZ-SAK": "Шекинский район",
+		"AZ-SAL": "Сальянский район",
+		"AZ-SAR": "Шарурский район",
+		"AZ-SAT": "Саатлинский район",
+		"AZ-SBN": "Шабранский район",
+		"AZ-SIY": "Сиазанский район",
+		"AZ-SKR": "Шамкирский район",
+		"AZ-SM":  "Сумгаит",
+		"AZ-SMI": "Шемахинский район",
+		"AZ-SMX": "Самухский район",
+		"AZ-SR":  "Ширван",
+		"AZ-SUS": "Шушинский район",
+		"AZ-TAR": "Тертерский район",
+		"AZ-TOV": "Товузский район",
+		"AZ-UCA": "Уджарский район",
+		"AZ-XA":  "Ханкенди",
+		"AZ-XAC": "Хачмазский район",
+		"AZ-XCI": "Ходжалинский район",
+		"AZ-XIZ": "Хызынский район",
+		"AZ-XVD": "Ходжаведский район",
+		"AZ-YAR": "Ярдымлинский район",
+		"AZ-YE":  "Евлахский район",
+		"AZ-YEV": "Евлахский район",
+		"AZ-ZAN": "Зангиланский район",
+		"AZ-ZAQ": "Закаталинский район",
+		"AZ-ZAR": "Зардабский район",
+		"BA-BIH": "Федерация Боснии и Герцеговины",
+		"BA-BRC": "Округ Брчко",
+		"BA-SRP": "Республика Сербская",
+		"BB-01":  "Крайст Чёрч",
+		"BB-02":  "Сент Эндрю",
+		"BB-03":  "Сент-Джордж",
+		"BB-04":  "Сент Джеймс",
+		"BB-05":  "Сент-Джон",
+		"BB-06":  "Сент Джозеф",
+		"BB-07":  "Сент Лючи",
+		"BB-08":  "Сент Мишель",
+		"BB-09":  "Сент-Питер",
+		"BB-10":  "Сент-Филип",
+		"BB-11":  "Сент Томас",
+		"BD-01":  "Бандарбан",
+		"BD-02":  "Баргуна",
+		"BD-03":  "Богра",
+		"BD-04":  "Брахманбария",
+		"BD-05":  "Багерхат",
+		"BD-06":  "Барисал",
+		"BD-07":  "Бхола",
+		"BD-08":  "Комилла",
+		"BD-09":  "Чандпур",
+		"BD-10":  "Читтагонг",
+		"BD-11":  "Кокс-Базар",
+		"BD-12":  "Чуаданга",
+		"BD-13":  "Дакка",
+		"BD-14":  "Динаджпур",
+		"BD-15":  "Фаридпур",
+		"BD-16":  "Фени",
+		"BD-17":  "Гопалгандж",
+		"BD-18":  "Газипур",
+		"BD-19":  "Гайбандха",
+		"BD-20":  "Хабигандж",
+		"BD-21":  "Джамалпур",
+		"BD-22":  "Джессор",
+		"BD-23":  "Дженайда",
+		"BD-24":  "Джайпурхат",
+		"BD-25":  "Джалокати",
+		"BD-26":  "Кишоргандж",
+		"BD-27":  "Кхулна",
+		"BD-28":  "Куриграм",
+		"BD-29":  "Кхаграчхари",
+		"BD-30":  "Куштия",
+		"BD-31":  "Лакшмипур",
+		"BD-32":  "Лалмонирхат",
+		"BD-33":  "Маникгандж",
+		"BD-34":  "Маймансингх",
+		"BD-35":  "Муншигандж",
+		"BD-36":  "Мадарипур",
+		"BD-37":  "Магура",
+		"BD-38":  "Маулвибазар",
+		"BD-39":  "Мехерпур",
+		"BD-40":  "Нараянгандж",
+		"BD-41":  "Нетрокона",
+		"BD-42":  "Нарсингди",
+		"BD-43":  "Нарайл",
+		"BD-44":  "Натор",
+		"BD-45":  "Навабгандж",
+		"BD-46":  "Нилпхамари",
+		"BD-47":  "Ноакхали",
+		"BD-48":  "Наогаон",
+		"BD-49":  "Пабна",
+		"BD-50":  "Пироджпур",
+		"BD-51":  "Патуакхали",
+		"BD-52":  "Панчагарх",
+		"BD-53":  "Раджбари",
+		"BD-54":  "Раджшахи",
+		"BD-55":  "Рангпур",
+		"BD-56":  "Рангамати",
+		"BD-57":  "Шерпур",
+		"BD-58":  "Саткхира",
+		"BD-59":  "Сираджгандж",
+		"BD-60":  "Силхет",
+		"BD-61":  "Сунамгандж",
+		"BD-62":  "Шариатпур",
+		"BD-63":  "Тангайл",
+		"BD-64":  "Тхакургаон",
+		"BD-A":   "Барисал",
+		"BD-B":   "Читтагонг",
+		"BD-C":   "Дакка",
+		"BD-D":   "Кхулна",
+		"BD-E":   "Раджшахи",
+		"BD-F":   "Рангпур",
+		"BD-G":   "Силхет",
+		"BD-H":   "Маймансингх",
+		"BE-BRU": "Брюссельский столичный регион",
+		"BE-VAN": "Антверпен",
+		"BE-VBR": "Фламандский Брабант",
+		"BE-VLG": "Фламандский регион",
+		"BE-VLI": "Лимбург",
+		"BE-VOV": "Восточная Фландрия",
+		"BE-VWV": "Западная Фландрия",
+		"BE-WAL": "Валлония",
+		"BE-WBR": "Валлонский Брабант",
+		"BE-WHT": "Эно",
+		"BE-WLG": "Льеж",
+		"BE-WLX": "Люксембург",
+		"BE-WNA": "Намюр",
+		"BF-01":  "Букле-ду-Мухун",
+		"BF-02":  "Каскады",
+		"BF-03":  "Центральная",
+		"BF-BAL": "Бале",
+		"BF-BAM": "Бам",
+		"BF-BAN": "Банва",
+		"BF-BAZ": "Базега",
+		"BF-BGR": "Бугуриба",
+		"BF-BLG": "Бульгу",
+		"BF-BLK": "Булькиемде",
+		"BF-COM": "Комоэ",
+		"BF-GAN": "Ганзургу",
+		"BF-GNA": "Ньянья",
+		"BF-GOU": "Гурма",
+		"BF-HOU": "Уэ",
+		"BF-IOB": "Иоба",
+		"BF-KAD": "Кадиого",
+		"BF-KEN": "Кенедугу",
+		"BF-KMD": "Комонджари",
+		"BF-KMP": "Компьенга",
+		"BF-KOP": "Кульпелого",
+		"BF-KOS": "Коси",
+		"BF-KOT": "Куритенга",
+		"BF-KOW": "Курвеого",
+		"BF-LER": "Лераба",
+		"BF-LOR": "Лорум",
+		"BF-MOU": "Мухун",
+		"BF-NAM": "Наментенга",
+		"BF-NAO": "Нахури",
+		"BF-NAY": "Наяла",
+		"BF-NOU": "Нумбиель",
+		"BF-OUB": "Убритенга",
+		"BF-OUD": "Удалан",
+		"BF-PAS": "Пасоре",
+		"BF-PON": "Пони",
+		"BF-SEN": "Сено",
+		"BF-SIS": "Сисили",
+		"BF-SMT": "Санматенга",
+		"BF-SNG": "Сангие",
+		"BF-SOM": "Сум",
+		"BF-SOR": "Суру",
+		"BF-TAP": "Тапоа",
+		"BF-TUI": "Тюи",
+		"BF-YAG": "Яга",
+		"BF-YAT": "Ятенга",
+		"BF-ZIR": "Зиро",
+		"BF-ZON": "Зондома",
+		"BF-ZOU": "Зундвеого",
+		"BG-01":  "Благоевград",
+		"BG-02":  "Бургас",
+		"BG-03":  "Варна",
+		"BG-04":  "Велико-Тырново",
+		"BG-05":  "Видин",
+		"BG-06":  "Враца",
+		"BG-07":  "Габрово",
+		"BG-08":  "Добрич",
+		"BG-09":  "Кырджали",
+		"BG-10":  "Кюстендил",
+		"BG-11":  "Ловеч",
+		"BG-12":  "Монтана",
+		"BG-13":  "Пазарджик",
+		"BG-14":  "Перник",
+		"BG-15":  "Плевен",
+		"BG-16":  "Пловдив",
+		"BG-17":  "Разград",
+		"BG-19":  "Силистра",
+		"BG-20":  "Сливен",
+		"BG-21":  "Смолян",
+		"BG-23":  "София",
+		"BG-24":  "Стара-Загора",
+		"BG-25":  "Тырговиште",
+		"BG-26":  "Хасково",
+		"BG-27":  "Шумен",
+		"BG-28":  "Ямбол",
+		"BI-BL":  "Бужумбура-Рураль",
+		"BI-BM":  "Бужумбура-Мери",
+		"BI-BR":  "Бурури",
+		"BI-CA":  "Чанкузо",
+		"BI-CI":  "Чибитоке",
+		"BI-GI":  "Гитега",
+		"BI-KI":  "Кирундо",
+		"BI-KR":  "Карузи",
+		"BI-KY":  "Каянза",
+		"BI-MU":  "Мурамвья",
+		"BI-MW":  "Мваро",
+		"BI-MY":  "Муйинга",
+		"BI-RM":  "Румонге",
+		"BI-RT":  "Рутана",
+		"BI-RY":  "Руйиги",
+		"BJ-LI":  "Литораль",
+		"BJ-MO":  "Моно",
+		"BJ-PL":  "Плато",
+		"BN-BM":  "Бруней-Муара",
+		"BN-TE":  "Тембуронг",
+		"BO-C":   "Кочабамба",
+		"BO-H":   "Чукисака",
+		"BO-L":   "Ла-Пас",
+		"BO-N":   "Пандо",
+		"BO-P":   "Потоси",
+		"BO-S":   "Санта-Крус",
+		"BO-T":   "Тариха",
+		"BQ-BO":  "Бонайре",
+		"BQ-SA":  "Саба",
+		"BQ-SE":  "Синт-Эстатиус",
+		"BR-AC":  "Акр",
+		"BR-AL":  "Алагоас",
+		"BR-AP":  "Амапа",
+		"BR-BA":  "Баия",
+		"BR-CE":  "Сеара",
+		"BR-ES":  "Эспириту-Санту",
+		"BR-GO":  "Гояс",
+		"BR-MA":  "Мараньян",
+		"BR-MG":  "Минас-Жерайс",
+		"BR-MS":  "Мату-Гросу-ду-Сул",
+		"BR-MT":  "Мату-Гросу",
+		"BR-PA":  "Пара",
+		"BR-PB":  "Параиба",
+		"BR-PE":  "Пернамбуку",
+		"BR-PI":  "Пиауи",
+		"BR-PR":  "Парана",
+		"BR-RJ":  "Рио-де-Жанейро",
+		"BR-RN":  "Риу-Гранди-ду-Норти",
+		"BR-RO":  "Рондония",
+		"BR-RR":  "Рорайма",
+		"BR-RS":  "Риу-Гранди-ду-Сул",
+		"BR-SC":  "Санта Катарина",
+		"BR-SE":  "Сержипи",
+		"BR-SP":  "Сан-Паулу",
+		"BR-TO":  "Токантинс",
+		"BS-AK":  "Аклинс",
+		"BS-BI":  "Бимини",
+		"BS-IN":  "Инагуа",
+		"BS-LI":  "Лонг-Айленд",
+		"BS-MC":  "Мангров-Ки",
+		"BS-MG":  "Маягуана",
+		"BS-NP":  "Нью-Провиденс",
+		"BS-RC":  "Рам-Ки",
+		"BS-SO":  "Южный Абако",
+		"BS-SS":  "Сан-Сальвадор",
+		"BS-SW":  "Спэниш-Уэллс",
+		"BT-11":  "Паро",
+		"BT-12":  "Мебиса",
+		"BT-13":  "Хас",
+		"BT-14":  "Самце",
+		"BT-15":  "Тхимпху",
+		"BT-23":  "Пунакха",
+		"BT-24":  "Вангди-Пходранг",
+		"BT-31":  "Сарпанг",
+		"BT-32":  "Тронгса",
+		"BT-34":  "Жемганг",
+		"BT-41":  "Трашиганг",
+		"BT-42":  "Монгар",
+		"BT-44":  "Лхунце",
+		"BT-45":  "Самдруп-Джонгхар",
+		"BT-TY":  "Трашиянгце",
+		"BW-CH":  "Чобе",
+		"BW-FR":  "Франсистаун",
+		"BW-GA":  "Габороне",
+		"BW-JW":  "Джваненг",
+		"BW-KL":  "Кгатленг",
+		"BW-LO":  "Лобаце",
+		"BY-HM":  "Минск",
+		"BY-HO":  "Гомельская область",
+		"BY-HR":  "Гродненская область",
+		"BY-MI":  "Минская область",
+		"BZ-BZ":  "Белиз",
+		"BZ-TOL": "Толедо",
+		"CA-AB":  "Альберта",
+		"CA-BC":  "Британская Колумбия",
+		"CA-MB":  "Манитоба",
+		"CA-NB":  "Нью-Брансуик",
+		"CA-NL":  "Ньюфаундленд и Лабрадор",
+		"CA-NS":  "Новая Шотландия",
+		"CA-NT":  "Северо-Западные территории",
+		"CA-NU":  "Нунавут",
+		"CA-ON":  "Онтарио",
+		"CA-PE":  "Остров Принца Эдуарда",
+		"CA-QC":  "Квебек",
+		"CA-SK":  "Саскачеван",
+		"CA-YT":  "Юкон",
+		"CD-BC":  "Центральное Конго",
+		"CD-BU":  "Нижнее Уэле",
+		"CD-EQ":  "Эквадор",
+		"CD-NK":  "Северное Киву",
+		"CD-NU":  "Северное Убанги",
+		"CD-SK":  "Южное Киву",
+		"CD-SU":  "Южное Убанги",
+		"CD-TA":  "Тангайл",
+		"CD-TO":  "Чопо",
+		"CD-TU":  "Чуапа",
+		"CF-AC":  "Уам",
+		"CF-BB":  "Баминги-Бангоран",
+		"CF-BGF": "Банги",
+		"CF-BK":  "Нижнее Котто",
+		"CF-HK":  "Верхнее Котто",
+		"CF-HM":  "Верхнее Мбому",
+		"CF-KB":  "Нана-Гребизи",
+		"CF-KG":  "Кемо",
+		"CF-LB":  "Лобае",
+		"CF-MP":  "Омбелла-Мпоко",
+		"CF-NM":  "Нана-Мамбере",
+		"CF-OP":  "Уам-Пенде",
+		"CF-SE":  "Сангха",
+		"CF-VK":  "Вакага",
+		"CG-11":  "Буэнза",
+		"CG-13":  "Сангха",
+		"CG-2":   "Лекуму",
+		"CG-BZV": "Браззавиль",
+		"CH-AG":  "Аргау",
+		"CH-AI":  "Аппенцелль-Иннерроден",
+		"CH-AR":  "Аппенцелль-Аусерроден",
+		"CH-BE":  "Берн",
+		"CH-BL":  "Базель-Ланд",
+		"CH-BS":  "Базель-Штадт",
+		"CH-FR":  "Лимбург",
+		"CH-GE":  "Женева",
+		"CH-GL":  "Гларус",
+		"CH-GR":  "Граубюнден",
+		"CH-JU":  "Юра",
+		"CH-LU":  "Люцерн",
+		"CH-NE":  "Невшатель",
+		"CH-NW":  "Нидвальден",
+		"CH-OW":  "Обвальден",
+		"CH-SH":  "Шаффхаузен",
+		"CH-SO":  "Золотурн",
+		"CH-TG":  "Тургау",
+		"CH-TI":  "Тичино",
+		"CH-ZG":  "Цуг",
+		"CH-ZH":  "Цюрих",
+		"CI-AB":  "Абиджан",
+		"CI-BS":  "Ла-Массана",
+		"CI-CM":  "Комоэ",
+		"CL-AI":  "Айсен-дель-Хенераль-Карлос-Ибаньес-дель-Кампо",
+		"CL-AN":  "Антофагаста",
+		"CL-AP":  "Арика-и-Паринакота",
+		"CL-AR":  "Арауко",
+		"CL-AT":  "Атакама",
+		"CL-BI":  "Био-Био",
+		"CL-CO":  "Кокимбо",
+		"CL-LI":  "Либертадор-Хенераль-Бернардо-О’Хиггинс",
+		"CL-LL":  "Лос-Лагос",
+		"CL-MA":  "Магальянес",
+		"CL-ML":  "Мауле",
+		"CM-CE":  "Центральная",
+		"CM-LT":  "Литораль",
+		"CN-BJ":  "Пекин",
+		"CN-CQ":  "Чунцин",
+		"CN-GD":  "Гуандун",
+		"CN-HI":  "Хайнань",
+		"CN-HK":  "Гонконг",
+		"CN-HN":  "Хунань",
+		"CN-JL":  "Гирин",
+		"CN-MO":  "Макао",
+		"CN-NM":  "Внутренняя Монголия",
+		"CN-QH":  "Цинхай",
+		"CN-SC":  "Сычуань",
+		"CN-SH":  "Шанхай",
+		"CN-YN":  "Юньнань",
+		"CN-ZJ":  "Чжэцзян",
+		"CO-ARA": "Араука",
+		"CO-COR": "Кордова",
+		"CO-NAR": "Нариньо",
+		"CO-NSA": "Северный Сантандер",
+		"CO-QUI": "Киндио",
+		"CO-SAP": "Сан-Андрес-и-Провиденсия",
+		"CR-C":   "Картаго",
+		"CR-G":   "Гуанакасте",
+		"CR-H":   "Эредия",
+		"CR-L":   "Лимон",
+		"CU-01":  "Пинар-дель-Рио",
+		"CU-03":  "Гавана",
+		"CU-04":  "Матансас",
+		"CU-06":  "Сьенфуэгос",
+		"CU-07":  "Санкти-Спиритус",
+		"CU-08":  "Сьего-де-Авила",
+		"CU-09":  "Камагуэй",
+		"CU-11":  "Ольгин",
+		"CU-12":  "Гранма",
+		"CU-13":  "Сантьяго-де-Куба",
+		"CU-14":  "Гуантанамо",
+		"CU-15":  "Артемиса",
+		"CV-BV":  "Боа-Виста",
+		"CV-CA":  "Санта Катарина",
+		"CV-CR":  "Санта-Крус",
+		"CV-MO":  "Моштейруш",
+		"CV-PN":  "Порто-Ново",
+		"CV-PR":  "Прая",
+		"CV-RB":  "Рибейра-Брава",
+		"CV-SD":  "Сан-Домингус",
+		"CV-SV":  "Сан-Висенти",
+		"CV-TA":  "Таррафал",
+		"CV-TS":  "Таррафал-де-Сан-Николау",
+		"CY-02":  "Лимасол",
+		"CY-03":  "Ларнака",
+		"CZ-20":  "Среднечешский край",
+		"CZ-201": "Бенешов",
+		"CZ-202": "Бероун",
+		"CZ-203": "Кладно",
+		"CZ-204": "Колин",
+		"CZ-205": "Кутна-Гора",
+		"CZ-206": "Мельник",
+		"CZ-207": "Млада-Болеслав",
+		"CZ-208": "Нимбурк",
+		"CZ-209": "Прага-восток",
+		"CZ-20A": "Прага-запад",
+		"CZ-20B": "Пршибрам",
+		"CZ-20C": "Раковник",
+		"CZ-31":  "Южно-Чешский край",
+		"CZ-311": "Ческе-Будеёвице",
+		"CZ-312": "Чески-Крумлов",
+		"CZ-313": "Йиндржихув-Градец",
+		"CZ-314": "Писек",
+		"CZ-315": "Прахатице",
+		"CZ-316": "Страконице",
+		"CZ-317": "Табор",
+		"CZ-32":  "Пльзеньский край",
+		"CZ-321": "Домажлице",
+		"CZ-322": "Клатови",
+		"CZ-323": "Пльзень-город",
+		"CZ-324": "Пльзень-юг",
+		"CZ-325": "Пльзень-север",
+		"CZ-326": "Рокицани",
+		"CZ-327": "Тахов",
+		"CZ-41":  "Карловарский край",
+		"CZ-411": "Хеб",
+		"CZ-412": "Карловы Вары",
+		"CZ-413": "Соколов",
+		"CZ-42":  "Устецкий край",
+		"CZ-421": "Дечин",
+		"CZ-422": "Хомутов",
+		"CZ-423": "Литомержице",
+		"CZ-424": "Лоуни",
+		"CZ-426": "Теплице",
+		"CZ-427": "Усти-над-Лабем",
+		"CZ-51":  "Либерецкий край",
+		"CZ-511": "Ческа-Липа",
+		"CZ-512": "Яблонец-над-Нисоу",
+		"CZ-513": "Либерец",
+		"CZ-514": "Семили",
+		"CZ-52":  "Краловеградецкий край",
+		"CZ-521": "Градец-Кралове",
+		"CZ-522": "Йичин",
+		"CZ-523": "Наход",
+		"CZ-524": "Рихнов-над-Кнежноу",
+		"CZ-525": "Трутнов",
+		"CZ-53":  "Пардубицкий край",
+		"CZ-531": "Хрудим",
+		"CZ-532": "Пардубице",
+		"CZ-533": "Свитави",
+		"CZ-534": "Усти-над-Орлици",
+		"CZ-631": "Гавличкув-Брод",
+		"CZ-632": "Йиглава",
+		"CZ-633": "Пельгржимов",
+		"CZ-634": "Тршебич",
+		"CZ-635": "Ждяр-над-Сазавоу",
+		"CZ-64":  "Южно-Моравский край",
+		"CZ-641": "Бланско",
+		"CZ-642": "Брно-город",
+		"CZ-643": "Брно-пригород",
+		"CZ-644": "Бржецлав",
+		"CZ-645": "Годонин",
+		"CZ-646": "Вишков",
+		"CZ-647": "Зноймо",
+		"CZ-71":  "Оломоуцкий край",
+		"CZ-711": "Есеник",
+		"CZ-712": "Оломоуц",
+		"CZ-713": "Простеёв",
+		"CZ-714": "Пршеров",
+		"CZ-715": "Шумперк",
+		"CZ-72":  "Злинский край",
+		"CZ-721": "Кромержиж",
+		"CZ-722": "Угерске-Градиште",
+		"CZ-723": "Всетин",
+		"CZ-724": "Злин",
+		"CZ-80":  "Моравско-Силезский край",
+		"CZ-802": "Фридек-Мистек",
+		"CZ-803": "Карвина",
+		"CZ-804": "Нови-Йичин",
+		"CZ-805": "Опава",
+		"CZ-806": "Острава-город",
+		"DE-BB":  "Бранденбург",
+		"DE-BE":  "Берлин",
+		"DE-BW":  "Баден-Вюртемберг",
+		"DE-BY":  "Бавария",
+		"DE-HB":  "Бремен",
+		"DE-HE":  "Гессен",
+		"DE-HH":  "Гамбург",
+		"DE-MV":  "Мекленбург-Передняя Померания",
+		"DE-NI":  "Нижняя Саксония",
+		"DE-NW":  "Северный Рейн-Вестфалия",
+		"DE-RP":  "Рейнланд-Пфальц",
+		"DE-SH":  "Шлезвиг-Гольштейн",
+		"DE-SL":  "Саар",
+		"DE-ST":  "Саксония-Анхальт",
+		"DE-TH":  "Тюрингия",
+		"DJ-AR":  "Арта",
+		"DJ-AS":  "Али-Сабих",
+		"DJ-DI":  "Дикиль",
+		"DJ-DJ":  "Джибути",
+		"DK-83":  "Южная Дания",
+		"DK-84":  "Ховедстаден",
+		"DK-85":  "Зеландия",
+		"DM-02":  "Сент Эндрю",
+		"DM-04":  "Сент-Джордж",
+		"DM-05":  "Сент-Джон",
+		"DM-06":  "Сент Джозеф",
+		"DM-10":  "Сент-Пол",
+		"DM-11":  "Сент-Питер",
+		"DO-21":  "Сан-Кристобаль",
+		"DO-22":  "Сан-Хуан",
+		"DO-23":  "Сан-Педро-де-Макорис",
+		"DO-24":  "Санчес-Рамирес",
+		"DO-25":  "Сантьяго",
+		"DO-27":  "Валверде",
+		"DO-32":  "Санто-Доминго",
+		"DZ-01":  "Адрар",
+		"DZ-02":  "Эш-Шелифф",
+		"DZ-03":  "Лагуат",
+		"DZ-04":  "Умм-эль-Буаги",
+		"DZ-05":  "Батна",
+		"DZ-06":  "Беджая",
+		"DZ-07":  "Бискра",
+		"DZ-08":  "Бешар",
+		"DZ-09":  "Блида",
+		"DZ-10":  "Буира",
+		"DZ-11":  "Таманрассет",
+		"DZ-12":  "Тебесса",
+		"DZ-13":  "Тлемсен",
+		"DZ-14":  "Тиарет",
+		"DZ-15":  "Тизи-Узу",
+		"DZ-16":  "Алджер",
+		"DZ-17":  "Джельфа",
+		"DZ-18":  "Джиджель",
+		"DZ-19":  "Сетиф",
+		"DZ-20":  "Саида",
+		"DZ-21":  "Скикда",
+		"DZ-22":  "Сиди-Бель-Аббес",
+		"DZ-23":  "Аннаба",
+		"DZ-24":  "Гельма",
+		"DZ-26":  "Медеа",
+		"DZ-30":  "Уаргла",
+		"DZ-31":  "Оран",
+		"DZ-32":  "Эль-Баяд",
+		"DZ-33":  "Иллизи",
+		"DZ-34":  "Бордж-Бу-Арреридж",
+		"DZ-35":  "Бумердес",
+		"DZ-36":  "Эль-Тарф",
+		"DZ-37":  "Тиндуф",
+		"DZ-38":  "Тисемсильт",
+		"DZ-39":  "Эль-Уэд",
+		"DZ-40":  "Хеншела",
+		"DZ-41":  "Сук-Ахрас",
+		"DZ-42":  "Типаза",
+		"DZ-43":  "Мила",
+		"DZ-44":  "Айн-Дефла",
+		"DZ-46":  "Айн-Темушент",
+		"DZ-47":  "Гардая",
+		"DZ-48":  "Гализан",
+		"EC-A":   "Асуай",
+		"EC-C":   "Карчи",
+		"EC-D":   "Орельяна",
+		"EC-G":   "Гуаяс",
+		"EC-H":   "Чимборасо",
+		"EC-P":   "Пичинча",
+		"EC-S":   "Морона-Сантьяго",
+		"EC-SD":  "Санто-Доминго-де-лос-Тсачилас",
+		"EC-SE":  "Санта-Элена",
+		"EC-T":   "Тунгурауа",
+		"EC-U":   "Сукумбиос",
+		"EC-W":   "Галапагос",
+		"EC-Z":   "Самора-Чинчипе",
+		"EE-130": "Ида-Вирумаа",
+		"EE-142": "Антсла",
+		"EE-171": "Елва",
+		"EE-184": "Хаапсалу",
+		"EE-205": "Хийумаа",
+		"EE-214": "Хяэдемеэсте",
+		"EE-247": "Йыгева",
+		"EE-251": "Йыхви",
+		"EE-255": "Ярвамаа",
+		"EE-272": "Кадрина",
+		"EE-296": "Кейла",
+		"EE-303": "Кихну",
+		"EE-305": "Кийли",
+		"EE-321": "Кохтла-Ярве",
+		"EE-338": "Козе",
+		"EE-37":  "Харьюмаа",
+		"EE-39":  "Хийумаа",
+		"EE-424": "Локса",
+		"EE-442": "Люганузе",
+		"EE-446": "Маарду",
+		"EE-45":  "Ида-Вирумаа",
+		"EE-486": "Муствеэ",
+		"EE-50":  "Йыгевамаа",
+		"EE-503": "Мярьямаа",
+		"EE-511": "Нарва",
+		"EE-514": "Нарва-Йыэсуу",
+		"EE-52":  "Ярвамаа",
+		"EE-557": "Отепя",
+		"EE-56":  "Ляэнемаа",
+		"EE-567": "Пайде",
+		"EE-60":  "Ляэне-Вирумаа",
+		"EE-618": "Пылтсамаа",
+		"EE-622": "Пылва",
+		"EE-624": "Пярну",
+		"EE-64":  "Пылвамаа",
+		"EE-651": "Раазику",
+		"EE-661": "Раквере",
+		"EE-663": "Раквере",
+		"EE-668": "Рапла",
+		"EE-68":  "Пярнумаа",
+		"EE-689": "Рухну",
+		"EE-708": "Ряпина",
+		"EE-71":  "Рапламаа",
+		"EE-714": "Сааремаа",
+		"EE-719": "Саку",
+		"EE-726": "Сауэ",
+		"EE-732": "Сетумаа",
+		"EE-735": "Силламяэ",
+		"EE-74":  "Сааремаа",
+		"EE-784": "Таллин",
+		"EE-79":  "Тартумаа",
+		"EE-792": "Тапа",
+		"EE-793": "Тарту",
+		"EE-796": "Тарту",
+		"EE-803": "Тойла",
+		"EE-81":  "Валгамаа",
+		"EE-824": "Тырва",
+		"EE-84":  "Вильяндимаа",
+		"EE-87":  "Вырумаа",
+		"EE-890": "Виймси",
+		"EE-897": "Вильянди",
+		"EE-899": "Вильянди",
+		"EE-903": "Виру-Нигула",
+		"EE-907": "Вормси",
+		"EE-917": "Выру",
+		"EE-919": "Выру",
+		"EG-ASN": "Асуан",
+		"EG-AST": "Асьют",
+		"EG-BH":  "Эль-Фуджайра",
+		"EG-BNS": "Бени-Суэйф",
+		"EG-DK":  "Дакахлия",
+		"EG-FYM": "Эль-Файюм",
+		"EG-GH":  "Эль-Гарбия",
+		"EG-GZ":  "Эль-Гиза",
+		"EG-KFS": "Кафр-эш-Шейх",
+		"EG-LX":  "Луксор",
+		"EG-PTS": "Порт-Саид",
+		"EG-SHG": "Сохаг",
+		"EG-SHR": "Эш-Шаркия",
+		"EG-WAD": "Вади-эль-Гедид",
+		"ER-GB":  "Гаш-Барка",
+		"ES-AB":  "Альбасете",
+		"ES-AL":  "Альмерия",
+		"ES-AN":  "Андалусия",
+		"ES-AR":  "Арагон",
+		"ES-BA":  "Бадахос",
+		"ES-BI":  "Бискайя",
+		"ES-BU":  "Бургос",
+		"ES-CA":  "Кадис",
+		"ES-CB":  "Кантабрия",
+		"ES-CC":  "Касерес",
+		"ES-CE":  "Сеута",
+		"ES-CL":  "Кастилия-Леон",
+		"ES-CM":  "Кастилия-Ла-Манча",
+		"ES-CN":  "Канариас",
+		"ES-CO":  "Кордова",
+		"ES-CR":  "Сьюдад-Реаль",
+		"ES-CU":  "Куэнка",
+		"ES-EX":  "Эстремадура",
+		"ES-GC":  "Лас-Пальмас-де-Гран-Канария",
+		"ES-GR":  "Гранада",
+		"ES-GU":  "Гвадалахара",
+		"ES-H":   "Уэльва",
+		"ES-HU":  "Уэска",
+		"ES-LE":  "Леон",
+		"ES-LO":  "Риоха",
+		"ES-M":   "Мадрид",
+		"ES-MA":  "Малага",
+		"ES-ML":  "Мелилья",
+		"ES-MU":  "Мурсия",
+		"ES-O":   "Астурия",
+		"ES-P":   "Паленсия",
+		"ES-PV":  "Баскские земли",
+		"ES-RI":  "Риоха",
+		"ES-S":   "Кантабрия",
+		"ES-SA":  "Саламанка",
+		"ES-SE":  "Севилья",
+		"ES-SG":  "Сеговия",
+		"ES-SO":  "Сория",
+		"ES-SS":  "Гипускоа",
+		"ES-TE":  "Теруэль",
+		"ES-TF":  "Санта-Крус-де-Тенерифе",
+		"ES-TO":  "Толедо",
+		"ES-V":   "Валенсия",
+		"ES-VA":  "Вальядолид",
+		"ES-Z":   "Сарагоса",
+		"ES-ZA":  "Самора",
+		"ET-AA":  "Аддис-Абеба",
+		"ET-AM":  "Амара",
+		"ET-BE":  "Бенишангуль-Гумуз",
+		"ET-DD":  "Дыре-Дауа",
+		"ET-OR":  "Оромия",
+		"FI-01":  "Аландские острова",
+		"FI-02":  "Южная Карелия",
+		"FI-03":  "Южная Остроботния",
+		"FI-04":  "Южное Саво",
+		"FI-05":  "Кайнуу",
+		"FI-09":  "Кюменлааксо",
+		"FI-10":  "Лаппи",
+		"FI-11":  "Бургенланд",
+		"FI-17":  "Сатакунта",
+		"FI-18":  "Ниланд",
+		"FR-01":  "Эн",
+		"FR-02":  "Эна",
+		"FR-03":  "Алье",
+		"FR-04":  "Альпы Верхнего Прованса",
+		"FR-05":  "Верхние Альпы",
+		"FR-06":  "Приморские Альпы",
+		"FR-07":  "Ардеш",
+		"FR-08":  "Арденны",
+		"FR-09":  "Арьеж",
+		"FR-10":  "Об",
+		"FR-11":  "Од",
+		"FR-12":  "Аверон",
+		"FR-13":  "Буш-дю-Рон",
+		"FR-14":  "Кальвадос",
+		"FR-15":  "Канталь",
+		"FR-16":  "Шаранта",
+		"FR-17":  "Приморская Шаранта",
+		"FR-18":  "Шер",
+		"FR-19":  "Коррез",
+		"FR-20R": "Корсика",
+		"FR-21":  "Кот-д’Ор",
+		"FR-22":  "Кот-д’Армор",
+		"FR-23":  "Крёз",
+		"FR-24":  "Дордонь",
+		"FR-25":  "Ду",
+		"FR-26":  "Дром",
+		"FR-27":  "Эр",
+		"FR-28":  "Эр и Луар",
+		"FR-29":  "Финистер",
+		"FR-2A":  "Южная Корсика",
+		"FR-2B":  "Верхняя Корсика",
+		"FR-30":  "Гар",
+		"FR-31":  "Верхняя Гаронна",
+		"FR-32":  "Жер",
+		"FR-33":  "Жиронда",
+		"FR-34":  "Эро",
+		"FR-35":  "Иль и Вилен",
+		"FR-36":  "Эндр",
+		"FR-37":  "Эндр и Луара",
+		"FR-38":  "Изер",
+		"FR-39":  "Юра",
+		"FR-40":  "Ланды",
+		"FR-41":  "Луар и Шер",
+		"FR-42":  "Луара",
+		"FR-43":  "Верхняя Луара",
+		"FR-44":  "Атлантическая Луара",
+		"FR-45":  "Луаре",
+		"FR-46":  "Лот",
+		"FR-47":  "Ло и Гаронна",
+		"FR-48":  "Лозер",
+		"FR-49":  "Мен и Луара",
+		"FR-50":  "Манш",
+		"FR-51":  "Марна",
+		"FR-52":  "Верхняя Марна",
+		"FR-53":  "Майен",
+		"FR-54":  "Мёрт и Мозель",
+		"FR-55":  "Маас",
+		"FR-56":  "Морбиан",
+		"FR-57":  "Мозель",
+		"FR-58":  "Ньевр",
+		"FR-60":  "Уаза",
+		"FR-61":  "Орн",
+		"FR-62":  "Па-де-Кале",
+		"FR-63":  "Пюи-де-Дом",
+		"FR-64":  "Атлантические Пиренеи",
+		"FR-65":  "Верхние Пиренеи",
+		"FR-66":  "Восточные Пиренеи",
+		"FR-67":  "Нижний Рейн",
+		"FR-68":  "Верхний Рейн",
+		"FR-69":  "Рона",
+		"FR-70":  "Верхняя Сона",
+		"FR-71":  "Сона и Луара",
+		"FR-72":  "Сарта",
+		"FR-73":  "Савойя",
+		"FR-74":  "Верхняя Савойя",
+		"FR-75":  "Париж",
+		"FR-76":  "Приморская Сена",
+		"FR-77":  "Сена и Марна",
+		"FR-78":  "Ивелин",
+		"FR-79":  "Дё-Севр",
+		"FR-80":  "Сомма",
+		"FR-81":  "Тарн",
+		"FR-82":  "Тарн и Гаронна",
+		"FR-84":  "Воклюз",
+		"FR-85":  "Вандея",
+		"FR-86":  "Вьенна",
+		"FR-87":  "Верхняя Вьенна",
+		"FR-88":  "Вогезы",
+		"FR-89":  "Йонна",
+		"FR-90":  "Территория Бельфор",
+		"FR-91":  "Эсон",
+		"FR-92":  "О-де-Сен",
+		"FR-93":  "Сен-Сен-Дени",
+		"FR-94":  "Валь-де-Марн",
+		"FR-95":  "Валь-д’Уаз",
+		"FR-971": "Гваделупа",
+		"FR-972": "Мартиника",
+		"FR-974": "Реюньон",
+		"FR-976": "Майотта",
+		"FR-ARA": "Овернь — Рона — Альпы",
+		"FR-BFC": "Бургундия — Франш-Конте",
+		"FR-BRE": "Бретань",
+		"FR-CVL": "Центр — Долина Луары",
+		"FR-GES": "Гранд-Эст",
+		"FR-GP":  "Гваделупа",
+		"FR-HDF": "О-де-Франс",
+		"FR-IDF": "Иль-де-Франс",
+		"FR-MQ":  "Мартиника",
+		"FR-NAQ": "Новая Аквитания",
+		"FR-NC":  "Новая Каледония",
+		"FR-OCC": "Окситания",
+		"FR-PDL": "Пеи-де-ла-Луар",
+		"FR-PF":  "Французская Полинезия",
+		"FR-PM":  "Сен-Пьер и Микелон",
+		"FR-RE":  "Реюньон",
+		"FR-TF":  "Французские Южные и Антарктические территории",
+		"FR-WF":  "Уоллис и Футуна",
+		"FR-YT":  "Майотта",
+		"GA-2":   "Верхнее Огове",
+		"GA-3":   "Среднее Огове",
+		"GA-6":   "Огове-Ивиндо",
+		"GA-7":   "Огове-Лоло",
+		"GA-9":   "Волё-Нтем",
+		"GB-ABD": "Абердиншир",
+		"GB-AGB": "Аргайл-энд-Бьют",
+		"GB-BAS": "Бат и Северо-Восточный Сомерсет",
+		"GB-BBD": "Блэкберн-уит-Даруэн",
+		"GB-BDF": "Бедфорд",
+		"GB-BDG": "Баркинг и Дагенем",
+		"GB-BEN": "Брент",
+		"GB-BFS": "Белфаст",
+		"GB-BGW": "Блайнай-Гуэнт",
+		"GB-BIR": "Бирмингем",
+		"GB-BKM": "Бакингемшир",
+		"GB-BNE": "Барнет",
+		"GB-BNH": "Брайтон-энд-Хов",
+		"GB-BNS": "Барнсли",
+		"GB-BOL": "Болтон",
+		"GB-BPL": "Блэкпул",
+		"GB-BRC": "Бракнелл-Форест",
+		"GB-BRD": "Брадфорд",
+		"GB-BRY": "Бромли",
+		"GB-BUR": "Бэри",
+		"GB-CAM": "Кембриджшир",
+		"GB-CBF": "Центральный Бедфордшир",
+		"GB-CHE": "Восточный Чешир",
+		"GB-CHW": "Западный Чешир и Честер",
+		"GB-CLD": "Колдердейл",
+		"GB-CLK": "Клакманнаншир",
+		"GB-CMD": "Камден",
+		"GB-CON": "Корнуолл",
+		"GB-COV": "Ковентри",
+		"GB-DAL": "Дарлингтон",
+		"GB-DBY": "Дербишир",
+		"GB-DGY": "Дамфрис-энд-Галловей",
+		"GB-DNC": "Донкастер",
+		"GB-DOR": "Дорсет",
+		"GB-EAY": "Ист-Эршир",
+		"GB-EDU": "Ист-Данбартоншир",
+		"GB-ELN": "Ист-Лотиан",
+		"GB-ENF": "Энфилд",
+		"GB-ERW": "Ист-Ренфрушир",
+		"GB-ERY": "Ист-Райдинг-оф-Йоркшир",
+		"GB-ESS": "Эссекс",
+		"GB-ESX": "Восточный Суссекс",
+		"GB-FAL": "Фолкерк",
+		"GB-FIF": "Файф",
+		"GB-GAT": "Гейтсхед",
+		"GB-GLS": "Глостершир",
+		"GB-GWN": "Гуинет",
+		"GB-HAM": "Гэмпшир",
+		"GB-HAV": "Хаверинг",
+		"GB-HEF": "Херефордшир",
+		"GB-HMF": "Хаммерсмит и Фулем",
+		"GB-HPL": "Хартлпул",
+		"GB-HRT": "Хартфордшир",
+		"GB-HRY": "Харинги",
+		"GB-IOS": "Силли",
+		"GB-ISL": "Ислингтон",
+		"GB-IVC": "Инверклайд",
+		"GB-KEN": "Кент",
+		"GB-KHL": "Кингстон-апон-Халл",
+		"GB-KIR": "Керклис",
+		"GB-KTT": "Кингстон-апон-Темс",
+		"GB-LAN": "Ланкашир",
+		"GB-LCE": "Лестер",
+		"GB-LDS": "Лидс",
+		"GB-LEC": "Лестершир",
+		"GB-LIN": "Линкольншир",
+		"GB-LIV": "Ливерпуль",
+		"GB-LUT": "Лутон",
+		"GB-MAN": "Манчестер",
+		"GB-MDB": "Мидлсбро",
+		"GB-MIK": "Милтон-Кинс",
+		"GB-MLN": "Мидлотиан",
+		"GB-MRT": "Мертон",
+		"GB-NAY": "Норт-Эршир",
+		"GB-NBL": "Нортамберленд",
+		"GB-NEL": "Северо-Восточный Линкольншир",
+		"GB-NET": "Ньюкасл-апон-Тайн",
+		"GB-NFK": "Норфолк",
+		"GB-NGM": "Ноттингем",
+		"GB-NLK": "Норт-Ланаркшир",
+		"GB-NLN": "Северный Линкольншир",
+		"GB-NSM": "Северный Сомерсет",
+		"GB-NTH": "Нортгемптоншир",
+		"GB-NTT": "Ноттингемшир",
+		"GB-NTY": "Норт-Тайнсайд",
+		"GB-NWM": "Ньюэм",
+		"GB-NYK": "Норт-Йоркшир",
+		"GB-OLD": "Олдем",
+		"GB-ORK": "Оркнейские острова",
+		"GB-OXF": "Оксфордшир",
+		"GB-PKN": "Перт-энд-Кинросс",
+		"GB-POR": "Портсмут",
+		"GB-POW": "Поуис",
+		"GB-RCC": "Редкар-энд-Кливленд",
+		"GB-RCH": "Рочдейл",
+		"GB-RFW": "Ренфрушир",
+		"GB-RIC": "Ричмонд-апон-Темс",
+		"GB-ROT": "Ротерем",
+		"GB-SAY": "Саут-Эршир",
+		"GB-SCB": "Скоттиш-Бордерс",
+		"GB-SFK": "Суффолк",
+		"GB-SGC": "Южный Глостершир",
+		"GB-SHF": "Шеффилд",
+		"GB-SHR": "Шропшир",
+		"GB-SKP": "Стокпорт",
+		"GB-SLF": "Солфорд",
+		"GB-SLG": "Слау",
+		"GB-SLK": "Саут-Ланаркшир",
+		"GB-SND": "Сандерленд",
+		"GB-SOL": "Солихалл",
+		"GB-SOM": "Сомерсет",
+		"GB-SOS": "Саутенд-он-Си",
+		"GB-SRY": "Суррей",
+		"GB-STE": "Сток-он-Трент",
+		"GB-STH": "Саутгемптон",
+		"GB-STN": "Саттон",
+		"GB-STS": "Стаффордшир",
+		"GB-STT": "Стоктон-он-Тис",
+		"GB-STY": "Саут-Тайнсайд",
+		"GB-SWD": "Суиндон",
+		"GB-TAM": "Теймсайд",
+		"GB-TFW": "Телфорд-энд-Рикин",
+		"GB-THR": "Таррок",
+		"GB-TOB": "Торбей",
+		"GB-TRF": "Траффорд",
+		"GB-TWH": "Тауэр-Хамлетс",
+		"GB-WAR": "Уорикшир",
+		"GB-WFT": "Уолтем-Форест",
+		"GB-WGN": "Уиган",
+		"GB-WIL": "Уилтшир",
+		"GB-WLL": "Уолсолл",
+		"GB-WLN": "Уэст-Лотиан",
+		"GB-WLV": "Вулвергемптон",
+		"GB-WND": "Уондсуэрт",
+		"GB-WNM": "Виндзор и Мейденхед",
+		"GB-WOR": "Вустершир",
+		"GB-WRT": "Уоррингтон",
+		"GB-WSM": "Вестминстер",
+		"GB-WSX": "Западный Суссекс",
+		"GB-YOR": "Йорк",
+		"GB-ZET": "Шетландские острова",
+		"GD-01":  "Сент Эндрю",
+		"GD-03":  "Сент-Джордж",
+		"GD-04":  "Сент-Джон",
+		"GD-10":  "Карриаку и Малый Мартиник",
+		"GE-AB":  "Абхазия",
+		"GE-AJ":  "Аджария",
+		"GE-GU":  "Гурия",
+		"GE-IM":  "Имеретия",
+		"GE-KK":  "Квемо-Картли",
+		"GE-MM":  "Мцхета-Мтианети",
+		"GE-SJ":  "Самцхе-Джавахети",
+		"GE-SK":  "Шида-Картли",
+		"GE-SZ":  "Самегрело-Верхняя Сванетия",
+		"GE-TB":  "Тбилиси",
+		"GH-AA":  "Большая Аккра",
+		"GH-AH":  "Ашанти",
+		"GH-SV":  "Саванна",
+		"GN-C":   "Конакри",
+		"GN-D":   "Киндиа",
+		"GN-DB":  "Дабола",
+		"GN-FR":  "Фрия",
+		"GN-KD":  "Киндиа",
+		"GN-N":   "Нзерекоре",
+		"GN-NZ":  "Нзерекоре",
+		"GN-PI":  "Пита",
+		"GQ-KN":  "Ке-Нтем",
+		"GQ-LI":  "Литораль",
+		"GQ-WN":  "Веле-Нзас",
+		"GR-I":   "Аттика",
+		"GR-J":   "Пелопоннес",
+		"GR-M":   "Крит",
+		"GT-AV":  "Альта-Верапас",
+		"GT-BV":  "Баха-Верапас",
+		"GT-CM":  "Чимальтенанго",
+		"GT-CQ":  "Чикимула",
+		"GT-ES":  "Эскуинтла",
+		"GT-GU":  "Гватемала",
+		"GT-HU":  "Уэуэтенанго",
+		"GT-IZ":  "Исабаль",
+		"GT-JA":  "Халапа-Энрикес",
+		"GT-PE":  "Петен",
+		"GT-PR":  "Эль-Прогресо",
+		"GT-QZ":  "Кесальтенанго",
+		"GT-SM":  "Сан-Маркос",
+		"GT-SR":  "Санта-Роза",
+		"GW-BA":  "Бафата",
+		"GY-BA":  "Барима-Уайни",
+		"GY-CU":  "Куюни-Мазаруни",
+		"GY-DE":  "Демерара-Махайка",
+		"GY-EB":  "Ист-Бербис-Корентайн",
+		"GY-ES":  "Эссекибо-Айлендс-Уэст-Демерара",
+		"GY-MA":  "Махайка-Бербис",
+		"GY-PM":  "Померун-Супенаам",
+		"GY-PT":  "Потаро-Сипаруни",
+		"GY-UD":  "Аппер-Демерара-Бербис",
+		"GY-UT":  "Аппер-Такуту-Аппер-Эссекибо",
+		"HN-CM":  "Комаягуа",
+		"HN-GD":  "Грасьяс-а-Дьос",
+		"HN-IB":  "Ислас-де-ла-Баия",
+		"HN-IN":  "Интибука",
+		"HN-LP":  "Ла-Пас",
+		"HN-OL":  "Оланчо",
+		"HN-SB":  "Санта-Барбара",
+		"HN-VA":  "Валье",
+		"HR-01":  "Загребачка",
+		"HR-02":  "Крапинско-Загорска",
+		"HR-03":  "Сисачко-Мославачка",
+		"HR-04":  "Карловачка",
+		"HR-05":  "Вараждинска",
+		"HR-06":  "Копривничко-Крижевачка",
+		"HR-07":  "Бьеловарско-Билогорска",
+		"HR-08":  "Приморско-Горанска",
+		"HR-09":  "Личко-Сеньска",
+		"HR-10":  "Вировитичко-Подравска",
+		"HR-11":  "Пожешко-Славонска",
+		"HR-12":  "Бродско-Посавска",
+		"HR-13":  "Задарска",
+		"HR-14":  "Осьечко-Бараньска",
+		"HR-15":  "Шибенско-Книнска",
+		"HR-16":  "Вуковарско-Сриемска",
+		"HR-17":  "Сплитско-Далматинска",
+		"HR-18":  "Истарска",
+		"HR-19":  "Дубровачко-Неретванска",
+		"HR-20":  "Меджимурска",
+		"HR-21":  "Загреб",
+		"HT-CE":  "Центральная",
+		"HU-BC":  "Бекешчаба",
+		"HU-BK":  "Бач-Кишкун",
+		"HU-BU":  "Будапешт",
+		"HU-BZ":  "Боршод-Абауй-Земплен",
+		"HU-CS":  "Чонград",
+		"HU-DE":  "Дебрецен",
+		"HU-DU":  "Дунауйварош",
+		"HU-EG":  "Эгер",
+		"HU-ER":  "Эрд",
+		"HU-GS":  "Дьёр-Мошон-Шопрон",
+		"HU-HB":  "Хайду-Бихар",
+		"HU-HV":  "Ходмезёвашархей",
+		"HU-KE":  "Комаром-Эстергом",
+		"HU-SN":  "Шопрон",
+		"HU-SO":  "Шомодь",
+		"HU-SS":  "Сексард",
+		"HU-ST":  "Шальготарьян",
+		"HU-SZ":  "Сабольч-Сатмар-Берег",
+		"HU-TB":  "Татабанья",
+		"HU-ZE":  "Залаэгерсег",
+		"ID-BB":  "Банка-Белитунг",
+		"ID-BT":  "Бантен",
+		"ID-GO":  "Горонтало",
+		"ID-LA":  "Лампунг",
+		"ID-PA":  "Папуа",
+		"ID-PP":  "Папуа",
+		"ID-RI":  "Риау",
+		"ID-SL":  "Сулавеси",
+		"ID-SM":  "Суматра",
+		"ID-YO":  "Джокьякарта",
+		"IE-C":   "Коннахт",
+		"IE-CN":  "Каван",
+		"IE-CO":  "Корк",
+		"IE-CW":  "Карлоу",
+		"IE-D":   "Дублин",
+		"IE-DL":  "Донегол",
+		"IE-G":   "Голуэй",
+		"IE-KE":  "Килдэр",
+		"IE-KK":  "Килкенни",
+		"IE-L":   "Ленстер",
+		"IE-LD":  "Лонгфорд",
+		"IE-LK":  "Лимерик",
+		"IE-LS":  "Лиишь",
+		"IE-M":   "Манстер",
+		"IE-MN":  "Монахан",
+		"IE-MO":  "Майо",
+		"IE-OY":  "Оффали",
+		"IE-RN":  "Роскоммон",
+		"IE-SO":  "Слайго",
+		"IE-TA":  "Типперери",
+		"IE-U":   "Ольстер",
+		"IE-WD":  "Уотерфорд",
+		"IE-WH":  "Уэстмит",
+		"IE-WW":  "Уиклоу",
+		"IE-WX":  "Уэксфорд",
+		"IL-HA":  "Хайфа",
+		"IL-JM":  "Иерусалим",
+		"IL-Z":   "Шарджа",
+		"IN-AN":  "Андаманские и Никобарские острова",
+		"IN-AP":  "Андхра-Прадеш",
+		"IN-AR":  "Аруначал-Прадеш",
+		"IN-AS":  "Ассам",
+		"IN-BR":  "Бихар",
+		"IN-CH":  "Чандпур",
+		"IN-CT":  "Чхаттисгарх",
+		"IN-DL":  "Дели",
+		"IN-GA":  "Гоа",
+		"IN-GJ":  "Гуджарат",
+		"IN-HP":  "Химачал-Прадеш",
+		"IN-HR":  "Харьяна",
+		"IN-JK":  "Джамму и Кашмир",
+		"IN-KA":  "Карнатака",
+		"IN-KL":  "Керала",
+		"IN-LD":  "Лакшадвип",
+		"IN-MH":  "Махараштра",
+		"IN-ML":  "Мегхалая",
+		"IN-MN":  "Манипур",
+		"IN-MP":  "Мадхья-Прадеш",
+		"IN-MZ":  "Мизорам",
+		"IN-NL":  "Нагаленд",
+		"IN-OR":  "Одиша",
+		"IN-PB":  "Пенджаб",
+		"IN-RJ":  "Раджастхан",
+		"IN-SK":  "Сикким",
+		"IN-TG":  "Саманган",
+		"IN-TN":  "Тамилнад",
+		"IN-TR":  "Трипура",
+		"IN-UP":  "Уттар-Прадеш",
+		"IN-WB":  "Западная Бенгалия",
+		"IS-7":   "Эйстюрланд",
+		"IS-8":   "Сюдюрланд",
+		"IS-AKU": "Акюрейри",
+		"IS-ARN": "Аурнесхреппюр",
+		"IS-ASA": "Аусахреппюр",
+		"IS-BLA": "Блаускоугабиггд",
+		"IS-BLO": "Блёндюоус",
+		"IS-BOG": "Боргарбиггд",
+		"IS-BOL": "Болунгарвик",
+		"IS-DAB": "Далабиггд",
+		"IS-DJU": "Дьюпивогюр",
+		"IS-EOM": "Эйя-ог-Миклахольтсхреппюр",
+		"IS-EYF": "Эйяфьярдарсвейт",
+		"IS-FJD": "Фьярдабиггд",
+		"IS-FLR": "Фльоутсдальсхреппюр",
+		"IS-GAR": "Гардабайр",
+		"IS-GRN": "Гриндавик",
+		"IS-GRU": "Грюндарфьордюр",
+		"IS-HAF": "Хабнарфьордюр",
+		"IS-HEL": "Хельгафедльссвейт",
+		"IS-HVA": "Хвальфьярдарсвейт",
+		"IS-HVE": "Хверагерди",
+		"IS-ISA": "Исафьярдарбайр",
+		"IS-KAL": "Кальдрананесхреппюр",
+		"IS-KOP": "Коупавогюр",
+		"IS-LAN": "Ланганесбигд",
+		"IS-MOS": "Мосфедльсбайр",
+		"IS-NOR": "Нордюртинг",
+		"IS-RHH": "Рейкхоулахреппюр",
+		"IS-RKN": "Рейкьянесбайр",
+		"IS-RKV": "Рейкьявик",
+		"IS-SDN": "Сюдюрнесьябайр",
+		"IS-SEL": "Сельтьяднарнес",
+		"IS-SEY": "Сейдисфьордюр",
+		"IS-SKO": "Скоррадальсхреппюр",
+		"IS-SNF": "Снайфедльсбайр",
+		"IS-SSF": "Скагафьордюр",
+		"IS-STY": "Стиккисхоульмюр",
+		"IS-TAL": "Таулькнафьярдархреппюр",
+		"IS-VER": "Вестюрбиггд",
+		"IS-VOP": "Вопнафьордюр",
+		"IT-21":  "Пьемонт",
+		"IT-23":  "Валле-д’Аоста",
+		"IT-25":  "Ломбардия",
+		"IT-32":  "Трентино-Альто-Адидже",
+		"IT-36":  "Фриули-Венеция-Джулия",
+		"IT-42":  "Лигурия",
+		"IT-45":  "Эмилия-Романья",
+		"IT-52":  "Тоскана",
+		"IT-55":  "Умбрия",
+		"IT-57":  "Марке",
+		"IT-62":  "Лацио",
+		"IT-65":  "Абруцци",
+		"IT-67":  "Молизе",
+		"IT-72":  "Кампания",
+		"IT-75":  "Апулия",
+		"IT-77":  "Базиликата",
+		"IT-78":  "Калабрия",
+		"IT-82":  "Сицилия",
+		"IT-88":  "Сардиния",
+		"IT-AG":  "Агридженто",
+		"IT-AL":  "Алессандрия",
+		"IT-AN":  "Анкона",
+		"IT-AP":  "Асколи-Пичено",
+		"IT-AQ":  "Л’Акуила",
+		"IT-AR":  "Ареццо",
+		"IT-AT":  "Асти",
+		"IT-AV":  "Авеллино",
+		"IT-BA":  "Бари",
+		"IT-BG":  "Бергамо",
+		"IT-BI":  "Биелла",
+		"IT-BL":  "Беллуно",
+		"IT-BN":  "Беневенто",
+		"IT-BO":  "Болонья",
+		"IT-BR":  "Бриндизи",
+		"IT-BS":  "Брешиа",
+		"IT-BT":  "Барлетта-Андрия-Трани",
+		"IT-BZ":  "Больцано",
+		"IT-CA":  "Кальяри",
+		"IT-CB":  "Кампобассо",
+		"IT-CE":  "Казерта",
+		"IT-CH":  "Кьети",
+		"IT-CL":  "Кальтаниссетта",
+		"IT-CN":  "Кунео",
+		"IT-CO":  "Комо",
+		"IT-CR":  "Кремона",
+		"IT-CS":  "Козенца",
+		"IT-CT":  "Катания",
+		"IT-CZ":  "Катандзаро",
+		"IT-EN":  "Энна",
+		"IT-FC":  "Форли-Чезена",
+		"IT-FE":  "Феррара",
+		"IT-FG":  "Фоджа",
+		"IT-FI":  "Флоренция",
+		"IT-FM":  "Фермо",
+		"IT-FR":  "Фрозиноне",
+		"IT-GE":  "Генуя",
+		"IT-GO":  "Гориция",
+		"IT-GR":  "Гроссето",
+		"IT-IS":  "Изерния",
+		"IT-KR":  "Кротоне",
+		"IT-LC":  "Лекко",
+		"IT-LE":  "Лечче",
+		"IT-LI":  "Ливорно",
+		"IT-LU":  "Лукка",
+		"IT-MB":  "Монца-э-Брианца",
+		"IT-MC":  "Мачерата",
+		"IT-ME":  "Мессина",
+		"IT-MI":  "Милан",
+		"IT-MN":  "Мантуя",
+		"IT-MO":  "Модена",
+		"IT-MS":  "Масса-Каррара",
+		"IT-MT":  "Матера",
+		"IT-NA":  "Неаполь",
+		"IT-NO":  "Новара",
+		"IT-NU":  "Нуоро",
+		"IT-OR":  "Ористано",
+		"IT-PA":  "Палермо",
+		"IT-PC":  "Пьяченца",
+		"IT-PD":  "Падуя",
+		"IT-PE":  "Пескара",
+		"IT-PG":  "Перуджа",
+		"IT-PI":  "Пиза",
+		"IT-PN":  "Порденоне",
+		"IT-PO":  "Прато",
+		"IT-PR":  "Парма",
+		"IT-PT":  "Пистоя",
+		"IT-PU":  "Пезаро-э-Урбино",
+		"IT-PV":  "Павия",
+		"IT-PZ":  "Потенца",
+		"IT-RA":  "Равенна",
+		"IT-RC":  "Реджо-ди-Калабрия",
+		"IT-RE":  "Реджо-нель-Эмилия",
+		"IT-RG":  "Рагуза",
+		"IT-RI":  "Риети",
+		"IT-RN":  "Римини",
+		"IT-RO":  "Ровиго",
+		"IT-SA":  "Салерно",
+		"IT-SI":  "Сиена",
+		"IT-SO":  "Сондрио",
+		"IT-SS":  "Сассари",
+		"IT-SU":  "Южная Сардиния",
+		"IT-SV":  "Савона",
+		"IT-TA":  "Таранто",
+		"IT-TE":  "Терамо",
+		"IT-TN":  "Тренто",
+		"IT-TO":  "Турин",
+		"IT-TP":  "Трапани",
+		"IT-TR":  "Терни",
+		"IT-TS":  "Триест",
+		"IT-TV":  "Тревизо",
+		"IT-UD":  "Удине",
+		"IT-VA":  "Варесе",
+		"IT-VB":  "Вербано-Кузио-Оссола",
+		"IT-VC":  "Верчелли",
+		"IT-VE":  "Венеция",
+		"IT-VI":  "Виченца",
+		"IT-VR":  "Верона",
+		"IT-VT":  "Витербо",
+		"IT-VV":  "Вибо-Валентия",
+		"JM-01":  "Кингстон",
+		"JM-02":  "Сент Эндрю",
+		"JM-03":  "Сент Томас",
+		"JM-04":  "Портленд",
+		"JM-05":  "Сент-Мэри",
+		"JM-08":  "Сент Джеймс",
+		"JM-09":  "Ганновер",
+		"JM-10":  "Уэстморленд",
+		"JM-12":  "Манчестер",
+		"JM-13":  "Кларендон",
+		"JP-01":  "Хоккайдо",
+		"JP-02":  "Аомори",
+		"JP-03":  "Ивате",
+		"JP-04":  "Мияги",
+		"JP-05":  "Акита",
+		"JP-07":  "Фукусима",
+		"JP-09":  "Тотиги",
+		"JP-11":  "Сайтама",
+		"JP-12":  "Тиба",
+		"JP-13":  "Токио",
+		"JP-14":  "Канагава",
+		"JP-15":  "Ниигата",
+		"JP-16":  "Тояма",
+		"JP-18":  "Фукуи",
+		"JP-19":  "Яманаси",
+		"JP-22":  "Сидзуока",
+		"JP-25":  "Сига",
+		"JP-26":  "Киото",
+		"JP-27":  "Осака",
+		"JP-28":  "Хиого",
+		"JP-29":  "Нара",
+		"JP-30":  "Вакаяма",
+		"JP-31":  "Тоттори",
+		"JP-32":  "Симане",
+		"JP-33":  "Окаяма",
+		"JP-34":  "Хиросима",
+		"JP-35":  "Ямагути",
+		"JP-36":  "Токусима",
+		"JP-37":  "Кагава",
+		"JP-38":  "Эхиме",
+		"JP-40":  "Фукуока",
+		"JP-42":  "Нагасаки",
+		"JP-43":  "Кумамото",
+		"JP-44":  "Оита",
+		"JP-45":  "Миядзаки",
+		"JP-46":  "Кагосима",
+		"JP-47":  "Окинава",
+		"KN-01":  "Крайст-Черч-Никола-Таун",
+		"KN-02":  "Сент-Энн-Сэнди-Пойнт",
+		"KN-03":  "Сент-Джордж-Бастер",
+		"KN-04":  "Сент-Джордж-Джинджерланд",
+		"KN-05":  "Сент-Джеймс-Уиндуорд",
+		"KN-06":  "Сент-Джон-Капистер",
+		"KN-07":  "Сент-Джон-Фигтри",
+		"KN-08":  "Сент-Мери-Кайон",
+		"KN-10":  "Сент-Пол-Чарлстаун",
+		"KN-11":  "Сент-Питер-Бастер",
+		"KN-12":  "Сент-Томас-Лоуленд",
+		"KN-13":  "Сент-Томас-Мидл-Айленд",
+		"KN-15":  "Тринити-Палметто-Пойнт",
+		"KN-K":   "Сент-Китс",
+		"KN-N":   "Невис",
+		"KP-06":  "Хванхэ-Пукто",
+		"KP-10":  "Янгандо",
+		"KP-13":  "Расон",
+		"KP-14":  "Нампхо",
+		"KR-11":  "Сеул",
+		"KZ-AKM": "Акмолинская область",
+		"KZ-AKT": "Актюбинская область",
+		"KZ-ALA": "Алма-Ата",
+		"KZ-ALM": "Алматинская область",
+		"KZ-AST": "Нур-Султан",
+		"KZ-ATY": "Атырауская область",
+		"KZ-KAR": "Карагандинская область",
+		"KZ-KUS": "Костанайская область",
+		"KZ-KZY": "Кызылординская область",
+		"KZ-MAN": "Мангистауская область",
+		"KZ-PAV": "Павлодарская область",
+		"KZ-SEV": "Северо-Казахстанская область",
+		"KZ-SHY": "Шымкент",
+		"KZ-VOS": "Восточно-Казахстанская область",
+		"KZ-ZAP": "Западно-Казахстанская область",
+		"KZ-ZHA": "Жамбылская область",
+		"LA-BK":  "Бокэу",
+		"LA-BL":  "Боликхамсай",
+		"LK-11":  "Коломбо",
+		"LR-CM":  "Гранд-Кейп-Маунт",
+		"LR-GB":  "Гранд-Басса",
+		"LR-GG":  "Гранд-Джиде",
+		"LR-GK":  "Гранд-Кру",
+		"LS-D":   "Берея",
+		"LS-E":   "Мафетенг",
+		"LS-F":   "Мохалес-Хук",
+		"LS-G":   "Цгутхинг",
+		"LS-H":   "Цгачас-Нек",
+		"LS-J":   "Мокхотлонг",
+		"LS-K":   "Тхаба-Цека",
+		"LT-01":  "Акмяне",
+		"LT-33":  "Паневежис",
+		"LT-34":  "Пасвалис",
+		"LT-35":  "Плунге",
+		"LT-36":  "Пренай",
+		"LT-37":  "Радвилишкис",
+		"LT-38":  "Расейняй",
+		"LT-40":  "Рокишкис",
+		"LT-41":  "Шакяй",
+		"LT-42":  "Шальчининкай",
+		"LT-44":  "Шяуляй",
+		"LT-45":  "Шилале",
+		"LT-46":  "Шилуте",
+		"LT-47":  "Ширвинтос",
+		"LT-48":  "Скуодас",
+		"LT-49":  "Швенчёнис",
+		"LT-50":  "Таураге",
+		"LT-51":  "Тельшяй",
+		"LT-52":  "Тракай",
+		"LT-53":  "Укмерге",
+		"LT-54":  "Утена",
+		"LT-55":  "Варена",
+		"LT-56":  "Вилкавишкис",
+		"LT-58":  "Вильнюс",
+		"LT-59":  "Висагинас",
+		"LT-60":  "Зарасай",
+		"LU-LU":  "Люксембург",
+		"LV-001": "Аглонский край",
+		"LV-002": "Айзкраукльский край",
+		"LV-003": "Айзпутский край",
+		"LV-004": "Акнистский край",
+		"LV-005": "Алойский край",
+		"LV-006": "Алсунгский край",
+		"LV-007": "Алуксненский край",
+		"LV-008": "Аматский край",
+		"LV-009": "Апский край",
+		"LV-010": "Ауцский край",
+		"LV-011": "Адажский край",
+		"LV-012": "Бабитский край",
+		"LV-013": "Балдонский край",
+		"LV-014": "Балтинавский край",
+		"LV-015": "Балвский край",
+		"LV-016": "Бауский край",
+		"LV-017": "Беверинский край",
+		"LV-018": "Броценский край",
+		"LV-019": "Буртниекский край",
+		"LV-020": "Царникавский край",
+		"LV-021": "Цесвайнский край",
+		"LV-022": "Цесисский край",
+		"LV-023": "Циблский край",
+		"LV-024": "Дагдский край",
+		"LV-025": "Даугавпилсский край",
+		"LV-026": "Добельский край",
+		"LV-027": "Дундагский край",
+		"LV-028": "Дурбский край",
+		"LV-029": "Энгурский край",
+		"LV-030": "Эргльский край",
+		"LV-031": "Гаркалнский край",
+		"LV-032": "Гробинский край",
+		"LV-033": "Гулбенский край",
+		"LV-034": "Иецавский край",
+		"LV-035": "Икшкильский край",
+		"LV-036": "Илукстский край",
+		"LV-037": "Инчукалнский край",
+		"LV-038": "Яунелгавский край",
+		"LV-039": "Яунпиебалгский край",
+		"LV-040": "Яунпилсский край",
+		"LV-041": "Елгавский край",
+		"LV-042": "Екабпилсский край",
+		"LV-043": "Кандавский край",
+		"LV-044": "Карсавский край",
+		"LV-045": "Коценский край",
+		"LV-046": "Кокнесский край",
+		"LV-047": "Краславский край",
+		"LV-048": "Кримулдский край",
+		"LV-049": "Крустпилсский край",
+		"LV-050": "Кулдигский край",
+		"LV-051": "Кегумский край",
+		"LV-052": "Кекавский край",
+		"LV-053": "Лиелвардский край",
+		"LV-054": "Лимбажский край",
+		"LV-055": "Лигатненский край",
+		"LV-056": "Ливанский край",
+		"LV-057": "Лубанский край",
+		"LV-058": "Лудзенский край",
+		"LV-059": "Мадонский край",
+		"LV-060": "Мазсалацский край",
+		"LV-061": "Малпилсский край",
+		"LV-062": "Марупский край",
+		"LV-063": "Мерсрагский край",
+		"LV-064": "Наукшенский край",
+		"LV-065": "Неретский край",
+		"LV-066": "Ницский край",
+		"LV-067": "Огрский край",
+		"LV-068": "Олайнский край",
+		"LV-069": "Озолниекский край",
+		"LV-070": "Паргауйский край",
+		"LV-071": "Павилостский край",
+		"LV-072": "Плявинский край",
+		"LV-073": "Прейльский край",
+		"LV-076": "Раунский край",
+		"LV-077": "Резекненский край",
+		"LV-078": "Риебинский край",
+		"LV-079": "Ройский край",
+		"LV-080": "Ропажский край",
+		"LV-081": "Руцавский край",
+		"LV-082": "Ругайский край",
+		"LV-083": "Рундальский край",
+		"LV-084": "Руйиенский край",
+		"LV-085": "Салский край",
+		"LV-086": "Салацгривский край",
+		"LV-087": "Саласпилсский край",
+		"LV-088": "Салдусский край",
+		"LV-089": "Саулкрастский край",
+		"LV-090": "Сейский край",
+		"LV-091": "Сигулдский край",
+		"LV-092": "Скриверский край",
+		"LV-093": "Скрундский край",
+		"LV-094": "Смилтенский край",
+		"LV-095": "Стопинский край",
+		"LV-096": "Стренчский край",
+		"LV-097": "Талсинский край",
+		"LV-098": "Терветский край",
+		"LV-099": "Тукумский край",
+		"LV-100": "Вайнёдский край",
+		"LV-101": "Валкский край",
+		"LV-102": "Вараклянский край",
+		"LV-103": "Варкавский край",
+		"LV-104": "Вецпиебалгский край",
+		"LV-105": "Вецумниекский край",
+		"LV-106": "Вентспилсский край",
+		"LV-107": "Виеситский край",
+		"LV-108": "Вилякский край",
+		"LV-109": "Вилянский край",
+		"LV-110": "Зилупский край",
+		"LV-DGV": "Даугавпилс",
+		"LV-JEL": "Елгава",
+		"LV-JKB": "Екабпилс",
+		"LV-JUR": "Юрмала",
+		"LV-LPX": "Лиепая",
+		"LV-REZ": "Резекне",
+		"LV-RIX": "Рига",
+		"LV-VEN": "Вентспилс",
+		"LV-VMR": "Валмиера",
+		"LY-BA":  "Бенгази",
+		"LY-BU":  "Эль-Бутнан",
+		"LY-JA":  "Эль-Джебель-эль-Ахдар",
+		"LY-KF":  "Эль-Куфра",
+		"LY-MI":  "Мисурата",
+		"LY-MQ":  "Марзук",
+		"LY-NL":  "Налут",
+		"LY-TB":  "Триполи",
+		"LY-WA":  "Эль-Вахат",
+		"LY-WD":  "Вади-эль-Хаят",
+		"MA-BRR": "Беррешид",
+		"MA-CAS": "Касабланка",
+		"MA-CHE": "Шавен",
+		"MA-ERR": "Эр-Рашидия",
+		"MA-ESI": "Эс-Сувейра",
+		"MA-FES": "Фес",
+		"MA-FQH": "Фких-Бен-Салах",
+		"MA-GUE": "Гулимин",
+		"MA-GUF": "Герсиф",
+		"MA-HAO": "Эль-Хауз",
+		"MA-HOC": "Эль-Хосейма",
+		"MA-KEN": "Кенитра",
+		"MA-KHE": "Хемиссет",
+		"MA-KHN": "Хенифра",
+		"MA-KHO": "Хурибга",
+		"MA-LAR": "Лараш",
+		"MA-MAR": "Марракеш",
+		"MA-MEK": "Мекнес",
+		"MA-MID": "Мидельт",
+		"MA-NAD": "Надор",
+		"MA-RAB": "Рабат",
+		"MA-SAF": "Сафи",
+		"MA-SAL": "Сале",
+		"MA-SEF": "Сефру",
+		"MA-SET": "Сеттат",
+		"MA-SIB": "Сиди-Беннур",
+		"MA-SIF": "Сиди-Ифни",
+		"MA-SIK": "Сиди-Касем",
+		"MA-TAR": "Тарудант",
+		"MA-TAZ": "Таза",
+		"MA-TET": "Тетуан",
+		"MA-TIN": "Тингир",
+		"MA-TIZ": "Тизнит",
+		"MC-MC":  "Монте-Карло",
+		"MC-MG":  "Монегетти",
+		"MC-MO":  "Монако",
+		"MC-MU":  "Мулен",
+		"MR-07":  "Адрар",
+		"MT-47":  "Сафи",
+		"MT-49":  "Сент-Джон",
+		"NG-PL":  "Плато",
+		"NI-GR":  "Гранада",
+		"NI-LE":  "Леон",
+		"NL-BQ1": "Бонайре",
+		"NL-BQ2": "Саба",
+		"NL-BQ3": "Синт-Эстатиус",
+		"NL-LI":  "Лимбург",
+		"NZ-CAN": "Кентербери",
+		"OM-ZA":  "Эз-Захира",
+		"PA-1":   "Бокас-дель-Торо",
+		"PA-4":   "Чирики",
+		"PA-5":   "Дарьен",
+		"PA-6":   "Эррера",
+		"PA-7":   "Лос-Сантос",
+		"PA-8":   "Панама",
+		"PA-9":   "Верагуас",
+		"PA-KY":  "Гуна-Яла",
+		"PA-NB":  "Нгобе-Бугле",
+		"PE-ARE": "Арекипа",
+		"PE-AYA": "Аякучо",
+		"PE-CAJ": "Кахамарка",
+		"PE-LAL": "Ла-Либертад",
+		"PE-LAM": "Ламбаеке",
+		"PE-LIM": "Лима",
+		"PE-LOR": "Лорето",
+		"PE-MDD": "Мадре-де-Дьос",
+		"PE-PAS": "Паско",
+		"PE-PIU": "Пьюра",
+		"PE-PUN": "Пуно",
+		"PE-TAC": "Такна",
+		"PE-UCA": "Укаяли",
+		"PK-PB":  "Пенджаб",
+		"PL-02":  "Нижнесилезское воеводство",
+		"PL-04":  "Куявско-Поморское воеводство",
+		"PL-06":  "Люблинское воеводство",
+		"PL-08":  "Любушское воеводство",
+		"PL-10":  "Лодзинское воеводство",
+		"PL-12":  "Малопольское воеводство",
+		"PL-14":  "Мазовецкое воеводство",
+		"PL-16":  "Опольское воеводство",
+		"PL-18":  "Подкарпатское воеводство",
+		"PL-20":  "Подляское воеводство",
+		"PL-22":  "Поморское воеводство",
+		"PL-24":  "Силезское воеводство",
+		"PL-26":  "Свентокшиское воеводство",
+		"PL-28":  "Варминьско-Мазурское воеводство",
+		"PL-30":  "Великопольское воеводство",
+		"PL-32":  "Западно-Поморское воеводство",
+		"PS-BTH": "Вифлеем",
+		"PS-HBN": "Хеврон",
+		"PS-JEM": "Иерусалим",
+		"PS-JEN": "Дженин",
+		"PS-RBH": "Рамалла",
+		"PT-11":  "Лиссабон",
+		"PT-13":  "Порту",
+		"PT-14":  "Сантарен",
+		"PT-15":  "Сетубал",
+		"PT-16":  "Виана-ду-Каштелу",
+		"PT-17":  "Вила-Реал",
+		"PY-1":   "Консепсьон",
+		"PY-10":  "Альто-Парана",
+		"PY-12":  "Ньеэмбуку",
+		"PY-13":  "Амамбай",
+		"PY-14":  "Канендию",
+		"PY-15":  "Пресиденте-Аес",
+		"PY-16":  "Альто-Парагвай",
+		"PY-2":   "Сан-Педро",
+		"PY-7":   "Итапуа",
+		"PY-8":   "Мисьонес",
+		"PY-9":   "Парагуари",
+		"PY-ASU": "Асунсьон",
+		"RS-00":  "Белград",
+		"RS-01":  "Северно-Бачский округ",
+		"RS-02":  "Средне-Банатский округ",
+		"RS-03":  "Северно-Банатский округ",
+		"RS-04":  "Южно-Банатский округ",
+		"RS-05":  "Западно-Бачский округ",
+		"RS-06":  "Южно-Бачский округ",
+		"RS-07":  "Сремский округ",
+		"RS-08":  "Мачванский округ",
+		"RS-09":  "Колубарский округ",
+		"RS-10":  "Подунайский округ",
+		"RS-11":  "Браничевский округ",
+		"RS-12":  "Шумадийский округ",
+		"RS-13":  "Поморавский округ",
+		"RS-14":  "Борский округ",
+		"RS-15":  "Заечарский округ",
+		"RS-16":  "Златиборский округ",
+		"RS-17":  "Моравичский округ",
+		"RS-18":  "Рашский округ",
+		"RS-19":  "Расинский округ",
+		"RS-20":  "Нишавский округ",
+		"RS-21":  "Топличский округ",
+		"RS-22":  "Пиротский округ",
+		"RS-23":  "Ябланичский округ",
+		"RS-24":  "Пчиньский округ",
+		"RS-25":  "Косовский округ",
+		"RS-26":  "Печский округ",
+		"RS-27":  "Призренский округ",
+		"RS-VO":  "Воеводина",
+		"RU-AD":  "Республика Адыгея",
+		"RU-AL":  "Республика Алтай",
+		"RU-ALT": "Алтайский край",
+		"RU-AMU": "Амурская область",
+		"RU-ARK": "Архангельская область",
+		"RU-AST": "Астраханская область",
+		"RU-BA":  "Республика Башкортостан",
+		"RU-BEL": "Белгородская область",
+		"RU-BRY": "Брянская область",
+		"RU-BU":  "Республика Бурятия",
+		"RU-CE":  "Чеченская республика",
+		"RU-CHE": "Челябинская область",
+		"RU-CHU": "Чукотский автономный округ",
+		"RU-CU":  "Чувашская республика",
+		"RU-DA":  "Республика Дагестан",
+		"RU-IN":  "Республика Ингушетия",
+		"RU-IRK": "Иркутская область",
+		"RU-IVA": "Ивановская область",
+		"RU-KAM": "Камчатский край",
+		"RU-KB":  "Кабардино-Балкарская республика",
+		"RU-KC":  "Карачаево-Черкесская республика",
+		"RU-KDA": "Краснодарский край",
+		"RU-KEM": "Кемеровская область",
+		"RU-KGD": "Калининградская область",
+		"RU-KGN": "Курганская область",
+		"RU-KHA": "Хабаровский край",
+		"RU-KHM": "Ханты-Мансийский автономный округ",
+		"RU-KIR": "Кировская область",
+		"RU-KK":  "Республика Хакассия",
+		"RU-KL":  "Республика Калмыкия",
+		"RU-KLU": "Калужская область",
+		"RU-KO":  "Республика Коми",
+		"RU-KOS": "Костромская область",
+		"RU-KR":  "Республика Карелия",
+		"RU-KRS": "Курская область",
+		"RU-KYA": "Красноярский край",
+		"RU-LEN": "Ленинградская область",
+		"RU-LIP": "Липецкая область",
+		"RU-MAG": "Магаданская область",
+		"RU-ME":  "Республика Марий Эл",
+		"RU-MO":  "Республика Мордовия",
+		"RU-MOS": "Московская область",
+		"RU-MOW": "Москва",
+		"RU-MUR": "Мурманская область",
+		"RU-NEN": "Ненецкий автономный округ",
+		"RU-NGR": "Новгородская область",
+		"RU-NIZ": "Нижегородская область",
+		"RU-NVS": "Новосибирская область",
+		"RU-OMS": "Омская область",
+		"RU-ORE": "Оренбургская область",
+		"RU-ORL": "Орловская область",
+		"RU-PER": "Пермский край",
+		"RU-PNZ": "Пензенская область",
+		"RU-PRI": "Приморский край",
+		"RU-PSK": "Псковская область",
+		"RU-ROS": "Ростовская область",
+		"RU-RYA": "Рязанская область",
+		"RU-SA":  "Республика Саха",
+		"RU-SAK": "Сахалинская область",
+		"RU-SAM": "Самарская область",
+		"RU-SAR": "Саратовская область",
+		"RU-SE":  "Республика Северная Осетия",
+		"RU-SMO": "Смоленская область",
+		"RU-SPE": "Санкт-Петербург",
+		"RU-STA": "Ставропольский край",
+		"RU-SVE": "Свердловская область",
+		"RU-TA":  "Республика Татарстан",
+		"RU-TAM": "Тамбовская область",
+		"RU-TOM": "Томская область",
+		"RU-TUL": "Тульская область",
+		"RU-TVE": "Тверская область",
+		"RU-TY":  "Республика Тува",
+		"RU-TYU": "Тюменская область",
+		"RU-UD":  "Республика Удмуртия",
+		"RU-ULY": "Ульяновская область",
+		"RU-VGG": "Волгоградская область",
+		"RU-VLA": "Владимирская область",
+		"RU-VLG": "Вологодская область",
+		"RU-VOR": "Воронежская область",
+		"RU-YAN": "Ямало-Ненецкий автономный округ",
+		"RU-YAR": "Ярославская область",
+		"RU-YEV": "Еврейская автономная область",
+		"RU-ZAB": "Забайкальский край",
+		"SA-01":  "Эр-Рияд",
+		"SA-04":  "Эш-Шаркия",
+		"SA-05":  "Эль-Касим",
+		"SA-08":  "Эль-Худуд-эш-Шамалия",
+		"SA-10":  "Наджран",
+		"SB-GU":  "Гуадалканал",
+		"SB-IS":  "Изабелла",
+		"SB-MK":  "Макира-Улава",
+		"SB-RB":  "Реннелл и Беллона",
+		"SO-BR":  "Бари",
+		"SS-BN":  "Северный Бахр-эль-Газаль",
+		"SS-BW":  "Западный Бахр-эль-Газаль",
+		"SV-LI":  "Ла-Либертад",
+		"SV-PA":  "Ла-Пас",
+		"SV-SS":  "Сан-Сальвадор",
+		"TD-LC":  "Эль-Фуджайра",
+		"TW-TNN": "Тайнань",
+		"TW-TPE": "Тайбэй",
+		"TW-TXG": "Тайчжун",
+		"UA-05":  "Винницкая область",
+		"UA-07":  "Волынская область",
+		"UA-09":  "Луганская область",
+		"UA-12":  "Днепропетровская область",
+		"UA-14":  "Донецкая область",
+		"UA-18":  "Житомирская область",
+		"UA-21":  "Закарпатская область",
+		"UA-23":  "Запорожская область",
+		"UA-26":  "Ивано-Франковская область",
+		"UA-30":  "Киев",
+		"UA-32":  "Киевская область",
+		"UA-35":  "Кировоградская область",
+		"UA-40":  "Севастополь",
+		"UA-43":  "Автономная Республика Крым",
+		"UA-46":  "Львовская область",
+		"UA-51":  "Одесская область",
+		"UA-53":  "Полтавская область",
+		"UA-56":  "Ровненская область",
+		"UA-59":  "Сумская область",
+		"UA-61":  "Тернопольская область",
+		"UA-63":  "Харьковская область",
+		"UA-65":  "Херсонская область",
+		"UA-68":  "Хмельницкая область",
+		"UA-71":  "Черкасская область",
+		"UA-74":  "Черниговская область",
+		"UA-77":  "Черновицкая область",
+		"US-AK":  "Аляска",
+		"US-AL":  "Алабама",
+		"US-AR":  "Арканзас",
+		"US-AS":  "Американское Самоа",
+		"US-AZ":  "Аризона",
+		"US-CA":  "Калифорния",
+		"US-CO":  "Колорадо",
+		"US-CT":  "Коннектикут",
+		"US-DC":  "Вашингтон",
+		"US-DE":  "Делавэр",
+		"US-FL":  "Флорида",
+		"US-GA":  "Джорджия",
+		"US-GU":  "Гуам",
+		"US-HI":  "Гавайи",
+		"US-IA":  "Айова",
+		"US-ID":  "Айдахо",
+		"US-IL":  "Иллинойс",
+		"US-IN":  "Индиана",
+		"US-KS":  "Канзас",
+		"US-KY":  "Кентукки",
+		"US-LA":  "Луизиана",
+		"US-MA":  "Массачусетс",
+		"US-ME":  "Мэн",
+		"US-MI":  "Мичиган",
+		"US-MN":  "Миннесота",
+		"US-MO":  "Миссури",
+		"US-MP":  "Северные Марианские Острова",
+		"US-MS":  "Миссисипи",
+		"US-MT":  "Монтана",
+		"US-NC":  "Северная Каролина",
+		"US-ND":  "Северная Дакота",
+		"US-NE":  "Небраска",
+		"US-NH":  "Нью-Гэмпшир",
+		"US-NJ":  "Нью-Джерси",
+		"US-NM":  "Нью-Мексико",
+		"US-NV":  "Невада",
+		"US-NY":  "Нью-Йорк",
+		"US-OH":  "Огайо",
+		"US-OK":  "Оклахома",
+		"US-OR":  "Орегон",
+		"US-PA":  "Пенсильвания",
+		"US-PR":  "Пуэрто-Рико",
+		"US-RI":  "Род-Айленд",
+		"US-SC":  "Южная Каролина",
+		"US-SD":  "Южная Дакота",
+		"US-TN":  "Теннесси",
+		"US-TX":  "Техас",
+		"US-UM":  "Внешние малые острова США",
+		"US-UT":  "Юта",
+		"US-VA":  "Вирджиния",
+		"US-VI":  "Виргинские Острова",
+		"US-VT":  "Вермонт",
+		"US-WA":  "Вашингтон",
+		"US-WI":  "Висконсин",
+		"US-WY":  "Вайоминг",
+		"UY-FD":  "Флорида",
+		"UY-RN":  "Рио-Негро",
+		"UZ-QR":  "Каракалпакстан",
+		"UZ-SA":  "Самарканд",
+		"UZ-TK":  "Ташкент",
+		"UZ-TO":  "Ташкент",
+		"UZ-XO":  "Хорезм",
+		"VC-02":  "Сент Эндрю",
+		"VC-04":  "Сент-Джордж",
+		"VN-DN":  "Дананг",
+		"VN-HN":  "Ханой",
+		"ZW-MS":  "Южный Матабелеленд",
+		"ZW-MV":  "Масвинго",
+		"ZW-MW":  "Западный Машоналенд",
+	},
+	"zh-CN": {
+		"AD-02":  "卡尼略",
+		"AD-03":  "恩坎普",
+		"AD-04":  "马萨纳",
+		"AD-05":  "奥尔迪诺",
+		"AD-06":  "圣胡利娅-德洛里亚",
+		"AD-07":  "安道尔城",
+		"AD-08":  "莱塞斯卡尔德-恩戈尔达",
+		"AE-AJ":  "阿吉曼",
+		"AE-AZ":  "阿布扎比",
+		"AE-DU":  "迪拜",
+		"AE-FU":  "富吉拉",
+		"AE-RK":  "拉斯海玛",
+		"AE-SH":  "夏尔迦",
+		"AE-UQ":  "欧姆古温",
+		"AF-BAL": "巴尔赫省",
+		"AF-BAM": "巴米扬省",
+		"AF-BDG": "巴德吉斯省",
+		"AF-BDS": "巴达赫尚省",
+		"AF-BGL": "巴格兰省",
+		"AF-DAY": "代孔迪省",
+		"AF-FRA": "法拉省",
+		"AF-FYB": "法利亚布省",
+		"AF-GHA": "加兹尼省",
+		"AF-GHO": "古尔省",
+		"AF-HEL": "赫尔曼德省",
+		"AF-HER": "赫拉特省",
+		"AF-JOW": "朱兹詹省",
+		"AF-KAB": "喀布尔省",
+		"AF-KAN": "坎大哈省",
+		"AF-KAP": "卡比萨省",
+		"AF-KDZ": "昆都士省",
+		"AF-KHO": "霍斯特省",
+		"AF-KNR": "库纳尔",
+		"AF-LAG": "拉格曼省",
+		"AF-LOG": "洛加尔省",
+		"AF-NAN": "楠格哈尔省",
+		"AF-NIM": "尼姆鲁兹省",
+		"AF-NUR": "努尔斯坦省",
+		"AF-PAN": "潘杰希尔省",
+		"AF-PAR": "帕尔旺省",
+		"AF-PIA": "帕克蒂亚省",
+		"AF-PKA": "帕克蒂卡省",
+		"AF-SAM": "萨曼甘省",
+		"AF-SAR": "萨尔普勒省",
+		"AF-TAK": "塔哈尔省",
+		"AF-URU": "乌鲁兹甘省",
+		"AF-WAR": "瓦尔达克省",
+		"AF-ZAB": "扎布尔省",
+		"AG-03":  "圣乔治",
+		"AG-04":  "圣约翰",
+		"AG-05":  "圣玛丽",
+		"AG-06":  "圣保罗",
+		"AG-07":  "圣彼得",
+		"AG-08":  "圣飞利浦",
+		"AG-10":  "巴布达",
+		"AG-11":  "雷东达岛",
+		"AL-01":  "培拉特区",
+		"AL-02":  "都拉斯区",
+		"AL-03":  "爱尔巴桑区",
+		"AL-04":  "非夏尔区",
+		"AL-05":  "吉罗卡斯特区",
+		"AL-07":  "库克斯区",
+		"AL-08":  "莱什区",
+		"AL-09":  "迪勃拉区",
+		"AL-10":  "斯库台区",
+		"AL-11":  "地拉那区",
+		"AL-12":  "发罗拉区",
+		"AM-AR":  "阿拉拉特省",
+		"AM-AV":  "阿尔马维尔省",
+		"AM-ER":  "埃里温",
+		"AM-KT":  "科泰克省",
+		"AM-LO":  "洛里省",
+		"AM-SU":  "休尼克省",
+		"AO-BGO": "本哥省",
+		"AO-BGU": "本吉拉省",
+		"AO-BIE": "比耶省",
+		"AO-CAB": "喀丙达省",
+		"AO-CNN": "库内纳省",
+		"AO-HUA": "世博省",
+		"AO-HUI": "威拉省",
+		"AO-LUA": "罗安达省",
+		"AO-MAL": "马兰哲省",
+		"AO-MOX": "莫希科省",
+		"AO-NAM": "纳米贝省",
+		"AO-UIG": "威热省",
+		"AO-ZAI": "扎伊尔省",
+		"AR-A":   "萨尔塔省",
+		"AR-B":   "布宜诺斯艾利斯省",
+		"AR-D":   "圣路易斯省",
+		"AR-F":   "拉里奥哈",
+		"AR-G":   "圣地亚哥-德尔埃斯特罗省",
+		"AR-H":   "查科省",
+		"AR-J":   "圣胡安省",
+		"AR-K":   "卡塔马卡省",
+		"AR-L":   "拉潘帕省",
+		"AR-M":   "门多萨省",
+		"AR-N":   "米西奥内斯省",
+		"AR-P":   "福尔摩沙省",
+		"AR-S":   "圣菲省",
+		"AR-U":   "丘布特省",
+		"AR-V":   "火地岛省",
+		"AR-W":   "科连特斯省",
+		"AR-X":   "科尔多瓦省",
+		"AR-Y":   "胡胡伊省",
+		"AR-Z":   "圣克鲁斯省",
+		"AT-1":   "布尔根兰州",
+		"AT-2":   "凯恩藤州",
+		"AT-3":   "下奥地利州",
+		"AT-4":   "上奥地利州",
+		"AT-5":   "萨尔茨堡州",
+		"AT-6":   "施泰尔马克州",
+		"AT-7":   "蒂罗尔州",
+		"AT-8":   "福拉尔贝格州",
+		"AT-9":   "维也纳州",
+		"AU-ACT": "澳大利亚首都特区",
+		"AU-NSW": "新南威尔士",
+		"AU-NT":  "北领地",
+		"AU-QLD": "昆士兰州",
+		"AU-SA":  "南澳大利亚州",
+		"AU-TAS": "塔斯曼尼亚",
+		"AU-VIC": "维多利亚州",
+		"AU-WA":  "西澳大利亚州",
+		"AZ-ABS": "阿布歇隆区",
+		"AZ-AGA": "阿克斯塔法区",
+		"AZ-AGC": "阿格贾贝迪区",
+		"AZ-AGM": "阿格达姆区",
+		"AZ-AGS": "阿格达什区",
+		"AZ-AGU": "阿赫苏区",
+		"AZ-AST": "阿斯塔拉区",
+		"AZ-BA":  "巴库",
+		"AZ-BAB": "巴贝克区",
+		"AZ-BAL": "巴拉肯区",
+		"AZ-NV":  "那克赤凡",
+		"AZ-NX":  "那克赤凡",
+		"AZ-QAZ": "卡扎赫",
+		"AZ-SAL": "萨利亚内",
+		"AZ-SM":  "苏姆盖特",
+		"BA-SRP": "塞族共和国",
+		"BB-02":  "圣安德鲁斯",
+		"BB-03":  "圣乔治",
+		"BB-04":  "圣詹姆斯",
+		"BB-05":  "圣约翰",
+		"BB-06":  "圣约瑟",
+		"BB-07":  "圣露西",
+		"BB-08":  "圣麦可",
+		"BB-09":  "圣彼得",
+		"BB-10":  "圣飞利浦",
+		"BB-11":  "圣托马斯",
+		"BE-VAN": "安特卫普省",
+		"BE-VBR": "佛兰芒布拉班特省",
+		"BE-VOV": "东佛兰德省",
+		"BE-VWV": "西佛兰德省",
+		"BE-WAL": "瓦隆大区",
+		"BE-WHT": "埃诺省",
+		"BE-WLX": "卢森堡",
+		"BE-WNA": "那慕尔省",
+		"BF-03":  "中部",
+		"BF-08":  "东部省",
+		"BF-10":  "北部省",
+		"BF-BAL": "巴雷",
+		"BF-BAM": "巴姆",
+		"BF-BAN": "巴瓦",
+		"BF-BAZ": "巴泽加",
+		"BF-BGR": "布古里巴",
+		"BF-BLG": "布尔古",
+		"BF-BLK": "布尔基恩德",
+		"BF-COM": "科莫埃",
+		"BF-GAN": "冈祖尔古",
+		"BF-GNA": "尼亚尼亚",
+		"BF-GOU": "古尔马",
+		"BF-HOU": "乌埃",
+		"BF-IOB": "伊奥巴",
+		"BF-KAD": "卡焦戈",
+		"BF-KMD": "科蒙加里",
+		"BF-KMP": "孔皮恩加",
+		"BF-KOS": "孔西",
+		"BF-KOT": "库里滕加",
+		"BF-LOR": "罗卢姆",
+		"BF-MOU": "穆翁",
+		"BF-NAM": "纳门滕加",
+		"BF-NAY": "纳亚拉",
+		"BF-NOU": "努姆比埃尔",
+		"BF-OUB": "乌布里滕加",
+		"BF-OUD": "乌达兰",
+		"BF-PON": "波尼",
+		"BF-SMT": "桑马滕加",
+		"BF-SOM": "苏姆",
+		"BF-SOR": "苏鲁",
+		"BF-TAP": "塔波阿",
+		"BF-YAG": "亚加",
+		"BF-YAT": "亚滕加",
+		"BF-ZIR": "济罗",
+		"BF-ZON": "宗多马",
+		"BG-01":  "布拉格耶夫格勒",
+		"BG-02":  "布尔加斯",
+		"BG-03":  "瓦尔纳",
+		"BG-04":  "大特尔诺沃",
+		"BG-05":  "维丁",
+		"BG-06":  "弗拉察",
+		"BG-07":  "加布罗沃",
+		"BG-08":  "多布里奇",
+		"BG-09":  "克尔贾利州",
+		"BG-10":  "丘斯滕迪尔",
+		"BG-11":  "洛维奇",
+		"BG-12":  "蒙塔纳",
+		"BG-13":  "帕扎尔吉克",
+		"BG-14":  "贝尔尼克",
+		"BG-15":  "普列文",
+		"BG-16":  "普罗夫迪夫",
+		"BG-17":  "拉兹格勒",
+		"BG-18":  "鲁塞",
+		"BG-19":  "锡利斯特拉",
+		"BG-20":  "斯利文",
+		"BG-21":  "斯莫梁",
+		"BG-23":  "索菲亚州",
+		"BG-24":  "旧扎戈拉",
+		"BG-25":  "特尔戈维什特",
+		"BG-26":  "哈斯科沃",
+		"BG-27":  "舒门",
+		"BG-28":  "扬博尔",
+		"BH-14":  "南方省",
+		"BH-15":  "穆哈拉克省",
+		"BH-17":  "北方省",
+		"BI-BB":  "布班扎",
+		"BI-BR":  "布鲁里",
+		"BI-CA":  "坎库佐",
+		"BI-CI":  "锡比托凯",
+		"BI-GI":  "基特加",
+		"BI-KR":  "卡鲁济",
+		"BI-KY":  "卡扬扎",
+		"BI-MA":  "马坎巴",
+		"BI-MU":  "穆拉姆维亚",
+		"BI-MW":  "穆瓦洛",
+		"BI-NG":  "恩戈齐",
+		"BI-RT":  "鲁塔纳",
+		"BI-RY":  "鲁伊吉",
+		"BJ-AL":  "阿黎博里省",
+		"BJ-AQ":  "大西洋省",
+		"BJ-BO":  "博尔古省",
+		"BJ-CO":  "丘陵省",
+		"BJ-DO":  "峡谷省",
+		"BJ-LI":  "滨海省",
+		"BJ-MO":  "莫诺省",
+		"BJ-OU":  "韦梅省",
+		"BJ-PL":  "高原省",
+		"BJ-ZO":  "祖省",
+		"BN-BE":  "马来奕",
+		"BN-BM":  "穆阿拉",
+		"BN-TE":  "腾布荣",
+		"BN-TU":  "都东",
+		"BO-B":   "贝尼省",
+		"BO-C":   "科恰班巴省",
+		"BO-H":   "丘基萨卡省",
+		"BO-L":   "拉巴斯省",
+		"BO-N":   "潘多省",
+		"BO-O":   "奥鲁罗省",
+		"BO-P":   "波托西省",
+		"BO-S":   "圣克鲁斯省",
+		"BO-T":   "塔里哈省",
+		"BR-AC":  "阿克里",
+		"BR-AL":  "阿拉戈阿斯",
+		"BR-AM":  "亚马孙州",
+		"BR-BA":  "巴伊亚",
+		"BR-DF":  "首都特区",
+		"BR-MG":  "米纳斯吉拉斯",
+		"BR-MS":  "南马托格罗索",
+		"BR-MT":  "马托格罗索",
+		"BR-PE":  "伯南布哥",
+		"BR-RJ":  "里约热内卢",
+		"BR-RN":  "北里约格朗德",
+		"BR-RR":  "罗赖马",
+		"BR-RS":  "南里约格朗德",
+		"BR-SE":  "塞尔希培",
+		"BS-CI":  "卡特岛",
+		"BS-EX":  "埃克苏马",
+		"BS-HI":  "哈伯岛",
+		"BS-IN":  "伊纳瓜",
+		"BS-LI":  "长屿",
+		"BS-MG":  "马亚瓜纳",
+		"BS-RI":  "拉吉德岛",
+		"BS-SS":  "圣萨尔瓦多省",
+		"BT-11":  "帕罗宗",
+		"BT-12":  "楚卡宗",
+		"BT-15":  "廷布宗",
+		"BT-21":  "奇朗宗",
+		"BT-22":  "达加纳宗",
+		"BT-23":  "普那卡宗",
+		"BT-24":  "旺杜波德朗宗",
+		"BT-31":  "盖莱普宗",
+		"BT-32":  "通萨宗",
+		"BT-33":  "布姆唐宗",
+		"BT-34":  "谢姆冈宗",
+		"BT-41":  "塔希冈宗",
+		"BT-42":  "蒙加尔宗",
+		"BT-44":  "伦奇宗",
+		"BT-GA":  "加萨宗",
+		"BT-TY":  "塔希央奇宗",
+		"BW-CE":  "中部",
+		"BW-GH":  "杭济",
+		"BW-KG":  "卡拉哈迪",
+		"BW-KL":  "卡特伦",
+		"BW-KW":  "奎嫩",
+		"BZ-BZ":  "伯利兹",
+		"BZ-CY":  "卡约",
+		"BZ-CZL": "科罗萨尔",
+		"BZ-OW":  "橘园",
+		"BZ-SC":  "斯坦港",
+		"CA-AB":  "艾伯塔",
+		"CA-BC":  "不列颠哥伦比亚",
+		"CA-MB":  "马尼托巴",
+		"CA-NB":  "新不伦瑞克",
+		"CA-NL":  "纽芬兰与拉布拉多",
+		"CA-NS":  "新斯科舍",
+		"CA-NT":  "西北地区",
+		"CA-NU":  "努纳武特",
+		"CA-ON":  "安大略省",
+		"CA-PE":  "爱德华王子岛省",
+		"CA-QC":  "魁北克省",
+		"CA-SK":  "萨斯喀彻温",
+		"CD-EQ":  "赤道",
+		"CD-KN":  "金沙萨直辖市",
+		"CD-MA":  "马尼埃马",
+		"CD-NK":  "北基伍",
+		"CD-SK":  "南基伍",
+		"CF-AC":  "瓦姆",
+		"CF-BB":  "巴明吉-班戈兰",
+		"CF-BGF": "班吉直辖市",
+		"CF-BK":  "下科托",
+		"CF-HK":  "上科托",
+		"CF-HM":  "上姆博穆",
+		"CF-LB":  "洛巴伊",
+		"CF-MB":  "姆博穆",
+		"CF-SE":  "尚加",
+		"CF-UK":  "瓦卡",
+		"CF-VK":  "瓦卡加",
+		"CG-11":  "布恩扎",
+		"CG-12":  "普尔",
+		"CG-13":  "尚加",
+		"CG-15":  "西盆地省",
+		"CG-5":   "奎卢",
+		"CG-7":   "利夸拉",
+		"CG-8":   "盆地省",
+		"CG-9":   "尼阿利",
+		"CG-BZV": "布拉柴维尔",
+		"CH-AG":  "阿尔高",
+		"CH-AI":  "内阿彭策尔",
+		"CH-AR":  "外阿彭策尔",
+		"CH-BE":  "伯尔尼",
+		"CH-BL":  "巴塞尔乡村",
+		"CH-BS":  "巴塞尔城市",
+		"CH-GL":  "格拉鲁斯",
+		"CH-JU":  "汝拉",
+		"CH-LU":  "卢塞恩",
+		"CH-NW":  "下瓦尔登",
+		"CH-OW":  "上瓦尔登",
+		"CH-SG":  "圣加仑",
+		"CH-SH":  "沙夫豪森",
+		"CH-SO":  "索洛图恩",
+		"CH-SZ":  "施维茨",
+		"CH-TG":  "图尔高",
+		"CH-TI":  "提契诺",
+		"CH-UR":  "乌里",
+		"CH-VD":  "沃",
+		"CH-VS":  "瓦莱",
+		"CH-ZG":  "楚格",
+		"CI-CM":  "科莫埃",
+		"CL-AN":  "安托法加斯塔大区",
+		"CL-AT":  "阿塔卡马大区",
+		"CL-CO":  "科金博大区",
+		"CL-LI":  "奥伊金斯将军解放者大区",
+		"CL-LL":  "湖大区",
+		"CL-LR":  "洛斯里奥斯省",
+		"CL-ML":  "马乌莱大区",
+		"CM-AD":  "阿达马瓦省",
+		"CM-CE":  "中部",
+		"CM-EN":  "极北省",
+		"CM-ES":  "东方省",
+		"CM-LT":  "滨海省",
+		"CM-NO":  "北方省",
+		"CM-OU":  "西方省",
+		"CM-SU":  "南方省",
+		"CM-SW":  "西南省",
+		"CN-AH":  "安徽省",
+		"CN-BJ":  "北京市",
+		"CN-CQ":  "重庆市",
+		"CN-FJ":  "福建省",
+		"CN-GD":  "广东省",
+		"CN-GS":  "甘肃省",
+		"CN-GX":  "广西壮族自治区",
+		"CN-GZ":  "贵州省",
+		"CN-HA":  "河南省",
+		"CN-HB":  "湖北省",
+		"CN-HE":  "河北省",
+		"CN-HI":  "海南省",
+		"CN-HK":  "香港特别行政区",
+		"CN-HL":  "黑龙江省",
+		"CN-HN":  "湖南省",
+		"CN-JL":  "吉林省",
+		"CN-JS":  "江苏省",
+		"CN-JX":  "江西省",
+		"CN-LN":  "辽宁省",
+		"CN-MO":  "澳门特别行政区",
+		"CN-NM":  "内蒙古自治区",
+		"CN-NX":  "宁夏回族自治区",
+		"CN-QH":  "青海省",
+		"CN-SC":  "四川省",
+		"CN-SD":  "山东省",
+		"CN-SH":  "上海市",
+		"CN-SN":  "陕西省",
+		"CN-SX":  "山西省",
+		"CN-TJ":  "天津市",
+		"CN-TW":  "台湾省",
+		"CN-XJ":  "新疆维吾尔自治区",
+		"CN-XZ":  "西藏自治区",
+		"CN-YN":  "云南省",
+		"CN-ZJ":  "浙江省",
+		"CO-AMA": "亚马孙州",
+		"CO-ANT": "安提奥基亚",
+		"CO-ARA": "阿劳卡",
+		"CO-ATL": "大西洋",
+		"CO-BOL": "玻利瓦尔省",
+		"CO-BOY": "博亚卡",
+		"CO-CAL": "卡尔达斯",
+		"CO-CAQ": "卡克塔",
+		"CO-CAS": "卡萨纳雷",
+		"CO-CAU": "考卡",
+		"CO-CES": "塞萨尔",
+		"CO-CHO": "乔科",
+		"CO-COR": "科尔多瓦省",
+		"CO-CUN": "昆迪纳马卡",
+		"CO-DC":  "波哥大首都区",
+		"CO-GUA": "瓜伊尼亚",
+		"CO-GUV": "瓜维亚雷",
+		"CO-HUI": "乌伊拉",
+		"CO-LAG": "瓜希拉",
+		"CO-MAG": "马格达雷那",
+		"CO-MET": "梅塔",
+		"CO-NAR": "纳里尼奥",
+		"CO-NSA": "北桑坦德",
+		"CO-PUT": "普图马约",
+		"CO-RIS": "利萨拉尔达",
+		"CO-SAN": "桑坦德",
+		"CO-SAP": "圣安德烈斯-普罗维登西亚",
+		"CO-SUC": "苏克雷",
+		"CO-TOL": "托利马",
+		"CO-VAC": "考卡山谷",
+		"CO-VAU": "沃佩斯",
+		"CO-VID": "维查达",
+		"CR-A":   "阿拉辉拉",
+		"CR-C":   "卡塔戈",
+		"CR-G":   "瓜纳卡斯特",
+		"CR-H":   "埃雷迪亚",
+		"CR-P":   "彭塔雷纳斯",
+		"CU-03":  "哈瓦那省",
+		"CU-04":  "马坦萨斯省",
+		"CU-05":  "比亚克拉拉省",
+		"CU-06":  "西恩富戈斯省",
+		"CU-07":  "圣斯皮里图斯",
+		"CU-08":  "谢戈德阿维拉省",
+		"CU-09":  "卡马圭省",
+		"CU-10":  "拉斯图纳斯省",
+		"CU-11":  "奥尔金",
+		"CU-12":  "格拉玛省",
+		"CU-13":  "圣地亚哥省",
+		"CU-14":  "关塔那摩省",
+		"CU-99":  "青年岛特区",
+		"CV-B":   "迎风群岛",
+		"CV-BR":  "布拉瓦岛",
+		"CV-BV":  "博阿维斯塔岛",
+		"CV-CR":  "圣克鲁斯省",
+		"CV-MA":  "马尤岛",
+		"CV-MO":  "莫斯特罗",
+		"CV-PN":  "波多诺伏",
+		"CV-PR":  "普拉亚",
+		"CV-RG":  "大里贝拉",
+		"CV-S":   "背风群岛",
+		"CV-SD":  "圣多明戈",
+		"CV-SF":  "圣菲利普",
+		"CV-SL":  "萨尔岛",
+		"CV-SV":  "圣维森特岛",
+		"CV-TA":  "塔拉法尔",
+		"CZ-20":  "中捷克",
+		"CZ-31":  "南捷克",
+		"CZ-32":  "比尔森",
+		"CZ-41":  "卡罗维发利",
+		"CZ-42":  "乌斯季",
+		"CZ-51":  "利贝雷克",
+		"CZ-52":  "赫拉德茨-克拉洛韦",
+		"CZ-53":  "帕尔杜比采",
+		"CZ-64":  "南摩拉维亚",
+		"CZ-71":  "奥洛穆茨",
+		"CZ-72":  "兹林",
+		"CZ-80":  "摩拉维亚-西里西亚",
+		"DE-BB":  "勃兰登堡",
+		"DE-BE":  "柏林",
+		"DE-BY":  "巴伐利亚",
+		"DE-HB":  "不来梅",
+		"DE-HE":  "黑森",
+		"DE-HH":  "汉堡",
+		"DE-MV":  "梅克伦堡-前波美拉尼亚",
+		"DE-NI":  "下萨克森",
+		"DE-NW":  "北莱茵-威斯特法伦",
+		"DE-RP":  "莱茵兰-普法尔茨",
+		"DE-SH":  "石勒苏益格-荷尔斯泰因",
+		"DE-SL":  "萨尔",
+		"DE-SN":  "萨克森",
+		"DE-ST":  "萨克森-安哈尔特",
+		"DE-TH":  "图林根",
+		"DJ-AR":  "阿尔塔州",
+		"DJ-AS":  "阿里·萨比州",
+		"DJ-DI":  "迪奇尔州",
+		"DJ-DJ":  "吉布提市",
+		"DJ-TA":  "塔朱拉州",
+		"DK-81":  "北日德兰大区",
+		"DK-82":  "中日德兰大区",
+		"DK-83":  "南丹麦大区",
+		"DK-84":  "京畿大区",
+		"DK-85":  "西兰大区",
+		"DM-02":  "圣安德鲁斯",
+		"DM-03":  "圣大卫",
+		"DM-04":  "圣乔治",
+		"DM-05":  "圣约翰",
+		"DM-06":  "圣约瑟",
+		"DM-07":  "圣路加",
+		"DM-08":  "圣马可",
+		"DM-09":  "圣派屈克",
+		"DM-10":  "圣保罗",
+		"DM-11":  "圣彼得",
+		"DO-01":  "国家区",
+		"DO-02":  "阿苏阿",
+		"DO-04":  "巴拉奥纳",
+		"DO-05":  "达哈翁",
+		"DO-21":  "圣克里斯托瓦尔",
+		"DO-22":  "圣胡安省",
+		"DO-25":  "圣地亚哥",
+		"DZ-02":  "谢里夫",
+		"DZ-03":  "拉格瓦特",
+		"DZ-04":  "乌姆布阿基",
+		"DZ-05":  "巴特纳",
+		"DZ-07":  "比斯克拉",
+		"DZ-09":  "布利达",
+		"DZ-10":  "布依拉",
+		"DZ-13":  "特莱姆森",
+		"DZ-14":  "提亚雷特",
+		"DZ-15":  "提济乌祖",
+		"DZ-16":  "阿尔及尔",
+		"DZ-17":  "杰勒法",
+		"DZ-18":  "吉杰尔",
+		"DZ-21":  "斯基克达",
+		"DZ-23":  "安纳巴",
+		"DZ-24":  "盖尔马",
+		"DZ-25":  "君士坦丁",
+		"DZ-27":  "莫斯塔加纳姆",
+		"DZ-29":  "马斯卡拉",
+		"DZ-30":  "瓦尔格拉",
+		"DZ-31":  "奥兰",
+		"DZ-32":  "贝伊德",
+		"DZ-33":  "伊利齐",
+		"DZ-36":  "塔里夫",
+		"DZ-37":  "廷杜夫",
+		"DZ-38":  "蒂斯姆西勒特",
+		"DZ-39":  "瓦德",
+		"DZ-40":  "罕西拉",
+		"DZ-41":  "苏克·阿赫拉斯",
+		"DZ-42":  "蒂巴扎",
+		"DZ-43":  "密拉",
+		"DZ-45":  "纳阿马",
+		"DZ-48":  "赫利赞",
+		"EC-A":   "阿苏艾省",
+		"EC-B":   "玻利瓦尔省",
+		"EC-C":   "卡尔奇省",
+		"EC-D":   "奥雷利亚纳省",
+		"EC-E":   "埃斯梅拉尔达斯省",
+		"EC-F":   "卡尼亚尔省",
+		"EC-G":   "瓜亚斯省",
+		"EC-H":   "钦博拉索省",
+		"EC-I":   "因巴布拉省",
+		"EC-L":   "洛哈省",
+		"EC-M":   "马纳比省",
+		"EC-N":   "纳波省",
+		"EC-O":   "埃尔奥罗省",
+		"EC-P":   "皮钦查省",
+		"EC-R":   "洛斯里奥斯省",
+		"EC-T":   "通古拉瓦省",
+		"EC-U":   "苏昆毕奥斯省",
+		"EC-W":   "加拉帕戈斯省",
+		"EC-X":   "科托帕希省",
+		"EC-Y":   "帕斯塔萨省",
+		"EE-81":  "瓦尔加省",
+		"EG-KFS": "谢赫村",
+		"EG-SUZ": "苏伊士",
+		"ES-AB":  "阿尔瓦塞特",
+		"ES-AL":  "阿尔梅里亚",
+		"ES-AR":  "阿拉贡",
+		"ES-AS":  "阿斯图利亚斯省",
+		"ES-AV":  "阿维拉",
+		"ES-BA":  "巴达霍斯",
+		"ES-BU":  "布尔戈斯",
+		"ES-CA":  "加的斯",
+		"ES-CB":  "坎塔布利亚",
+		"ES-CC":  "卡塞雷斯",
+		"ES-CE":  "休达",
+		"ES-CM":  "卡斯蒂利亚－拉曼恰",
+		"ES-CN":  "加那利",
+		"ES-CO":  "科尔多瓦省",
+		"ES-CR":  "雷阿尔城",
+		"ES-CU":  "昆卡",
+		"ES-EX":  "埃斯特雷马杜拉",
+		"ES-GC":  "拉斯帕尔马斯",
+		"ES-GR":  "格拉纳达",
+		"ES-GU":  "瓜达拉哈拉",
+		"ES-H":   "韦尔瓦",
+		"ES-HU":  "韦斯卡",
+		"ES-J":   "哈恩",
+		"ES-LE":  "莱昂",
+		"ES-LO":  "拉里奥哈",
+		"ES-M":   "马德里",
+		"ES-MA":  "马拉加",
+		"ES-MD":  "马德里",
+		"ES-ML":  "梅利利亚",
+		"ES-MU":  "穆尔西亚",
+		"ES-O":   "阿斯图利亚斯",
+		"ES-P":   "帕伦西亚",
+		"ES-RI":  "拉里奥哈",
+		"ES-S":   "坎塔布利亚",
+		"ES-SA":  "萨拉曼卡",
+		"ES-SE":  "塞维利亚",
+		"ES-SG":  "塞哥维亚",
+		"ES-SO":  "索里亚",
+		"ES-TE":  "特鲁埃尔",
+		"ES-TF":  "圣克鲁斯-德特内里费",
+		"ES-VA":  "巴利亚多利德",
+		"ES-Z":   "萨拉戈萨",
+		"ES-ZA":  "萨莫拉",
+		"FJ-C":   "中部",
+		"FJ-E":   "东部区",
+		"FJ-N":   "北部区",
+		"FJ-R":   "罗图马岛",
+		"FJ-W":   "西部区",
+		"FM-KSA": "科斯雷",
+		"FM-PNI": "波纳佩",
+		"FM-TRK": "丘克",
+		"FM-YAP": "雅浦",
+		"FR-01":  "安省",
+		"FR-02":  "埃纳省",
+		"FR-03":  "阿列省",
+		"FR-04":  "上普罗旺斯阿尔卑斯省",
+		"FR-05":  "上阿尔卑斯省",
+		"FR-06":  "滨海阿尔卑斯省",
+		"FR-07":  "阿尔代什省",
+		"FR-08":  "阿登省",
+		"FR-09":  "阿列日省",
+		"FR-10":  "奥布省",
+		"FR-11":  "奥德省",
+		"FR-12":  "阿韦龙省",
+		"FR-13":  "罗讷河口省",
+		"FR-14":  "卡尔瓦多斯省",
+		"FR-15":  "康塔尔省",
+		"FR-16":  "夏朗德省",
+		"FR-17":  "滨海夏朗德省",
+		"FR-18":  "谢尔省",
+		"FR-19":  "科雷兹省",
+		"FR-20R": "科西嘉",
+		"FR-21":  "科多尔省",
+		"FR-22":  "阿摩尔滨海省",
+		"FR-23":  "克勒兹省",
+		"FR-24":  "多尔多涅省",
+		"FR-25":  "杜省",
+		"FR-26":  "德龙省",
+		"FR-27":  "厄尔省",
+		"FR-28":  "厄尔-卢瓦尔省",
+		"FR-29":  "菲尼斯泰尔省",
+		"FR-2A":  "南科西嘉",
+		"FR-2B":  "上科西嘉",
+		"FR-30":  "加尔省",
+		"FR-31":  "上加龙省",
+		"FR-32":  "热尔省",
+		"FR-33":  "吉伦特省",
+		"FR-34":  "埃罗省",
+		"FR-35":  "伊勒-维莱讷省",
+		"FR-36":  "安德尔省",
+		"FR-37":  "安德尔-卢瓦尔省",
+		"FR-38":  "伊泽尔省",
+		"FR-39":  "汝拉",
+		"FR-40":  "朗德省",
+		"FR-41":  "卢瓦尔-谢尔省",
+		"FR-42":  "卢瓦尔省",
+		"FR-43":  "上卢瓦尔省",
+		"FR-44":  "大西洋卢瓦尔省",
+		"FR-45":  "卢瓦雷省",
+		"FR-46":  "洛特省",
+		"FR-47":  "洛特-加龙省",
+		"FR-48":  "洛泽尔省",
+		"FR-49":  "曼恩-卢瓦尔省",
+		"FR-50":  "芒什省",
+		"FR-51":  "马恩省",
+		"FR-53":  "马耶讷省",
+		"FR-54":  "默尔特-摩泽尔省",
+		"FR-55":  "默兹省",
+		"FR-56":  "莫尔比昂省",
+		"FR-57":  "摩泽尔省",
+		"FR-58":  "涅夫勒省",
+		"FR-59":  "北部省",
+		"FR-60":  "瓦兹省",
+		"FR-61":  "奥恩省",
+		"FR-62":  "加来海峡省",
+		"FR-63":  "多姆山省",
+		"FR-64":  "比利牛斯-大西洋省",
+		"FR-65":  "上比利尼斯省",
+		"FR-66":  "东比利尼斯省",
+		"FR-67":  "下莱茵省",
+		"FR-68":  "上莱茵省",
+		"FR-69":  "罗讷省",
+		"FR-70":  "上索恩省",
+		"FR-71":  "索恩-卢瓦尔省",
+		"FR-72":  "萨尔特省",
+		"FR-73":  "萨瓦省",
+		"FR-74":  "上萨瓦省",
+		"FR-75":  "巴黎省",
+		"FR-76":  "滨海塞纳省",
+		"FR-77":  "塞纳-马恩省",
+		"FR-78":  "伊夫林省",
+		"FR-79":  "德塞夫勒省",
+		"FR-80":  "索姆省",
+		"FR-81":  "塔恩省",
+		"FR-82":  "塔恩-加龙省",
+		"FR-83":  "瓦尔省",
+		"FR-84":  "沃克吕兹省",
+		"FR-85":  "旺代省",
+		"FR-86":  "维埃纳省",
+		"FR-87":  "上维埃纳省",
+		"FR-88":  "孚日省",
+		"FR-89":  "约讷省",
+		"FR-90":  "贝尔福地区",
+		"FR-91":  "埃松省",
+		"FR-92":  "上塞纳省",
+		"FR-93":  "塞纳-圣但尼省",
+		"FR-94":  "瓦勒德马恩省",
+		"FR-971": "瓜德罗普",
+		"FR-972": "马提尼克",
+		"FR-976": "马约特",
+		"FR-BRE": "布列塔尼",
+		"FR-GP":  "瓜德罗普",
+		"FR-IDF": "法兰西岛",
+		"FR-MQ":  "马提尼克",
+		"FR-NC":  "新喀里多尼亚",
+		"FR-PF":  "法属玻里尼西亚",
+		"FR-PM":  "圣皮埃尔和密克隆群岛",
+		"FR-TF":  "法属南部领地",
+		"FR-YT":  "马约特",
+		"GA-1":   "河口省",
+		"GA-2":   "上奥果韦省",
+		"GA-3":   "中奥果韦省",
+		"GA-4":   "恩古涅省",
+		"GA-5":   "尼扬加省",
+		"GA-6":   "奥果韦－伊温多省",
+		"GA-7":   "奥果韦－洛洛省",
+		"GA-8":   "滨海奥果韦省",
+		"GA-9":   "沃勒－恩特姆省",
+		"GB-ABD": "阿伯丁郡",
+		"GB-ABE": "阿伯丁市",
+		"GB-AGB": "阿盖尔-比特",
+		"GB-ANS": "安格斯",
+		"GB-BAS": "巴思-东北萨默塞特",
+		"GB-BBD": "布莱克本",
+		"GB-BDG": "巴金-达根汉姆市",
+		"GB-BEN": "布兰特市",
+		"GB-BEX": "白克斯雷市",
+		"GB-BGW": "布莱耐格温特",
+		"GB-BIR": "伯明翰郡",
+		"GB-BKM": "白金汉郡",
+		"GB-BNE": "巴奈特市",
+		"GB-BNH": "布赖顿-霍伍",
+		"GB-BNS": "巴恩斯利郡",
+		"GB-BOL": "博尔顿市",
+		"GB-BPL": "布莱克浦",
+		"GB-BRC": "布拉克内尔森林",
+		"GB-BRD": "布拉德福德郡",
+		"GB-BRY": "布劳姆雷市",
+		"GB-BST": "布里斯托尔市",
+		"GB-BUR": "贝里市",
+		"GB-CAM": "剑桥郡",
+		"GB-CLD": "卡里德戴尔郡",
+		"GB-CLK": "克拉克曼南郡",
+		"GB-CMA": "坎布里亚",
+		"GB-CMD": "坎敦市",
+		"GB-CON": "康沃尔",
+		"GB-COV": "考文垂郡",
+		"GB-CRY": "克劳顿市",
+		"GB-CWY": "康威",
+		"GB-DAL": "达灵顿",
+		"GB-DBY": "德比郡",
+		"GB-DER": "德比",
+		"GB-DEV": "德文",
+		"GB-DGY": "邓弗里斯盖洛韦",
+		"GB-DNC": "唐克斯特郡",
+		"GB-DND": "邓迪市",
+		"GB-DOR": "多塞特",
+		"GB-DUD": "达德利郡",
+		"GB-EAL": "伊林市",
+		"GB-EAY": "东艾尔郡",
+		"GB-EDH": "爱丁堡市",
+		"GB-EDU": "东邓巴顿郡",
+		"GB-ELN": "东洛锡安",
+		"GB-ELS": "埃利安-锡尔",
+		"GB-ENF": "英菲尔德市",
+		"GB-ERW": "东伦弗鲁郡",
+		"GB-ERY": "约克郡东区",
+		"GB-ESS": "埃塞克斯",
+		"GB-ESX": "东萨塞克斯",
+		"GB-FAL": "福尔柯克",
+		"GB-FIF": "法夫",
+		"GB-GAT": "盖茨黑德郡",
+		"GB-GLG": "格拉斯哥市",
+		"GB-GLS": "格洛斯特郡",
+		"GB-GRE": "格林尼治市",
+		"GB-GWN": "圭内斯",
+		"GB-HAL": "哈尔顿",
+		"GB-HAM": "汉普郡",
+		"GB-HAV": "哈佛英市",
+		"GB-HCK": "汉克尼市",
+		"GB-HIL": "黑灵敦市",
+		"GB-HLD": "苏格兰高地",
+		"GB-HMF": "哈默斯密斯－佛尔汉姆市",
+		"GB-HNS": "杭斯路市",
+		"GB-HPL": "哈特尔浦",
+		"GB-HRT": "赫里福德郡",
+		"GB-HRW": "海伦市",
+		"GB-HRY": "哈灵根市",
+		"GB-IOW": "怀特岛",
+		"GB-ISL": "艾斯灵顿市",
+		"GB-IVC": "因弗克莱德",
+		"GB-KEC": "坎星顿－切尔西市",
+		"GB-KEN": "肯特",
+		"GB-KIR": "柯克利斯郡",
+		"GB-KTT": "泰晤士河畔金斯顿市",
+		"GB-KWL": "诺斯雷市",
+		"GB-LAN": "兰开夏",
+		"GB-LBH": "兰贝斯市",
+		"GB-LCE": "莱斯特",
+		"GB-LDS": "利兹郡",
+		"GB-LEC": "莱斯特郡",
+		"GB-LEW": "路易斯汉市",
+		"GB-LIN": "林肯郡",
+		"GB-LIV": "利物浦",
+		"GB-LND": "伦敦市",
+		"GB-LUT": "卢顿",
+		"GB-MDB": "米德尔斯堡",
+		"GB-MDW": "梅德韦",
+		"GB-MIK": "米尔顿凯恩斯",
+		"GB-MLN": "中洛锡安",
+		"GB-MRT": "莫顿市",
+		"GB-MRY": "马里",
+		"GB-NEL": "东北林肯郡",
+		"GB-NET": "泰恩河畔纽卡斯尔郡",
+		"GB-NFK": "诺福克",
+		"GB-NGM": "诺丁汉",
+		"GB-NLK": "北拉纳克郡",
+		"GB-NLN": "北林肯郡",
+		"GB-NSM": "北萨默塞特",
+		"GB-NTH": "北安普敦郡",
+		"GB-NTT": "诺丁汉郡",
+		"GB-NTY": "北泰恩河畔郡",
+		"GB-NWM": "纽汉市",
+		"GB-NYK": "北约克郡",
+		"GB-OLD": "奥尔德姆市",
+		"GB-ORK": "奥克尼群岛",
+		"GB-OXF": "牛津郡",
+		"GB-PKN": "珀斯-金罗斯",
+		"GB-PLY": "普利茅斯",
+		"GB-POR": "朴次茅斯",
+		"GB-POW": "波伊斯",
+		"GB-PTE": "彼得伯勒",
+		"GB-RCC": "里德卡-克里夫兰",
+		"GB-RCH": "罗奇代尔市",
+		"GB-RDB": "红桥市",
+		"GB-RDG": "雷丁",
+		"GB-RFW": "伦弗鲁郡",
+		"GB-RIC": "泰晤士河畔里士满市",
+		"GB-ROT": "罗瑟勒姆郡",
+		"GB-RUT": "拉特兰",
+		"GB-SAW": "山德威尔郡",
+		"GB-SAY": "南艾尔郡",
+		"GB-SFK": "萨福克",
+		"GB-SGC": "南格洛斯特郡",
+		"GB-SHF": "设菲尔德",
+		"GB-SHN": "圣海伦斯郡",
+		"GB-SHR": "什罗普郡",
+		"GB-SKP": "斯托克波特市",
+		"GB-SLF": "塞尔福德市",
+		"GB-SLG": "斯劳",
+		"GB-SLK": "南拉纳克郡",
+		"GB-SND": "森得兰郡",
+		"GB-SOL": "索利哈尔郡",
+		"GB-SOM": "萨默塞特",
+		"GB-SOS": "绍森德",
+		"GB-SRY": "萨里",
+		"GB-STE": "特伦河畔斯托克",
+		"GB-STG": "斯特灵",
+		"GB-STH": "南安普敦",
+		"GB-STN": "萨顿市",
+		"GB-STS": "斯塔福德郡",
+		"GB-STT": "蒂斯河畔斯托克",
+		"GB-STY": "南泰恩河畔郡",
+		"GB-SWD": "斯温顿",
+		"GB-SWK": "南瓦可市",
+		"GB-TAM": "泰姆赛德市",
+		"GB-TFW": "特尔福德-里金",
+		"GB-THR": "瑟罗克",
+		"GB-TOB": "托贝郡",
+		"GB-TRF": "特兰弗德市",
+		"GB-TWH": "塔沃哈姆莱茨市",
+		"GB-WAR": "沃里克郡",
+		"GB-WBK": "西巴克夏",
+		"GB-WDU": "西邓巴顿郡",
+		"GB-WFT": "威尔詹福利斯特市",
+		"GB-WGN": "威根市",
+		"GB-WKF": "韦克菲尔德市",
+		"GB-WLL": "沃尔索尔郡",
+		"GB-WLN": "西洛锡安",
+		"GB-WLV": "伍尔弗汉普顿郡",
+		"GB-WND": "旺茨沃斯市",
+		"GB-WNM": "温莎梅登黑德",
+		"GB-WOK": "沃金厄姆",
+		"GB-WOR": "伍斯特郡",
+		"GB-WRL": "威瑞尔郡",
+		"GB-WRT": "沃灵顿",
+		"GB-WSM": "西明斯特市",
+		"GB-WSX": "西萨塞克斯",
+		"GB-YOR": "约克",
+		"GB-ZET": "设得兰群岛",
+		"GD-01":  "圣安德鲁斯",
+		"GD-02":  "圣大卫",
+		"GD-03":  "圣乔治",
+		"GD-04":  "圣约翰",
+		"GD-05":  "圣马可",
+		"GD-06":  "圣派屈克",
+		"GD-10":  "南格林纳达群岛",
+		"GE-AB":  "阿布哈兹",
+		"GE-AJ":  "阿扎尔",
+		"GE-GU":  "古利亚",
+		"GH-AA":  "大阿克拉地区",
+		"GH-AH":  "阿散蒂地区",
+		"GH-CP":  "中部",
+		"GH-EP":  "东部区",
+		"GH-NP":  "北部区",
+		"GH-TV":  "沃尔特地区",
+		"GH-UE":  "东北地区",
+		"GH-UW":  "西北地区",
+		"GH-WP":  "西部区",
+		"GM-B":   "班珠尔市",
+		"GM-L":   "下河区",
+		"GM-M":   "麦卡锡岛区",
+		"GM-N":   "北岸区",
+		"GM-U":   "上河区",
+		"GM-W":   "西部区",
+		"GN-B":   "博凯",
+		"GN-BE":  "贝拉",
+		"GN-BF":  "博法",
+		"GN-BK":  "博凯",
+		"GN-CO":  "科亚",
+		"GN-D":   "金迪亚",
+		"GN-DB":  "达波拉",
+		"GN-DI":  "丁基拉耶",
+		"GN-DL":  "达拉巴",
+		"GN-DU":  "杜布雷卡",
+		"GN-F":   "法拉纳",
+		"GN-FA":  "法拉纳",
+		"GN-FO":  "弗雷卡里亚",
+		"GN-FR":  "弗里亚",
+		"GN-GA":  "高瓦尔",
+		"GN-GU":  "盖凯杜古",
+		"GN-K":   "康康",
+		"GN-KA":  "康康",
+		"GN-KB":  "库比亚",
+		"GN-KD":  "金迪亚",
+		"GN-KE":  "凯鲁阿内",
+		"GN-KN":  "孔达拉",
+		"GN-KO":  "库鲁萨",
+		"GN-KS":  "吉西杜古",
+		"GN-L":   "拉贝",
+		"GN-LA":  "拉贝",
+		"GN-LE":  "莱鲁马",
+		"GN-LO":  "洛拉",
+		"GN-M":   "玛木",
+		"GN-MC":  "马桑达",
+		"GN-MD":  "芒加纳",
+		"GN-ML":  "马里省",
+		"GN-MM":  "玛木",
+		"GN-N":   "恩泽雷科雷",
+		"GN-NZ":  "恩泽雷科雷",
+		"GN-PI":  "皮塔",
+		"GN-SI":  "西基里",
+		"GN-TE":  "特里梅莱",
+		"GN-TO":  "杜盖",
+		"GN-YO":  "尤木",
+		"GQ-LI":  "滨海省",
+		"GT-AV":  "上韦拉帕斯",
+		"GT-BV":  "下韦拉帕斯",
+		"GT-CM":  "奇马尔特南戈",
+		"GT-CQ":  "奇基穆拉",
+		"GT-ES":  "埃斯昆特拉",
+		"GT-GU":  "瓜地马拉",
+		"GT-HU":  "韦韦特南戈",
+		"GT-IZ":  "伊萨瓦尔",
+		"GT-JA":  "哈拉帕",
+		"GT-JU":  "胡蒂亚帕",
+		"GT-PE":  "佩滕",
+		"GT-PR":  "埃尔普罗格雷索",
+		"GT-QC":  "基切",
+		"GT-QZ":  "克萨尔特南戈",
+		"GT-RE":  "雷塔卢莱乌",
+		"GT-SA":  "萨卡特佩克斯",
+		"GT-SM":  "圣马科斯",
+		"GT-SO":  "索洛拉",
+		"GT-SR":  "圣罗莎",
+		"GT-SU":  "苏奇特佩克斯",
+		"GT-TO":  "托托尼卡潘",
+		"GT-ZA":  "萨卡帕",
+		"GW-BM":  "比翁博",
+		"GW-BS":  "比绍",
+		"GW-CA":  "卡谢乌",
+		"GW-OI":  "奥约",
+		"GW-QU":  "基纳拉",
+		"GW-TO":  "通巴利",
+		"GY-BA":  "巴里马-瓦伊尼",
+		"GY-CU":  "库尤尼-马扎鲁尼",
+		"GY-DE":  "德梅拉拉-马海卡",
+		"GY-EB":  "东伯比斯-科兰太因",
+		"GY-ES":  "埃塞奎博群岛-西德梅拉拉",
+		"GY-MA":  "马海卡-伯比斯",
+		"GY-PM":  "波默伦-苏佩纳姆",
+		"GY-PT":  "波塔罗-锡帕鲁尼",
+		"GY-UD":  "上德梅拉拉-伯比斯",
+		"GY-UT":  "上塔库图-上埃塞奎博",
+		"HN-CH":  "乔卢特卡",
+		"HN-CL":  "科隆省",
+		"HN-CM":  "科马亚瓜",
+		"HN-GD":  "格拉西亚斯-阿迪奥斯",
+		"HN-LE":  "伦皮拉",
+		"HN-LP":  "拉巴斯省",
+		"HN-OC":  "奥科特佩克",
+		"HN-OL":  "奥兰乔",
+		"HN-VA":  "山谷",
+		"HN-YO":  "约罗",
+		"HR-01":  "萨格勒布市",
+		"HR-02":  "克拉皮纳-扎戈列",
+		"HR-03":  "锡萨克-莫斯拉维纳",
+		"HR-04":  "卡尔洛瓦茨",
+		"HR-05":  "瓦拉日丁",
+		"HR-06":  "科普里夫尼察-克里热夫齐",
+		"HR-07":  "别洛瓦尔-比洛戈拉",
+		"HR-08":  "滨海和山区",
+		"HR-09":  "利卡-塞尼",
+		"HR-10":  "维罗维蒂察-波德拉维纳",
+		"HR-11":  "波热加-斯拉沃尼亚",
+		"HR-12":  "布罗德-波萨维纳",
+		"HR-13":  "扎达尔",
+		"HR-14":  "奥西耶克-巴拉尼亚",
+		"HR-15":  "希贝尼克-克宁",
+		"HR-16":  "武科瓦尔-斯里耶姆",
+		"HR-17":  "斯普利特-达尔马提亚",
+		"HR-18":  "伊斯特拉",
+		"HR-19":  "杜布罗夫斯克-内雷特瓦",
+		"HR-20":  "梅吉穆列",
+		"HR-21":  "札格雷布直辖市",
+		"HT-CE":  "中部",
+		"HT-ND":  "北部省",
+		"HT-NE":  "东北区",
+		"HT-NO":  "西北区",
+		"HU-BA":  "巴兰尼亚州",
+		"HU-BC":  "贝凯什乔包",
+		"HU-BE":  "贝凯什州",
+		"HU-BK":  "巴奇-基什孔州",
+		"HU-BU":  "布达佩斯",
+		"HU-BZ":  "包尔绍德-奥包乌伊-曾普伦州",
+		"HU-CS":  "琼格拉德州",
+		"HU-DE":  "德布勒森",
+		"HU-DU":  "多瑙新城",
+		"HU-EG":  "埃格尔",
+		"HU-FE":  "费耶尔州",
+		"HU-GS":  "杰尔-莫松-肖普朗州",
+		"HU-GY":  "杰尔",
+		"HU-HE":  "赫维什州",
+		"HU-HV":  "霍德梅泽瓦",
+		"HU-JN":  "加兹-纳杰孔-索尔诺克州",
+		"HU-KE":  "科马罗姆-埃斯泰尔戈姆州",
+		"HU-KM":  "凯奇凯梅特",
+		"HU-KV":  "考波什堡",
+		"HU-MI":  "米什科尔茨",
+		"HU-NK":  "瑙吉考尼饶",
+		"HU-PE":  "佩斯州",
+		"HU-PS":  "佩奇",
+		"HU-SD":  "塞格德",
+		"HU-SF":  "塞克什白堡",
+		"HU-SH":  "松博特海伊",
+		"HU-SK":  "索尔诺克",
+		"HU-SN":  "肖普朗",
+		"HU-SO":  "绍莫吉州",
+		"HU-SS":  "塞克萨德",
+		"HU-ST":  "绍尔戈陶尔扬",
+		"HU-SZ":  "索博尔奇-索特马尔-贝拉格州",
+		"HU-TB":  "陶陶巴尼奥",
+		"HU-TO":  "托尔瑙州",
+		"HU-VA":  "沃什州",
+		"HU-VE":  "维斯普雷姆州",
+		"HU-VM":  "维斯普雷姆州",
+		"HU-ZA":  "佐洛州",
+		"HU-ZE":  "佐洛埃格塞格",
+		"ID-AC":  "亚齐",
+		"ID-BA":  "巴厘",
+		"ID-BE":  "明古鲁",
+		"ID-BT":  "万丹",
+		"ID-GO":  "哥伦打洛",
+		"ID-JA":  "占碑",
+		"ID-JB":  "西爪哇",
+		"ID-JI":  "东爪哇",
+		"ID-JK":  "雅加达",
+		"ID-JT":  "中爪哇",
+		"ID-JW":  "爪哇",
+		"ID-KA":  "加里曼丹",
+		"ID-KB":  "西加里曼丹",
+		"ID-KI":  "东加里曼丹",
+		"ID-KR":  "廖内群岛",
+		"ID-KS":  "南加里曼丹",
+		"ID-KT":  "中加里曼丹",
+		"ID-LA":  "楠榜",
+		"ID-MA":  "马鲁古",
+		"ID-ML":  "马鲁古",
+		"ID-MU":  "北马鲁古",
+		"ID-NB":  "西努沙登加拉",
+		"ID-NT":  "东努沙登加拉",
+		"ID-NU":  "努沙登加拉",
+		"ID-PA":  "巴布亚",
+		"ID-PP":  "巴布亚",
+		"ID-RI":  "廖内",
+		"ID-SA":  "北苏拉威西",
+		"ID-SG":  "东南苏拉威西",
+		"ID-SL":  "苏拉威西",
+		"ID-SM":  "苏门答腊",
+		"ID-SN":  "南苏拉威西",
+		"ID-SR":  "西苏拉威西",
+		"ID-ST":  "中苏拉威西",
+		"ID-SU":  "北苏门答腊",
+		"ID-YO":  "日惹特区",
+		"IE-CE":  "克莱尔郡",
+		"IE-CN":  "卡文郡",
+		"IE-CO":  "科克郡",
+		"IE-CW":  "卡娄郡",
+		"IE-D":   "都柏林郡",
+		"IE-DL":  "当尼戈尔郡",
+		"IE-G":   "高维郡",
+		"IE-KE":  "基尔代尔郡",
+		"IE-KK":  "基尔肯尼郡",
+		"IE-KY":  "凯瑞郡",
+		"IE-L":   "伦斯特",
+		"IE-LD":  "朗福德郡",
+		"IE-LH":  "劳斯郡",
+		"IE-LK":  "利默里克郡",
+		"IE-LM":  "利特里姆郡",
+		"IE-LS":  "利施郡",
+		"IE-M":   "蒙斯特",
+		"IE-MH":  "米斯郡",
+		"IE-MN":  "莫纳亨郡",
+		"IE-MO":  "梅欧郡",
+		"IE-OY":  "奥法利郡",
+		"IE-RN":  "罗斯康门郡",
+		"IE-SO":  "斯莱戈郡",
+		"IE-TA":  "蒂珀雷里郡",
+		"IE-U":   "阿尔斯特",
+		"IE-WD":  "沃特福德郡",
+		"IE-WH":  "西米斯郡",
+		"IE-WW":  "威克娄郡",
+		"IE-WX":  "韦克斯福德郡",
+		"IN-AN":  "安达曼-尼科巴群岛",
+		"IN-AP":  "安得拉邦",
+		"IN-AS":  "阿萨姆邦",
+		"IN-DL":  "德里",
+		"IN-GA":  "果阿邦",
+		"IN-KL":  "喀拉拉邦",
+		"IN-LD":  "拉克沙群岛",
+		"IN-MN":  "曼尼普尔邦",
+		"IN-MP":  "中央邦",
+		"IN-MZ":  "米佐拉姆邦",
+		"IN-PB":  "旁遮普邦",
+		"IN-SK":  "泰米尔纳德邦",
+		"IN-TR":  "特里普拉邦",
+		"IN-UP":  "北方邦",
+		"IN-WB":  "西孟加拉邦",
+		"IR-00":  "中央",
+		"IR-01":  "吉兰省",
+		"IR-02":  "马赞德兰",
+		"IR-05":  "克尔曼沙汗",
+		"IR-06":  "胡齐斯坦",
+		"IR-07":  "法尔斯",
+		"IR-08":  "克尔曼",
+		"IR-10":  "伊斯法罕",
+		"IR-11":  "锡斯坦-俾路支斯坦",
+		"IR-12":  "库尔德斯坦",
+		"IR-13":  "哈马丹",
+		"IR-15":  "洛雷斯坦",
+		"IR-16":  "伊拉姆省",
+		"IR-18":  "布什尔",
+		"IR-19":  "赞詹",
+		"IR-20":  "塞姆南",
+		"IR-21":  "亚兹德",
+		"IR-22":  "霍尔木兹甘",
+		"IR-23":  "德黑兰",
+		"IR-24":  "阿尔达比勒",
+		"IR-25":  "库姆",
+		"IR-26":  "加兹温",
+		"IR-27":  "戈勒斯坦",
+		"IS-2":   "西南区",
+		"IS-3":   "西部区",
+		"IS-4":   "西峡湾区",
+		"IS-5":   "西北区",
+		"IS-6":   "东北区",
+		"IS-7":   "东部区",
+		"IS-8":   "南部区",
+		"IT-21":  "皮埃蒙特",
+		"IT-25":  "伦巴第",
+		"IT-32":  "特伦蒂诺-上阿迪杰",
+		"IT-34":  "威尼托",
+		"IT-42":  "利古里亚",
+		"IT-45":  "艾米利亚-罗马涅",
+		"IT-52":  "托斯卡纳",
+		"IT-55":  "翁布里亚",
+		"IT-57":  "马尔凯",
+		"IT-62":  "拉齐奥",
+		"IT-65":  "阿布鲁佐",
+		"IT-67":  "莫利塞",
+		"IT-72":  "坎帕尼亚",
+		"IT-75":  "普利亚",
+		"IT-77":  "巴斯利卡塔",
+		"IT-78":  "卡拉布里亚",
+		"IT-82":  "西西里",
+		"IT-88":  "萨丁",
+		"IT-AG":  "阿格里根托",
+		"IT-AL":  "亚历山德里亚",
+		"IT-AN":  "安科纳",
+		"IT-AP":  "阿斯科利皮切诺",
+		"IT-AQ":  "拉奎拉",
+		"IT-AR":  "阿雷佐",
+		"IT-AT":  "阿斯蒂",
+		"IT-AV":  "阿韦利诺",
+		"IT-BG":  "贝加莫",
+		"IT-BI":  "比耶拉",
+		"IT-BL":  "贝卢诺",
+		"IT-BN":  "贝内文托",
+		"IT-BO":  "博洛尼亚",
+		"IT-BR":  "布林迪西",
+		"IT-BS":  "布雷西亚",
+		"IT-BZ":  "博尔扎诺",
+		"IT-CA":  "卡利亚里",
+		"IT-CB":  "坎波巴索",
+		"IT-CE":  "卡塞尔塔",
+		"IT-CH":  "奇耶蒂",
+		"IT-CL":  "卡尔塔尼塞塔",
+		"IT-CN":  "库内奥",
+		"IT-CO":  "科莫",
+		"IT-CR":  "克雷莫纳",
+		"IT-CS":  "科森扎",
+		"IT-CT":  "卡塔尼亚",
+		"IT-CZ":  "卡坦扎罗",
+		"IT-EN":  "恩纳",
+		"IT-FE":  "费拉拉",
+		"IT-FG":  "福贾",
+		"IT-FI":  "佛罗伦萨",
+		"IT-FR":  "弗洛西诺内",
+		"IT-GE":  "热那亚",
+		"IT-GO":  "戈里奇亚",
+		"IT-GR":  "格罗塞托",
+		"IT-IM":  "因佩里亚",
+		"IT-IS":  "伊塞尔尼亚",
+		"IT-KR":  "克罗托内",
+		"IT-LC":  "雷科",
+		"IT-LE":  "莱切",
+		"IT-LI":  "里窝那",
+		"IT-LO":  "洛迪",
+		"IT-LT":  "拉蒂纳",
+		"IT-LU":  "卢卡",
+		"IT-MC":  "马塞拉塔",
+		"IT-ME":  "墨西拿",
+		"IT-MI":  "米兰",
+		"IT-MN":  "蒙托瓦",
+		"IT-MO":  "摩德纳",
+		"IT-MS":  "马萨-卡拉拉",
+		"IT-MT":  "梅特拉",
+		"IT-NA":  "那波利",
+		"IT-NO":  "诺瓦拉",
+		"IT-NU":  "努奥罗",
+		"IT-OR":  "奥里斯塔诺",
+		"IT-PA":  "巴勒莫",
+		"IT-PC":  "皮亚琴察",
+		"IT-PD":  "帕多瓦",
+		"IT-PE":  "佩斯卡拉",
+		"IT-PG":  "佩鲁贾",
+		"IT-PI":  "比萨",
+		"IT-PN":  "波代诺内",
+		"IT-PO":  "普拉托",
+		"IT-PR":  "帕尔马",
+		"IT-PT":  "比斯托利亚",
+		"IT-PU":  "佩萨洛乌尔毕诺",
+		"IT-PV":  "帕维亚",
+		"IT-PZ":  "波坦察",
+		"IT-RA":  "拉韦纳",
+		"IT-RC":  "雷焦卡拉布里亚",
+		"IT-RE":  "雷焦艾米利亚",
+		"IT-RG":  "拉古萨",
+		"IT-RI":  "列蒂",
+		"IT-RM":  "罗马",
+		"IT-RN":  "里米尼",
+		"IT-RO":  "罗维戈",
+		"IT-SA":  "萨莱诺",
+		"IT-SI":  "锡耶纳",
+		"IT-SO":  "松德里奥",
+		"IT-SP":  "拉斯佩奇亚",
+		"IT-SR":  "锡拉库萨",
+		"IT-SS":  "萨萨里",
+		"IT-SV":  "萨窝那",
+		"IT-TA":  "塔兰托",
+		"IT-TE":  "泰拉莫",
+		"IT-TN":  "特伦托",
+		"IT-TO":  "都灵",
+		"IT-TP":  "特拉帕尼",
+		"IT-TR":  "特尔尼",
+		"IT-TS":  "的里雅斯特",
+		"IT-TV":  "特雷维索",
+		"IT-UD":  "乌迪内",
+		"IT-VA":  "瓦雷泽",
+		"IT-VB":  "韦巴诺-库西奥-奥索拉",
+		"IT-VC":  "韦塞利",
+		"IT-VE":  "威尼斯",
+		"IT-VI":  "维琴察",
+		"IT-VR":  "维罗那",
+		"IT-VT":  "维特尔博",
+		"IT-VV":  "维伯瓦伦蒂亚",
+		"JM-01":  "金斯敦",
+		"JM-02":  "圣安德鲁斯",
+		"JM-03":  "圣托马斯",
+		"JM-04":  "波特兰",
+		"JM-05":  "圣玛丽",
+		"JM-06":  "圣安娜",
+		"JM-07":  "特里洛尼",
+		"JM-08":  "圣詹姆斯",
+		"JM-09":  "汉诺威",
+		"JM-10":  "西摩兰",
+		"JM-11":  "圣伊丽莎白",
+		"JM-13":  "克拉伦登",
+		"JM-14":  "圣凯瑟琳",
+		"JO-IR":  "伊尔比德",
+		"JO-JA":  "杰拉什",
+		"JO-KA":  "卡拉克",
+		"JO-MA":  "马夫拉克",
+		"JP-01":  "北海道",
+		"JP-02":  "青森县",
+		"JP-03":  "岩手县",
+		"JP-04":  "宫城县",
+		"JP-05":  "秋田县",
+		"JP-06":  "山形县",
+		"JP-07":  "福岛县",
+		"JP-08":  "茨城县",
+		"JP-09":  "枥木县",
+		"JP-10":  "群马县",
+		"JP-11":  "琦玉县",
+		"JP-12":  "千叶县",
+		"JP-13":  "东京都",
+		"JP-14":  "神奈川县",
+		"JP-15":  "新泻县",
+		"JP-16":  "富山县",
+		"JP-17":  "石川县",
+		"JP-18":  "福井县",
+		"JP-19":  "山梨县",
+		"JP-20":  "长野县",
+		"JP-21":  "岐阜县",
+		"JP-22":  "静冈县",
+		"JP-23":  "爱知县",
+		"JP-24":  "三重县",
+		"JP-25":  "滋贺县",
+		"JP-26":  "京都府",
+		"JP-27":  "大阪府",
+		"JP-28":  "兵库县",
+		"JP-29":  "奈良县",
+		"JP-30":  "和歌山县",
+		"JP-31":  "鸟取县",
+		"JP-32":  "岛根县",
+		"JP-33":  "冈山县",
+		"JP-34":  "广岛县",
+		"JP-35":  "山口县",
+		"JP-36":  "德岛县",
+		"JP-37":  "香川县",
+		"JP-38":  "爱媛县",
+		"JP-39":  "高知县",
+		"JP-40":  "福冈县",
+		"JP-41":  "佐贺县",
+		"JP-42":  "长崎县",
+		"JP-43":  "熊本县",
+		"JP-44":  "大分县",
+		"JP-45":  "宫崎县",
+		"JP-46":  "鹿儿岛县",
+		"JP-47":  "冲绳县",
+		"KE-04":  "布西亚",
+		"KG-B":   "巴特肯州",
+		"KG-N":   "纳伦州",
+		"KG-O":   "奥什州",
+		"KG-T":   "塔拉斯州",
+		"KH-11":  "蒙多基里省",
+		"KH-12":  "金边市",
+		"KH-13":  "柏威夏省",
+		"KH-14":  "波罗勉省",
+		"KH-15":  "菩萨省",
+		"KH-16":  "拉达那基里省",
+		"KH-17":  "暹粒省",
+		"KH-20":  "柴桢省",
+		"KH-21":  "茶胶省",
+		"KH-22":  "奥多棉芷省",
+		"KH-4":   "磅清扬省",
+		"KH-7":   "贡布省",
+		"KI-G":   "吉尔伯特群岛",
+		"KI-L":   "莱恩群岛",
+		"KI-P":   "菲尼克斯群岛",
+		"KN-K":   "圣科特司",
+		"KN-N":   "尼维斯",
+		"KP-04":  "慈江道",
+		"KZ-ALA": "阿拉木图市",
+		"KZ-PAV": "巴甫洛达尔",
+		"LA-AT":  "阿速坡",
+		"LA-BK":  "博乔",
+		"LA-BL":  "波里坎赛",
+		"LA-CH":  "占巴塞",
+		"LA-HO":  "华潘",
+		"LA-KH":  "甘蒙",
+		"LA-LM":  "琅南塔",
+		"LA-LP":  "琅勃拉邦",
+		"LA-OU":  "乌多姆赛",
+		"LA-PH":  "丰沙里",
+		"LA-SL":  "沙拉湾",
+		"LA-SV":  "沙湾拿吉",
+		"LA-XA":  "沙耶武里",
+		"LI-01":  "巴札尔",
+		"LI-02":  "埃申",
+		"LI-03":  "甘普林",
+		"LI-04":  "毛伦",
+		"LI-05":  "伯朗肯",
+		"LI-06":  "儒格尔",
+		"LI-07":  "沙恩",
+		"LI-08":  "许内勒贝格",
+		"LI-09":  "特里森",
+		"LI-10":  "特里森贝格",
+		"LI-11":  "瓦杜茨",
+		"LK-12":  "加姆珀哈",
+		"LK-81":  "巴杜勒",
+		"LK-91":  "拉特纳普勒",
+		"LR-BG":  "邦县",
+		"LR-BM":  "伯米县",
+		"LR-CM":  "大角山县",
+		"LR-GG":  "大各德县",
+		"LR-GK":  "大克鲁县",
+		"LR-LO":  "罗发县",
+		"LR-MG":  "马及比县",
+		"LR-MO":  "孟色罗拉多县",
+		"LR-NI":  "年巴县",
+		"LR-SI":  "习诺县",
+		"LS-A":   "马塞卢",
+		"LS-C":   "莱里贝",
+		"LS-D":   "伯里亚",
+		"LS-E":   "马费滕",
+		"LS-F":   "莫哈莱斯胡克",
+		"LS-G":   "古廷",
+		"LS-H":   "加查斯内克",
+		"LS-J":   "莫霍特隆",
+		"LS-K":   "塔巴采卡",
+		"LU-DI":  "迪基希",
+		"LU-GR":  "格雷文马赫",
+		"LU-LU":  "卢森堡",
+		"LV-DGV": "陶格夫皮尔斯",
+		"LV-JEL": "叶尔加瓦",
+		"LV-VEN": "文茨皮尔斯",
+		"LY-BA":  "班加西",
+		"LY-BU":  "巴特曼",
+		"LY-DR":  "德尔纳",
+		"LY-JA":  "绿山",
+		"LY-JU":  "胡夫拉",
+		"LY-KF":  "库夫拉",
+		"LY-MI":  "米苏拉塔",
+		"LY-MJ":  "迈尔季",
+		"LY-MQ":  "迈尔祖格",
+		"LY-SB":  "塞卜哈",
+		"LY-SR":  "苏尔特",
+		"LY-TB":  "的黎波里",
+		"MA-AZI": "艾济拉勒",
+		"MA-BER": "贝尔坎",
+		"MA-BOM": "布勒曼",
+		"MA-CHI": "希沙瓦",
+		"MA-ERR": "拉希迪耶",
+		"MA-ESI": "索维拉",
+		"MA-FIG": "菲吉格",
+		"MA-GUE": "盖勒敏",
+		"MA-HAJ": "哈杰布",
+		"MA-HAO": "豪兹",
+		"MA-HOC": "胡塞马",
+		"MA-IFR": "伊夫兰",
+		"MA-JDI": "杰迪代",
+		"MA-KEN": "盖尼特拉",
+		"MA-KHO": "胡里卜盖",
+		"MA-LAA": "阿尤恩",
+		"MA-LAR": "拉拉谢",
+		"MA-NAD": "纳祖尔",
+		"MA-OUA": "瓦尔扎扎特",
+		"MA-SAF": "萨非",
+		"MA-SEF": "塞夫劳",
+		"MA-SET": "塞塔特",
+		"MA-TAO": "陶纳特",
+		"MA-TAT": "塔塔",
+		"MA-TAZ": "塔扎",
+		"MA-TET": "得土安",
+		"MA-TIZ": "提兹尼特",
+		"MD-CA":  "卡胡尔",
+		"MD-OR":  "奥尔海伊",
+		"MD-SN":  "德涅斯特河左岸地区",
+		"MD-SO":  "索罗卡",
+		"MD-TA":  "塔拉克利亚",
+		"MD-UN":  "温盖尼",
+		"ME-01":  "安德里耶维察",
+		"ME-02":  "巴尔",
+		"ME-03":  "贝拉内",
+		"ME-04":  "比耶洛波列",
+		"ME-05":  "布德瓦",
+		"ME-06":  "采蒂涅",
+		"ME-07":  "达尼洛夫格勒",
+		"ME-08":  "新海尔采格",
+		"ME-09":  "科拉辛",
+		"ME-10":  "科托尔",
+		"ME-11":  "莫伊科瓦茨",
+		"ME-12":  "尼克希奇",
+		"ME-13":  "普拉夫",
+		"ME-14":  "普卢日内",
+		"ME-15":  "普列夫利亚",
+		"ME-16":  "波德戈里察",
+		"ME-17":  "罗扎伊",
+		"ME-18":  "沙夫尼克",
+		"ME-19":  "蒂瓦特",
+		"ME-20":  "乌尔齐尼",
+		"ME-21":  "扎布良克",
+		"MG-A":   "图阿马西拉省",
+		"MG-D":   "安齐拉纳纳省",
+		"MG-F":   "菲亚纳兰楚阿省",
+		"MG-M":   "马哈赞加省",
+		"MG-T":   "塔那那利佛省",
+		"MG-U":   "图利亚拉省",
+		"MH-ALK": "艾卢克",
+		"MH-ARN": "阿尔诺",
+		"MH-AUR": "奥尔",
+		"MH-EBO": "埃崩",
+		"MH-JAL": "贾卢伊特",
+		"MH-KWA": "夸贾林",
+		"MH-LAE": "拉埃",
+		"MH-LIB": "里布",
+		"MH-LIK": "利基埃普",
+		"MH-MAJ": "马朱罗",
+		"MH-MAL": "马洛埃拉普",
+		"MH-MEJ": "梅吉特",
+		"MH-MIL": "米利",
+		"MH-NMU": "纳木",
+		"MH-RON": "朗格拉普",
+		"MH-UJA": "乌贾",
+		"MH-WTH": "沃特",
+		"MH-WTJ": "沃特杰",
+		"ML-1":   "卡伊地区",
+		"ML-2":   "库里克罗地区",
+		"ML-3":   "锡加索地区",
+		"ML-4":   "塞古地区",
+		"ML-5":   "莫普提地区",
+		"ML-6":   "通布图地区",
+		"ML-7":   "加奥地区",
+		"ML-8":   "基达尔地区",
+		"ML-BKO": "巴马科",
+		"MM-01":  "实皆省",
+		"MM-02":  "勃固省",
+		"MM-03":  "马圭省",
+		"MM-04":  "曼德勒省",
+		"MM-05":  "德林达依省",
+		"MM-06":  "仰光省",
+		"MM-07":  "伊洛瓦底省",
+		"MM-11":  "克钦邦",
+		"MM-12":  "克耶邦",
+		"MM-13":  "克伦邦",
+		"MM-14":  "钦邦",
+		"MM-15":  "孟邦",
+		"MM-16":  "若开邦",
+		"MM-17":  "掸邦",
+		"MN-035": "鄂尔浑省",
+		"MN-037": "达尔汗乌勒省",
+		"MN-039": "肯特省",
+		"MN-043": "科布多省",
+		"MN-046": "乌布苏省",
+		"MN-049": "色楞格省",
+		"MN-057": "扎布汗省",
+		"MN-061": "东方省",
+		"MN-067": "布尔干省",
+		"MN-069": "巴彦洪戈尔省",
+		"MN-073": "后杭爱省",
+		"MR-01":  "西胡德",
+		"MR-04":  "戈尔戈勒",
+		"MR-05":  "卜拉克纳",
+		"MR-06":  "特拉扎",
+		"MR-09":  "塔甘特",
+		"MR-10":  "吉迪马卡",
+		"MR-11":  "提里斯-宰穆尔",
+		"MR-12":  "因希里",
+		"MT-47":  "萨非",
+		"MT-49":  "圣约翰",
+		"MU-AG":  "阿加莱加群岛",
+		"MU-BL":  "黑河",
+		"MU-FL":  "弗拉克",
+		"MU-GP":  "大港",
+		"MU-MO":  "莫卡",
+		"MU-PA":  "庞普勒穆斯",
+		"MU-PL":  "路易港",
+		"MU-PW":  "威廉平原",
+		"MU-RO":  "罗德里格斯",
+		"MU-SA":  "萨凡纳",
+		"MV-MLE": "马累",
+		"MW-BA":  "巴拉卡县",
+		"MW-BL":  "布兰太尔县",
+		"MW-CK":  "奇克瓦瓦县",
+		"MW-CR":  "奇拉朱卢县",
+		"MW-CT":  "奇蒂帕县",
+		"MW-DE":  "代扎县",
+		"MW-DO":  "多瓦县",
+		"MW-KR":  "卡龙加县",
+		"MW-KS":  "卡松古县",
+		"MW-LI":  "利隆圭县",
+		"MW-MC":  "姆钦吉县",
+		"MW-MG":  "曼戈切县",
+		"MW-MH":  "马钦加县",
+		"MW-MU":  "姆兰杰县",
+		"MW-MW":  "姆万扎区",
+		"MW-MZ":  "姆津巴县",
+		"MW-NB":  "恩卡塔湾县",
+		"MW-NI":  "恩奇斯县",
+		"MW-NK":  "恩科塔科塔县",
+		"MW-NS":  "恩桑杰县",
+		"MW-NU":  "恩彻乌县",
+		"MW-RU":  "伦比县",
+		"MW-SA":  "萨利马县",
+		"MW-TH":  "蒂约罗县",
+		"MW-ZO":  "松巴县",
+		"MX-AGU": "阿瓜斯卡连特斯州",
+		"MX-BCN": "下加利福尼亚州",
+		"MX-BCS": "南下加利福尼亚州",
+		"MX-CAM": "坎佩切州",
+		"MX-CHH": "奇瓦瓦州",
+		"MX-CHP": "恰帕斯州",
+		"MX-COL": "科利马州",
+		"MX-DUR": "杜兰戈州",
+		"MX-GRO": "格雷罗州",
+		"MX-GUA": "瓜纳华托州",
+		"MX-HID": "伊达尔戈州",
+		"MX-JAL": "哈利斯科州",
+		"MX-MOR": "莫雷洛斯州",
+		"MX-NAY": "纳亚里特州",
+		"MX-OAX": "瓦哈卡州",
+		"MX-PUE": "普埃布拉州",
+		"MX-ROO": "金塔纳罗奥州",
+		"MX-SIN": "锡那罗亚州",
+		"MX-SON": "索诺拉州",
+		"MX-TAB": "塔巴斯科州",
+		"MX-TAM": "塔毛利帕斯州",
+		"MX-TLA": "特拉斯卡拉州",
+		"MX-ZAC": "萨卡特卡斯州",
+		"MY-01":  "柔佛",
+		"MY-02":  "吉打",
+		"MY-03":  "吉兰丹",
+		"MY-04":  "马六甲",
+		"MY-05":  "森美兰",
+		"MY-06":  "彭亨",
+		"MY-07":  "槟城",
+		"MY-08":  "霹雳",
+		"MY-09":  "玻璃市",
+		"MY-10":  "雪兰莪",
+		"MY-11":  "登嘉楼",
+		"MY-12":  "沙巴",
+		"MY-13":  "砂拉越",
+		"MY-14":  "吉隆坡",
+		"MY-15":  "纳闽",
+		"MY-16":  "布特拉再也",
+		"MZ-A":   "尼亚萨省",
+		"MZ-B":   "马尼卡省",
+		"MZ-G":   "加扎省",
+		"MZ-I":   "伊尼扬巴内",
+		"MZ-L":   "马普托市",
+		"MZ-MPM": "马普托市",
+		"MZ-P":   "德尔加杜角省",
+		"MZ-S":   "索法拉省",
+		"MZ-T":   "太特省",
+		"NA-ER":  "埃龙戈",
+		"NA-HA":  "哈达普",
+		"NA-KH":  "霍马斯",
+		"NA-KU":  "库内内",
+		"NA-OD":  "奥乔宗蒂约巴",
+		"NA-OH":  "奥马赫科",
+		"NA-ON":  "奥沙纳",
+		"NA-OS":  "奥姆沙蒂",
+		"NA-OT":  "奥希科托",
+		"NA-OW":  "奥汉圭纳",
+		"NE-1":   "阿加德兹",
+		"NE-2":   "迪法",
+		"NE-3":   "多索",
+		"NE-4":   "马拉迪",
+		"NE-5":   "塔瓦",
+		"NE-6":   "蒂拉贝里",
+		"NE-7":   "津德尔",
+		"NE-8":   "尼亚美市",
+		"NG-AB":  "阿比亚州",
+		"NG-AD":  "阿达马瓦州",
+		"NG-AK":  "阿夸伊博姆州",
+		"NG-AN":  "阿南布拉州",
+		"NG-BA":  "包奇州",
+		"NG-BE":  "贝努埃州",
+		"NG-BO":  "博尔诺州",
+		"NG-BY":  "巴耶尔萨州",
+		"NG-CR":  "克里斯河州",
+		"NG-DE":  "三角州",
+		"NG-EB":  "埃邦伊州",
+		"NG-ED":  "埃多州",
+		"NG-EK":  "埃基蒂州",
+		"NG-EN":  "埃努古州",
+		"NG-GO":  "贡贝州",
+		"NG-IM":  "伊莫州",
+		"NG-JI":  "吉加瓦州",
+		"NG-KD":  "卡杜纳州",
+		"NG-KE":  "凯比州",
+		"NG-KN":  "卡诺州",
+		"NG-KO":  "科吉州",
+		"NG-KT":  "卡齐纳州",
+		"NG-KW":  "夸拉州",
+		"NG-LA":  "拉哥斯州",
+		"NG-NA":  "纳萨拉瓦州",
+		"NG-NI":  "尼日尔州",
+		"NG-OG":  "奥贡州",
+		"NG-ON":  "翁多州",
+		"NG-OS":  "奥孙州",
+		"NG-OY":  "奥约州",
+		"NG-PL":  "高原省",
+		"NG-RI":  "河流州",
+		"NG-SO":  "索科托州",
+		"NG-TA":  "塔拉巴州",
+		"NG-YO":  "约贝州",
+		"NG-ZA":  "扎姆法拉州",
+		"NI-BO":  "博阿科",
+		"NI-CA":  "卡拉索",
+		"NI-CI":  "奇南德加",
+		"NI-CO":  "琼塔莱斯",
+		"NI-ES":  "埃斯特利",
+		"NI-GR":  "格拉纳达",
+		"NI-JI":  "希诺特加",
+		"NI-LE":  "莱昂",
+		"NI-MD":  "马德里斯",
+		"NI-MN":  "马那瓜",
+		"NI-MS":  "马萨亚",
+		"NI-MT":  "马塔加尔帕",
+		"NI-NS":  "新塞哥维亚",
+		"NI-RI":  "里瓦斯",
+		"NI-SJ":  "圣胡安河",
+		"NL-AW":  "阿鲁巴",
+		"NL-CW":  "库拉索",
+		"NL-DR":  "德伦特省",
+		"NL-FL":  "菲仕兰省",
+		"NL-GE":  "海尔德兰",
+		"NL-GR":  "格罗宁根",
+		"NL-NB":  "北部拉班特",
+		"NL-NH":  "北荷兰",
+		"NL-OV":  "上艾瑟尔",
+		"NL-UT":  "乌德勒支",
+		"NL-ZE":  "泽兰",
+		"NL-ZH":  "南荷兰",
+		"NO-03":  "奥斯陆",
+		"NO-11":  "罗加兰",
+		"NO-18":  "诺尔兰",
+		"NP-1":   "中部",
+		"NP-3":   "西部区",
+		"NP-4":   "东部区",
+		"NZ-AUK": "奥克兰",
+		"NZ-BOP": "丰盛湾",
+		"NZ-CAN": "坎特伯雷",
+		"NZ-MWT": "马努瓦图-旺格纽伊",
+		"NZ-NTL": "北部地区",
+		"NZ-OTA": "奥塔哥地区",
+		"NZ-STL": "南部地区",
+		"NZ-TKI": "塔拉纳奇",
+		"NZ-WGN": "惠灵顿",
+		"NZ-WKO": "怀卡托",
+		"NZ-WTC": "西岸",
+		"OM-MU":  "穆桑达姆省",
+		"OM-WU":  "中部省",
+		"PA-1":   "博卡斯·德尔托罗省",
+		"PA-2":   "科克莱省",
+		"PA-3":   "科隆省",
+		"PA-4":   "奇里基省",
+		"PA-5":   "达连省",
+		"PA-6":   "埃雷拉省",
+		"PA-7":   "洛斯桑托斯省",
+		"PA-8":   "巴拿马省",
+		"PA-9":   "贝拉瓜斯省",
+		"PE-ANC": "安卡什区域",
+		"PE-ARE": "阿雷基帕区域",
+		"PE-AYA": "阿亚库乔区域",
+		"PE-CAJ": "卡哈马卡区域",
+		"PE-CAL": "卡亚俄区",
+		"PE-HUV": "万卡维利卡区域",
+		"PE-ICA": "伊卡区域",
+		"PE-LAM": "兰巴耶克区域",
+		"PE-LIM": "利马区域",
+		"PE-LOR": "洛雷托区域",
+		"PE-MDD": "马德雷·德迪奥斯区域",
+		"PE-MOQ": "莫克瓜区域",
+		"PE-PAS": "帕斯科区域",
+		"PE-PIU": "皮乌拉区域",
+		"PE-PUN": "普诺区域",
+		"PE-TAC": "塔克纳区域",
+		"PE-TUM": "通贝斯区域",
+		"PE-UCA": "乌卡亚利区域",
+		"PG-CPK": "钦布省",
+		"PG-CPM": "中部",
+		"PG-EBR": "东新不列颠省",
+		"PG-EHG": "东高地省",
+		"PG-EPW": "恩加省",
+		"PG-ESW": "东塞皮克省",
+		"PG-GPK": "海湾省",
+		"PG-MBA": "米尔恩湾省",
+		"PG-MPL": "莫雷贝省",
+		"PG-MPM": "马当省",
+		"PG-MRL": "马努斯省",
+		"PG-NCD": "国家首都区 (莫尔兹比港)",
+		"PG-NIK": "新爱尔兰省",
+		"PG-NPP": "北部区",
+		"PG-SHM": "南高地省",
+		"PG-WBK": "西新不列颠省",
+		"PG-WHM": "西高地省",
+		"PG-WPD": "西部区",
+		"PH-ABR": "阿布拉",
+		"PH-AGN": "北阿古桑",
+		"PH-AGS": "南阿古桑",
+		"PH-AKL": "阿克兰",
+		"PH-ALB": "阿尔拜",
+		"PH-ANT": "安蒂克",
+		"PH-APA": "阿巴尧",
+		"PH-AUR": "奥罗拉",
+		"PH-BAS": "巴西兰",
+		"PH-BEN": "本格特",
+		"PH-BIL": "比利兰",
+		"PH-BOH": "保和",
+		"PH-BTG": "八打雁",
+		"PH-BTN": "巴坦群岛",
+		"PH-BUK": "布基农",
+		"PH-BUL": "布拉干",
+		"PH-CAG": "卡加延",
+		"PH-CAM": "甘米银",
+		"PH-CAN": "北甘马磷",
+		"PH-CAP": "卡皮斯",
+		"PH-CAS": "南甘马磷",
+		"PH-CAT": "卡坦端内斯",
+		"PH-CAV": "甲米地",
+		"PH-CEB": "宿务",
+		"PH-DAO": "东达沃",
+		"PH-DAS": "南达沃",
+		"PH-EAS": "东萨马",
+		"PH-GUI": "吉马拉斯",
+		"PH-IFU": "伊富高",
+		"PH-ILI": "伊洛伊洛",
+		"PH-ILN": "北伊罗戈",
+		"PH-ILS": "南伊罗戈",
+		"PH-ISA": "伊莎贝拉",
+		"PH-LAG": "内湖",
+		"PH-LAN": "北拉瑙",
+		"PH-LAS": "南拉瑙",
+		"PH-LEY": "莱特",
+		"PH-MAD": "马林杜克",
+		"PH-MAG": "马京达瑙",
+		"PH-MAS": "马斯巴特",
+		"PH-MDC": "西民都洛",
+		"PH-MDR": "东民都洛",
+		"PH-MOU": "高山省",
+		"PH-MSC": "西米萨米斯",
+		"PH-MSR": "东米萨米斯",
+		"PH-NER": "东内格罗斯",
+		"PH-NSA": "北萨马",
+		"PH-NUE": "新怡诗夏",
+		"PH-NUV": "新比斯开",
+		"PH-PAM": "邦板牙",
+		"PH-PAN": "班诗兰",
+		"PH-PLW": "巴拉望",
+		"PH-QUE": "奎松",
+		"PH-QUI": "季里诺",
+		"PH-RIZ": "黎刹",
+		"PH-ROM": "朗布隆",
+		"PH-SAR": "萨兰加尼",
+		"PH-SCO": "南哥打巴托",
+		"PH-SIG": "锡基霍尔",
+		"PH-SLE": "南莱特",
+		"PH-SLU": "苏禄",
+		"PH-SOR": "索索贡",
+		"PH-SUK": "苏丹库达拉",
+		"PH-SUN": "北苏里高",
+		"PH-SUR": "南苏里高",
+		"PH-TAR": "打拉",
+		"PH-TAW": "塔威塔威",
+		"PH-ZAN": "北三宝颜",
+		"PH-ZAS": "南三宝颜",
+		"PH-ZMB": "三描礼士",
+		"PK-IS":  "伊斯兰堡",
+		"PK-PB":  "旁遮普邦",
+		"PL-02":  "下西里西亚省",
+		"PL-04":  "库亚维-滨海省",
+		"PL-06":  "卢布林省",
+		"PL-08":  "卢布斯卡省",
+		"PL-10":  "罗兹省",
+		"PL-12":  "小波兰省",
+		"PL-14":  "马佐夫舍省",
+		"PL-16":  "奥波莱省",
+		"PL-18":  "喀尔巴阡山省",
+		"PL-20":  "波德拉谢省",
+		"PL-22":  "滨海省",
+		"PL-24":  "西里西亚省",
+		"PL-26":  "圣十字省",
+		"PL-28":  "瓦尔米亚-马祖里省",
+		"PL-30":  "大波兰省",
+		"PL-32":  "西滨海省",
+		"PS-GZA": "加扎省",
+		"PT-01":  "亚威罗",
+		"PT-02":  "贝雅",
+		"PT-03":  "布拉加",
+		"PT-05":  "布朗库堡",
+		"PT-06":  "科英布拉",
+		"PT-08":  "法鲁",
+		"PT-09":  "瓜达",
+		"PT-10":  "莱里亚",
+		"PT-11":  "里斯本",
+		"PT-12":  "波塔莱格雷",
+		"PT-13":  "波尔图",
+		"PT-16":  "维亚纳堡",
+		"PT-17":  "雷阿尔城",
+		"PT-18":  "维塞乌",
+		"PW-002": "艾梅利克",
+		"PW-004": "艾拉伊",
+		"PW-010": "安加尔",
+		"PW-100": "卡扬埃尔",
+		"PW-150": "科罗尔",
+		"PW-212": "梅莱凯奥克",
+		"PW-214": "雅拉尔德",
+		"PW-218": "雅切隆",
+		"PW-222": "雅德马乌",
+		"PW-224": "雅庞",
+		"PW-226": "恩切萨尔",
+		"PW-227": "埃雷姆伦维",
+		"PW-228": "宜瓦尔",
+		"PW-350": "贝里琉",
+		"PW-370": "松索罗尔",
+		"PY-11":  "中部",
+		"PY-13":  "阿曼拜省",
+		"PY-15":  "阿耶斯总统省",
+		"PY-16":  "上巴拉圭省",
+		"PY-2":   "圣佩德罗省",
+		"PY-3":   "科迪勒拉省",
+		"PY-8":   "米西奥内斯省",
+		"QA-WA":  "沃克拉",
+		"RO-AB":  "阿尔巴",
+		"RO-AR":  "阿拉德",
+		"RO-BH":  "比霍尔",
+		"RO-CJ":  "克鲁日",
+		"RO-CV":  "科瓦斯纳",
+		"RO-DJ":  "多尔日",
+		"RO-GJ":  "戈尔日",
+		"RO-GR":  "朱尔朱",
+		"RO-HD":  "洪尼多阿拉",
+		"RO-HR":  "哈尔吉塔",
+		"RO-IF":  "伊尔福夫",
+		"RO-OT":  "奥尔特",
+		"RO-PH":  "普拉霍瓦",
+		"RO-SB":  "锡比乌",
+		"RO-SM":  "萨图·马雷",
+		"RO-SV":  "苏恰瓦",
+		"RO-TL":  "图尔恰",
+		"RO-TR":  "特列奥尔曼",
+		"RO-VN":  "弗郎恰",
+		"RO-VS":  "瓦斯鲁伊",
+		"RS-00":  "贝尔格莱德",
+		"RS-KM":  "科索沃-梅托西亚",
+		"RS-VO":  "沃伊伏丁那",
+		"RU-CE":  "车臣共和国",
+		"RU-CHU": "楚科奇自治区",
+		"RU-CU":  "楚瓦什共和国",
+		"RU-DA":  "达吉斯坦共和国",
+		"RU-KLU": "卡卢加州",
+		"RU-KO":  "科米共和国",
+		"RU-MOW": "莫斯科",
+		"RU-NIZ": "下诺夫哥罗德州",
+		"RU-SPE": "圣彼得堡",
+		"RU-TA":  "鞑靼斯坦共和国",
+		"RU-VOR": "沃罗涅日州",
+		"RW-02":  "东部区",
+		"RW-03":  "北部区",
+		"RW-04":  "西部区",
+		"SB-CE":  "中部",
+		"SB-CT":  "首都直辖区 (霍尼亚拉)",
+		"SB-GU":  "瓜达尔卡纳尔",
+		"SB-IS":  "伊萨贝尔",
+		"SB-ML":  "马莱塔",
+		"SB-RB":  "拉纳尔和贝罗纳",
+		"SB-TE":  "泰莫图",
+		"SB-WE":  "西部区",
+		"SD-NO":  "北部区",
+		"SI-001": "阿伊多夫什契纳",
+		"SI-002": "贝尔廷齐",
+		"SI-003": "不莱德",
+		"SI-004": "博希尼",
+		"SI-005": "博罗夫尼察",
+		"SI-006": "博韦茨",
+		"SI-007": "布尔达",
+		"SI-008": "布罗佐维察",
+		"SI-009": "布雷日采",
+		"SI-010": "蒂希纳",
+		"SI-011": "采列",
+		"SI-012": "戈雷尼斯卡地区采尔克列",
+		"SI-013": "采尔克尼察",
+		"SI-014": "采尔克诺",
+		"SI-015": "奇伦绍夫奇",
+		"SI-016": "科罗什卡地区奇尔纳",
+		"SI-017": "奇尔诺梅利",
+		"SI-018": "代斯特尔尼克-特尔诺夫斯克村",
+		"SI-019": "迪瓦查",
+		"SI-020": "多布雷波列",
+		"SI-021": "多布罗瓦-霍尔尤尔-波尔霍夫格拉代茨",
+		"SI-022": "卢布尔雅那附近多尔",
+		"SI-023": "多姆扎莱",
+		"SI-024": "多尔纳瓦",
+		"SI-025": "德拉沃格勒",
+		"SI-026": "杜普莱克",
+		"SI-027": "戈雷尼亚村-波利亚内",
+		"SI-028": "戈里什尼察",
+		"SI-029": "上拉德戈纳",
+		"SI-030": "戈尔尼格勒",
+		"SI-031": "上彼得罗夫齐",
+		"SI-032": "格罗苏普列",
+		"SI-033": "沙洛夫齐",
+		"SI-034": "赫拉斯特尼克",
+		"SI-035": "赫尔佩列-科济纳",
+		"SI-036": "伊德里亚",
+		"SI-037": "伊格",
+		"SI-038": "伊利尔斯卡比斯特里察",
+		"SI-039": "伊万奇纳戈里察",
+		"SI-041": "耶塞尼采",
+		"SI-042": "尤尔欣齐",
+		"SI-043": "卡姆尼克",
+		"SI-044": "卡纳尔",
+		"SI-045": "基德里切沃",
+		"SI-046": "科巴里德",
+		"SI-047": "科比列",
+		"SI-049": "科门",
+		"SI-051": "科济耶",
+		"SI-052": "克拉尼",
+		"SI-053": "克拉尼斯卡戈拉",
+		"SI-054": "克尔什科",
+		"SI-055": "昆戈塔",
+		"SI-056": "库兹马",
+		"SI-057": "拉什科",
+		"SI-058": "来纳尔特",
+		"SI-060": "利蒂亚",
+		"SI-061": "卢布尔雅那",
+		"SI-062": "柳布诺",
+		"SI-063": "柳托梅尔",
+		"SI-064": "洛加泰茨",
+		"SI-065": "洛什卡多利纳",
+		"SI-066": "洛什基波托克",
+		"SI-067": "卢切",
+		"SI-068": "卢科维察",
+		"SI-069": "马伊什佩克",
+		"SI-070": "马里博尔",
+		"SI-071": "梅德沃代",
+		"SI-072": "门盖什",
+		"SI-073": "梅特利卡",
+		"SI-074": "梅日察",
+		"SI-075": "米伦-科斯塔涅维察",
+		"SI-076": "米斯利尼亚",
+		"SI-077": "摩拉夫切",
+		"SI-078": "摩拉瓦-托普利采",
+		"SI-079": "莫济列",
+		"SI-080": "穆尔斯卡索博塔",
+		"SI-081": "穆塔",
+		"SI-082": "纳克洛",
+		"SI-083": "纳扎列",
+		"SI-084": "新戈里察",
+		"SI-108": "鲁塞",
+		"SI-117": "申丘尔",
+		"SI-118": "申蒂利",
+		"SI-119": "申特耶尔内伊",
+		"SI-121": "什科茨杨",
+		"SI-122": "什科菲亚洛卡",
+		"SI-123": "什科夫利察",
+		"SI-124": "耶尔沙赫附近什马列",
+		"SI-125": "帕卡河畔什马尔特诺",
+		"SI-126": "绍什塔尼",
+		"SI-127": "什托雷",
+		"SI-128": "托尔明",
+		"SI-129": "特雷布涅",
+		"SI-130": "特雷布涅",
+		"SI-131": "特尔日奇",
+		"SI-132": "图尔尼什切",
+		"SI-133": "韦莱涅",
+		"SI-134": "大拉什切",
+		"SI-135": "维代姆",
+		"SI-136": "维帕瓦",
+		"SI-137": "维塔涅",
+		"SI-139": "沃伊尼克",
+		"SI-140": "弗尔赫尼卡",
+		"SI-141": "武泽尼察",
+		"SI-142": "萨瓦河畔扎列格",
+		"SI-143": "扎夫尔奇",
+		"SI-144": "兹雷切",
+		"SI-146": "热莱兹尼基",
+		"SI-147": "日里",
+		"SI-149": "索特里河畔比什特里察",
+		"SI-150": "不洛科",
+		"SI-151": "布拉斯洛夫采",
+		"SI-152": "灿科瓦-蒂希纳",
+		"SI-153": "采尔克芬尼亚克",
+		"SI-154": "多布耶",
+		"SI-155": "多布尔纳",
+		"SI-157": "多莱尼斯科托普利采",
+		"SI-158": "格拉德",
+		"SI-159": "哈伊蒂纳",
+		"SI-160": "霍策-什里夫尼察",
+		"SI-163": "耶则尔斯科",
+		"SI-164": "科门达",
+		"SI-165": "科斯特尔",
+		"SI-166": "克里兹夫奇",
+		"SI-167": "波霍里尤地区洛夫伦茨",
+		"SI-168": "马里科夫奇",
+		"SI-169": "德拉夫斯肯波尔尤地区米克拉夫兹",
+		"SI-170": "米尔纳比茨",
+		"SI-183": "赛姆比特-费尔托伊巴",
+		"SI-184": "塔波尔",
+		"SI-186": "特尔兹恩",
+		"SI-187": "维里卡波兰纳",
+		"SI-188": "维尔泽伊",
+		"SI-189": "弗兰斯科",
+		"SI-190": "扎列奇",
+		"SI-191": "热塔莱",
+		"SI-192": "兹罗夫尼察",
+		"SI-193": "祖泽姆波克",
+		"SI-194": "利蒂基附近什马尔特诺",
+		"SK-BC":  "班斯卡·比斯特理察州",
+		"SK-BL":  "布拉迪斯拉发州",
+		"SK-KI":  "科希策州",
+		"SK-NI":  "尼特拉州",
+		"SK-PV":  "普列索夫州",
+		"SK-TA":  "特尔纳瓦州",
+		"SK-TC":  "特伦钦州",
+		"SK-ZI":  "日利纳州",
+		"SL-E":   "东部区",
+		"SL-N":   "北部区",
+		"SL-W":   "弗里敦",
+		"SM-06":  "博尔戈马焦雷",
+		"SN-DB":  "久尔贝勒",
+		"SN-DK":  "达喀尔",
+		"SN-FK":  "法蒂克",
+		"SN-KD":  "科尔达",
+		"SN-KL":  "考拉克",
+		"SN-LG":  "卢加",
+		"SN-MT":  "马塔姆",
+		"SN-SL":  "圣路易",
+		"SN-TC":  "坦巴昆达",
+		"SN-TH":  "捷斯",
+		"SN-ZG":  "济金绍尔",
+		"SR-BR":  "布罗科蓬多",
+		"SR-CM":  "科默韦讷",
+		"SR-CR":  "科罗尼",
+		"SR-MA":  "马罗韦纳",
+		"SR-NI":  "尼克里",
+		"SR-PM":  "帕拉马里博区",
+		"SR-SA":  "萨拉马卡",
+		"SR-SI":  "西帕利维尼",
+		"SR-WA":  "瓦尼卡",
+		"ST-P":   "普林西比",
+		"SV-CH":  "查拉特南戈省",
+		"SV-PA":  "拉巴斯省",
+		"SV-SA":  "圣安娜省",
+		"SV-SM":  "圣米格尔省",
+		"SV-SO":  "松索纳特省",
+		"SV-SS":  "圣萨尔瓦多省",
+		"SV-SV":  "圣维森特省",
+		"SY-DI":  "大马士革市",
+		"SY-DY":  "代尔祖尔",
+		"SY-ID":  "伊德利卜",
+		"SY-RA":  "拉卡",
+		"SZ-HH":  "霍霍",
+		"SZ-LU":  "卢邦博",
+		"SZ-MA":  "曼齐尼区",
+		"SZ-SH":  "希塞卢韦尼",
+		"TH-11":  "北榄府",
+		"TH-12":  "暖武里府",
+		"TH-13":  "巴吞他尼府",
+		"TH-14":  "大城府",
+		"TH-15":  "红统府",
+		"TH-16":  "华富里府",
+		"TH-17":  "信武里府",
+		"TH-18":  "猜纳府",
+		"TH-19":  "北标府",
+		"TH-20":  "春武里府",
+		"TH-21":  "罗勇府",
+		"TH-22":  "尖竹汶府",
+		"TH-23":  "桐艾府",
+		"TH-24":  "北柳府",
+		"TH-25":  "巴真府",
+		"TH-26":  "坤西育府",
+		"TH-27":  "沙缴府",
+		"TH-30":  "呵叻府",
+		"TH-31":  "武里喃府",
+		"TH-32":  "素辇府",
+		"TH-33":  "四色菊府",
+		"TH-34":  "乌汶府",
+		"TH-35":  "益梭通府",
+		"TH-36":  "猜也贲府",
+		"TH-37":  "安纳乍能府",
+		"TH-39":  "廊磨喃蒲府",
+		"TH-40":  "坤敬府",
+		"TH-41":  "莫肯府",
+		"TH-42":  "黎府",
+		"TH-43":  "廊开府",
+		"TH-44":  "吗哈沙拉堪府",
+		"TH-45":  "横逸府",
+		"TH-46":  "加拉信府",
+		"TH-47":  "色军府",
+		"TH-48":  "那空拍侬府",
+		"TH-49":  "莫拉限府",
+		"TH-50":  "清迈府",
+		"TH-51":  "南奔府",
+		"TH-52":  "南邦府",
+		"TH-53":  "程逸府",
+		"TH-54":  "帕府",
+		"TH-55":  "楠府",
+		"TH-56":  "拍天府",
+		"TH-57":  "清莱府",
+		"TH-58":  "湄宏顺府",
+		"TH-60":  "北榄坡府",
+		"TH-61":  "乌泰他尼府",
+		"TH-62":  "甘烹碧府",
+		"TH-63":  "来兴府",
+		"TH-64":  "素可泰",
+		"TH-65":  "彭世洛府",
+		"TH-66":  "披集府",
+		"TH-67":  "碧差汶府",
+		"TH-70":  "叻丕府",
+		"TH-71":  "北碧府",
+		"TH-72":  "素攀府",
+		"TH-73":  "佛统府",
+		"TH-74":  "龙仔厝府",
+		"TH-75":  "夜功府",
+		"TH-76":  "佛丕府",
+		"TH-77":  "班武里府",
+		"TH-80":  "洛坤府",
+		"TH-81":  "甲米府",
+		"TH-82":  "攀牙府",
+		"TH-83":  "普吉府",
+		"TH-84":  "素叻府",
+		"TH-85":  "拉廊府",
+		"TH-86":  "春蓬府",
+		"TH-90":  "宋卡府",
+		"TH-91":  "沙敦府",
+		"TH-92":  "董里府",
+		"TH-93":  "博他仑府",
+		"TH-94":  "北大年府",
+		"TH-95":  "惹拉府",
+		"TH-96":  "陶公府",
+		"TH-S":   "芭达亚",
+		"TJ-KT":  "哈特隆州",
+		"TJ-SU":  "索格特州",
+		"TL-AL":  "阿伊莱乌区",
+		"TL-AN":  "阿伊纳罗区",
+		"TL-BA":  "包考区",
+		"TL-BO":  "博博纳罗区",
+		"TL-CO":  "科瓦利马区",
+		"TL-ER":  "埃尔梅拉区",
+		"TL-MF":  "马努法伊区",
+		"TL-MT":  "马纳图托区",
+		"TM-A":   "阿哈尔",
+		"TM-B":   "巴尔坎",
+		"TM-D":   "达沙古兹",
+		"TM-L":   "列巴普",
+		"TM-M":   "马雷",
+		"TN-11":  "突尼斯",
+		"TN-13":  "本阿鲁斯",
+		"TN-14":  "马努巴",
+		"TN-21":  "纳布勒",
+		"TN-22":  "宰格万",
+		"TN-23":  "比塞大",
+		"TN-31":  "巴杰",
+		"TN-32":  "坚杜拜",
+		"TN-33":  "卡夫",
+		"TN-34":  "锡勒亚奈",
+		"TN-41":  "凯鲁万",
+		"TN-42":  "卡塞林",
+		"TN-43":  "西迪布济德",
+		"TN-51":  "苏塞",
+		"TN-52":  "莫纳斯提尔",
+		"TN-53":  "马赫迪耶",
+		"TN-61":  "斯法克斯",
+		"TN-71":  "加夫萨",
+		"TN-72":  "托泽尔",
+		"TN-81":  "加贝斯",
+		"TN-83":  "泰塔温",
+		"TO-01":  "埃瓦岛",
+		"TO-02":  "哈派群岛",
+		"TO-03":  "纽阿斯",
+		"TO-04":  "汤加塔布岛",
+		"TO-05":  "瓦瓦乌群岛",
+		"TR-01":  "阿达纳",
+		"TR-02":  "阿德亚曼",
+		"TR-04":  "阿勒",
+		"TR-05":  "阿马西亚",
+		"TR-06":  "安卡拉",
+		"TR-07":  "安塔利亚",
+		"TR-08":  "阿尔特温",
+		"TR-09":  "艾登",
+		"TR-10":  "巴勒克埃西尔",
+		"TR-11":  "比莱吉克",
+		"TR-12":  "宾格尔",
+		"TR-13":  "比特利斯",
+		"TR-14":  "博卢",
+		"TR-15":  "布尔杜尔",
+		"TR-16":  "布尔萨",
+		"TR-17":  "恰纳卡莱",
+		"TR-18":  "昌克勒",
+		"TR-19":  "乔鲁姆",
+		"TR-20":  "代尼兹利",
+		"TR-21":  "迪亚巴克尔",
+		"TR-22":  "埃迪尔内",
+		"TR-23":  "埃拉泽省",
+		"TR-24":  "埃尔津詹",
+		"TR-25":  "埃尔祖鲁姆",
+		"TR-26":  "埃斯基谢希尔",
+		"TR-27":  "加济安泰普",
+		"TR-28":  "吉雷松",
+		"TR-29":  "居米什哈内",
+		"TR-30":  "哈卡里",
+		"TR-31":  "哈塔伊",
+		"TR-32":  "伊斯帕尔塔",
+		"TR-34":  "伊斯坦布尔",
+		"TR-35":  "伊兹密尔",
+		"TR-36":  "卡尔斯",
+		"TR-37":  "卡斯塔莫努",
+		"TR-38":  "开塞利",
+		"TR-39":  "柯克拉雷利",
+		"TR-40":  "克尔谢希尔",
+		"TR-41":  "科贾埃利",
+		"TR-42":  "科尼亚",
+		"TR-43":  "屈塔希亚",
+		"TR-44":  "马拉蒂亚",
+		"TR-45":  "马尼萨",
+		"TR-46":  "卡赫拉曼马拉什",
+		"TR-47":  "马尔丁",
+		"TR-48":  "穆拉",
+		"TR-49":  "穆什",
+		"TR-50":  "内夫谢希尔",
+		"TR-51":  "尼代",
+		"TR-52":  "奥尔杜",
+		"TR-53":  "里泽",
+		"TR-54":  "萨卡里亚",
+		"TR-55":  "萨姆松",
+		"TR-56":  "锡尔特",
+		"TR-57":  "锡诺普",
+		"TR-58":  "锡瓦斯",
+		"TR-59":  "泰基尔达",
+		"TR-60":  "托卡特",
+		"TR-61":  "特拉布宗",
+		"TR-62":  "通杰利",
+		"TR-63":  "尚利乌尔法",
+		"TR-64":  "乌萨克",
+		"TR-65":  "凡",
+		"TR-66":  "约兹加特",
+		"TR-67":  "宗古尔达克",
+		"TR-68":  "阿克萨赖",
+		"TR-69":  "巴伊布尔特",
+		"TR-70":  "卡拉曼",
+		"TR-71":  "克勒克卡莱",
+		"TR-72":  "巴特曼",
+		"TR-73":  "锡尔纳克",
+		"TR-74":  "巴尔腾",
+		"TR-75":  "阿尔达罕",
+		"TR-76":  "厄德尔",
+		"TR-77":  "亚罗法",
+		"TR-78":  "卡拉比克",
+		"TR-79":  "基利斯",
+		"TR-80":  "奥斯曼尼菲",
+		"TR-81":  "迪兹杰",
+		"TT-ARI": "阿里马",
+		"TT-CHA": "查瓜纳斯",
+		"TT-CTT": "库瓦-塔巴基特-塔尔帕罗",
+		"TT-DMN": "迭哥马丁",
+		"TT-PED": "皮纳尔-德贝",
+		"TT-POS": "西班牙港市",
+		"TT-PRT": "王子镇",
+		"TT-PTF": "福廷岬",
+		"TT-SFO": "圣费尔南多市",
+		"TT-SGE": "大桑格雷",
+		"TT-SIP": "锡帕里亚",
+		"TT-SJL": "圣胡安-拉芬蒂勒",
+		"TT-TUP": "图纳普纳-皮亚尔科",
+		"TV-FUN": "富纳富提",
+		"TV-NIT": "纽乌道乌",
+		"TV-NKF": "努谷费陶",
+		"TV-NKL": "努谷拉耶拉耶",
+		"TV-NMA": "那努米亚",
+		"TV-NUI": "努伊",
+		"TV-VAI": "婓伊托波",
+		"TW-CHA": "彰化县",
+		"TW-CYI": "嘉义县",
+		"TW-CYQ": "嘉义县",
+		"TW-HSQ": "新竹县",
+		"TW-HSZ": "新竹县",
+		"TW-HUA": "花莲县",
+		"TW-KHH": "高雄县",
+		"TW-MIA": "苗栗县",
+		"TW-NAN": "南投县",
+		"TW-PEN": "澎湖县",
+		"TW-PIF": "屏东县",
+		"TW-TAO": "桃园县",
+		"TW-TNN": "台南县",
+		"TW-TPE": "台北县",
+		"TW-TTT": "台东县",
+		"TW-TXG": "台中县",
+		"TW-YUN": "云林县",
+		"TZ-01":  "阿鲁沙",
+		"TZ-03":  "多多马",
+		"TZ-04":  "伊林加",
+		"TZ-05":  "卡盖拉",
+		"TZ-08":  "基戈马",
+		"TZ-09":  "乞力马扎罗",
+		"TZ-12":  "林迪",
+		"TZ-13":  "马腊",
+		"TZ-14":  "姆贝亚",
+		"TZ-16":  "莫罗戈罗",
+		"TZ-17":  "姆特瓦拉",
+		"TZ-18":  "姆万扎区",
+		"TZ-20":  "鲁夸",
+		"TZ-21":  "鲁伍马",
+		"TZ-22":  "希尼安加",
+		"TZ-23":  "辛吉达",
+		"TZ-24":  "塔波拉",
+		"TZ-25":  "坦噶",
+		"TZ-26":  "曼亚拉",
+		"UA-40":  "塞瓦斯托波尔市",
+		"UG-101": "卡兰加拉",
+		"UG-102": "坎帕拉",
+		"UG-103": "基博加",
+		"UG-104": "卢韦罗",
+		"UG-105": "马萨卡",
+		"UG-106": "姆皮吉",
+		"UG-107": "穆本德",
+		"UG-108": "穆科诺",
+		"UG-109": "纳卡松戈拉",
+		"UG-110": "拉卡伊",
+		"UG-111": "森巴布莱",
+		"UG-112": "卡永加",
+		"UG-113": "瓦基索",
+		"UG-201": "布吉里",
+		"UG-202": "布西亚",
+		"UG-203": "伊甘加",
+		"UG-204": "金贾",
+		"UG-205": "卡穆利",
+		"UG-206": "卡普乔鲁瓦",
+		"UG-207": "卡塔奎",
+		"UG-208": "库米",
+		"UG-209": "姆巴莱",
+		"UG-210": "帕利萨",
+		"UG-211": "索罗提",
+		"UG-212": "托罗罗",
+		"UG-213": "卡贝拉马伊多",
+		"UG-214": "马尤盖",
+		"UG-215": "锡龙科",
+		"UG-301": "阿朱马尼",
+		"UG-302": "阿帕克",
+		"UG-303": "阿鲁阿",
+		"UG-304": "古卢",
+		"UG-305": "基特古姆",
+		"UG-306": "科蒂多",
+		"UG-307": "利拉",
+		"UG-308": "莫罗托",
+		"UG-309": "莫约",
+		"UG-310": "内比",
+		"UG-311": "纳卡皮里皮里特",
+		"UG-312": "帕德尔",
+		"UG-313": "永贝",
+		"UG-401": "本迪布焦",
+		"UG-402": "布谢尼",
+		"UG-403": "霍伊马",
+		"UG-404": "卡巴莱",
+		"UG-405": "卡巴罗莱",
+		"UG-406": "卡塞塞",
+		"UG-407": "基巴莱",
+		"UG-408": "基索罗",
+		"UG-409": "马辛迪",
+		"UG-410": "姆巴拉拉",
+		"UG-411": "恩通加莫",
+		"UG-412": "鲁昆吉里",
+		"UG-413": "卡姆文盖",
+		"UG-414": "卡农古",
+		"UG-415": "基恩乔乔",
+		"UG-C":   "中部",
+		"UG-E":   "东部区",
+		"UG-N":   "北部区",
+		"UG-W":   "西部区",
+		"UM-67":  "约翰斯顿岛",
+		"UM-71":  "中途岛",
+		"UM-76":  "纳弗沙岛",
+		"UM-79":  "威克岛",
+		"UM-81":  "贝克岛",
+		"UM-84":  "豪兰岛",
+		"UM-86":  "贾维斯岛",
+		"UM-89":  "金曼礁",
+		"UM-95":  "巴尔米拉环礁",
+		"US-AK":  "阿拉斯加州",
+		"US-AL":  "亚拉巴马州",
+		"US-AR":  "阿肯色州",
+		"US-AS":  "美属萨摩亚",
+		"US-AZ":  "亚利桑那州",
+		"US-CA":  "加利福尼亚州",
+		"US-CO":  "科罗拉多州",
+		"US-CT":  "康涅狄格州",
+		"US-DC":  "华盛顿哥伦比亚特区",
+		"US-DE":  "特拉华州",
+		"US-FL":  "佛罗里达州",
+		"US-GA":  "佐治亚州",
+		"US-GU":  "关岛",
+		"US-HI":  "夏威夷州",
+		"US-IA":  "艾奥瓦州",
+		"US-ID":  "爱达荷州",
+		"US-IL":  "伊利诺伊州",
+		"US-IN":  "印第安纳州",
+		"US-KS":  "堪萨斯州",
+		"US-KY":  "肯塔基州",
+		"US-LA":  "路易斯安那州",
+		"US-MA":  "马萨诸塞州",
+		"US-ME":  "缅因州",
+		"US-MI":  "密歇根州",
+		"US-MN":  "明尼苏达州",
+		"US-MO":  "密苏里州",
+		"US-MP":  "北马里亚纳群岛",
+		"US-MS":  "密西西比州",
+		"US-MT":  "蒙塔纳",
+		"US-NC":  "北卡罗来纳州",
+		"US-ND":  "北达科他州",
+		"US-NE":  "内布拉斯加州",
+		"US-NH":  "新罕布什尔州",
+		"US-NJ":  "新泽西州",
+		"US-NM":  "新墨西哥州",
+		"US-NV":  "内华达州",
+		"US-NY":  "纽约州",
+		"US-OH":  "俄亥俄州",
+		"US-OK":  "俄克拉何马州",
+		"US-OR":  "俄勒冈州",
+		"US-PA":  "宾夕法尼亚州",
+		"US-PR":  "波多黎各",
+		"US-RI":  "罗德岛州",
+		"US-SC":  "南卡罗莱那州",
+		"US-SD":  "南达科他州",
+		"US-TN":  "田纳西州",
+		"US-TX":  "得克萨斯州",
+		"US-UM":  "美国本土外小岛屿",
+		"US-UT":  "犹他州",
+		"US-VA":  "弗吉尼亚州",
+		"US-VI":  "美属维尔京群岛",
+		"US-VT":  "佛蒙特州",
+		"US-WA":  "华盛顿州",
+		"US-WI":  "威斯康星州",
+		"US-WV":  "西维吉尼亚州",
+		"US-WY":  "怀俄明州",
+		"UY-AR":  "阿蒂加斯",
+		"UY-CA":  "卡内洛内斯",
+		"UY-CL":  "塞罗拉尔戈",
+		"UY-CO":  "科洛尼亚",
+		"UY-DU":  "杜拉斯诺",
+		"UY-FD":  "佛罗里达州",
+		"UY-FS":  "弗洛雷斯",
+		"UY-LA":  "拉瓦列哈",
+		"UY-MA":  "马尔多纳多",
+		"UY-MO":  "蒙特维多",
+		"UY-RO":  "罗恰",
+		"UY-RV":  "里维拉",
+		"UY-SA":  "萨尔托",
+		"UY-SO":  "索里亚诺",
+		"UY-TT":  "特雷因塔伊特雷斯",
+		"UZ-AN":  "安集延",
+		"UZ-BU":  "布哈拉",
+		"UZ-JI":  "吉扎克",
+		"UZ-NG":  "纳曼干",
+		"UZ-NW":  "纳沃伊",
+		"UZ-QA":  "卡什卡达里亚",
+		"UZ-SA":  "撒马尔罕",
+		"UZ-SI":  "锡尔河",
+		"UZ-SU":  "苏尔汉河",
+		"UZ-TK":  "塔什干",
+		"UZ-TO":  "塔什干",
+		"UZ-XO":  "花拉子模",
+		"VC-01":  "沙洛",
+		"VC-02":  "圣安德鲁斯",
+		"VC-03":  "圣大卫",
+		"VC-04":  "圣乔治",
+		"VC-05":  "圣派屈克",
+		"VC-06":  "格瑞那丁",
+		"VE-B":   "安索阿特吉州",
+		"VE-C":   "阿普雷州",
+		"VE-D":   "阿拉瓜州",
+		"VE-E":   "巴里纳斯州",
+		"VE-F":   "玻利瓦尔省",
+		"VE-G":   "卡拉沃沃州",
+		"VE-H":   "科赫德斯州",
+		"VE-I":   "法尔孔州",
+		"VE-J":   "瓜里科州",
+		"VE-K":   "拉腊州",
+		"VE-L":   "梅里达州",
+		"VE-M":   "米兰达州",
+		"VE-N":   "莫纳加斯州",
+		"VE-O":   "新埃斯帕塔州",
+		"VE-P":   "波图格萨州",
+		"VE-R":   "苏克雷",
+		"VE-S":   "塔奇拉州",
+		"VE-T":   "特鲁希略州",
+		"VE-U":   "亚拉奎州",
+		"VE-V":   "苏利亚州",
+		"VE-W":   "联邦属地",
+		"VE-Y":   "阿马库罗三角洲",
+		"VE-Z":   "亚马孙州",
+		"VN-01":  "莱州省",
+		"VN-02":  "老街省",
+		"VN-03":  "河江省",
+		"VN-04":  "高平省",
+		"VN-05":  "山罗省",
+		"VN-06":  "安沛省",
+		"VN-07":  "宣光省",
+		"VN-09":  "谅山省",
+		"VN-13":  "广宁省",
+		"VN-18":  "宁平省",
+		"VN-20":  "太平省",
+		"VN-21":  "清化省",
+		"VN-22":  "义安省",
+		"VN-24":  "广平省",
+		"VN-25":  "广治省",
+		"VN-26":  "承天顺化省",
+		"VN-27":  "广南省",
+		"VN-28":  "昆嵩省",
+		"VN-29":  "广义省",
+		"VN-30":  "嘉莱省",
+		"VN-31":  "平定省",
+		"VN-32":  "富安省",
+		"VN-34":  "庆和省",
+		"VN-35":  "林同省",
+		"VN-36":  "宁顺省",
+		"VN-37":  "西宁省",
+		"VN-39":  "同奈省",
+		"VN-40":  "平顺省",
+		"VN-41":  "隆安省",
+		"VN-44":  "安江省",
+		"VN-45":  "同塔省",
+		"VN-46":  "前江省",
+		"VN-49":  "永隆省",
+		"VN-50":  "槟知省",
+		"VN-51":  "茶荣省",
+		"VN-52":  "朔庄省",
+		"VN-53":  "北干省",
+		"VN-54":  "北江省",
+		"VN-55":  "薄辽省",
+		"VN-56":  "北宁省",
+		"VN-57":  "平阳省",
+		"VN-58":  "平福省",
+		"VN-59":  "金瓯省",
+		"VN-63":  "河南省",
+		"VN-66":  "兴安省",
+		"VN-67":  "南定省",
+		"VN-68":  "富寿省",
+		"VN-69":  "太原省",
+		"VN-70":  "永富省",
+		"VN-71":  "奠边省",
+		"VN-72":  "得农省",
+		"VN-73":  "后江省",
+		"VN-CT":  "芹苴市",
+		"VU-MAP": "马朗帕",
+		"VU-SAM": "桑马",
+		"VU-TOB": "托尔巴",
+		"WS-AA":  "阿纳",
+		"WS-AL":  "艾加伊勒泰",
+		"WS-AT":  "阿图阿",
+		"WS-GE":  "加加埃毛加",
+		"WS-GI":  "加盖福毛加",
+		"WS-PA":  "帕劳利",
+		"WS-SA":  "萨图帕伊泰阿",
+		"WS-TU":  "图阿马萨加",
+		"WS-VF":  "瓦奥福诺蒂",
+		"WS-VS":  "韦西加诺",
+		"YE-IB":  "伊卜",
+		"YE-MR":  "马哈拉",
+		"YE-SH":  "夏卜瓦",
+		"ZA-EC":  "东开普省",
+		"ZA-FS":  "奥兰治自由邦",
+		"ZA-GP":  "豪登省",
+		"ZA-KZN": "夸祖鲁-纳塔尔省",
+		"ZA-LP":  "林波波省",
+		"ZA-MP":  "普马兰加省",
+		"ZA-NC":  "北开普省",
+		"ZA-WC":  "西开普省",
+		"ZM-01":  "西部区",
+		"ZM-02":  "中部",
+		"ZM-03":  "东部区",
+		"ZM-04":  "卢阿普拉省",
+		"ZM-05":  "北部区",
+		"ZM-06":  "西北省",
+		"ZM-08":  "铜带省",
+		"ZM-09":  "卢萨卡省",
+		"ZW-BU":  "布拉瓦约",
+		"ZW-HA":  "哈拉雷",
+		"ZW-MA":  "马尼卡兰",
+		"ZW-MC":  "中马绍纳兰",
+		"ZW-ME":  "东马绍纳兰",
+		"ZW-MI":  "中部",
+		"ZW-MN":  "北马塔贝莱兰",
+		"ZW-MS":  "南马塔贝莱兰",
+		"ZW-MV":  "马斯温戈",
+		"ZW-MW":  "西马绍纳兰",
+	},
+}
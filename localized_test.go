@@ -0,0 +1,106 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "testing"
+
+func TestLocalizedCountryName(t *testing.T) {
+	gir := &GeoIPRecord{CountryCode: "ZA", CountryName: "South Africa"}
+	tests := []struct {
+		lang, want string
+	}{
+		{"de", "Südafrika"},
+		{"de-AT", "Südafrika"},
+		{"pt", "África do Sul"},
+		{"pt_BR", "África do Sul"},
+		{"zh-CN", "南非"},
+		{"en", "South Africa"},
+		{"xx", "South Africa"},
+	}
+	for _, test := range tests {
+		if got := gir.LocalizedCountryName(test.lang); got != test.want {
+			t.Fatalf("%v: got %q, want %q", test.lang, got, test.want)
+		}
+	}
+	if got := (&GeoIPRecord{CountryCode: "A1", CountryName: "Anonymous Proxy"}).LocalizedCountryName("de"); got != "Anonymous Proxy" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestLocalizedSubdivisionName(t *testing.T) {
+	gir := &GeoIPRecord{CountryCode: "ZA", SubdivisionCode: "ZA-WC", SubdivisionName: "Western Cape"}
+	if got := gir.LocalizedSubdivisionName("fr"); got != "Cap-Occidental" {
+		t.Fatalf("got %q", got)
+	}
+	if got := gir.LocalizedSubdivisionName("es"); got != "Western Cape" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestSetLocalizedNames(t *testing.T) {
+	SetLocalizedNames("af", map[string]string{"za": "Suid-Afrika"}, map[string]string{"ZA-WC": "Wes-Kaap"})
+	defer func() {
+		localizedMu.Lock()
+		delete(countryNames, "af")
+		delete(subdivisionNames, "af")
+		localizedMu.Unlock()
+	}()
+	if got := CountryByCode("ZA").LocalizedName("af"); got != "Suid-Afrika" {
+		t.Fatalf("got %q", got)
+	}
+	if got := SubdivisionByCode("ZA-WC").LocalizedName("af"); got != "Wes-Kaap" {
+		t.Fatalf("got %q", got)
+	}
+	if got := CountryByCode("NL").LocalizedName("af"); got != "Netherlands" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestLocalizedTags(t *testing.T) {
+	for _, test := range []struct{ in, want string }{
+		{"pt_br", "pt-BR"},
+		{"ZH-hant-tw", "zh-Hant-TW"},
+		{"DE", "de"},
+		{"es-419", "es-419"},
+	} {
+		if got := canonicalTag(test.in); got != test.want {
+			t.Fatalf("%v: got %v, want %v", test.in, got, test.want)
+		}
+	}
+
+	SetLocalizedNames("PT_br", map[string]string{"XK": "Kosovo (pt)"}, nil)
+	SetLocalizedNames("de-CH", map[string]string{"DE": "Deutschland (CH)"}, nil)
+	defer func() {
+		localizedMu.Lock()
+		delete(countryNames["pt-BR"], "XK")
+		delete(countryNames, "de-CH")
+		localizedMu.Unlock()
+	}()
+	if _, ok := countryNames["PT_br"]; ok {
+		t.Fatal("tag not canonicalized")
+	}
+	if got := localizedName(countryNames, "pt", "XK"); got != "Kosovo (pt)" {
+		t.Fatalf("got %q", got)
+	}
+	if got := CountryByCode("ZA").LocalizedName("pt-br"); got != "África do Sul" {
+		t.Fatalf("embedded names replaced: %q", got)
+	}
+	if got := CountryByCode("DE").LocalizedName("de_ch"); got != "Deutschland (CH)" {
+		t.Fatalf("got %q", got)
+	}
+	if got := CountryByCode("ZA").LocalizedName("de-CH"); got != "Südafrika" {
+		t.Fatalf("no fallback to de: %q", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"bytes"
+	"net"
+)
+
+// Networks returns the smallest list of CIDR networks that covers exactly
+// the ranges of the database whose records match. Adjacent ranges are
+// aggregated even if their records differ.
+func (gi *GeoIP) Networks(match func(gir *GeoIPRecord) bool) ([]*net.IPNet, error) {
+	var merged []*Range
+	err := gi.Walk(func(r *Range) error {
+		if match(r.Record) {
+			merged = appendRange(merged, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var nets []*net.IPNet
+	for _, r := range merged {
+		nets = append(nets, r.Networks()...)
+	}
+	return nets, nil
+}
+
+// CountryNetworks returns the smallest list of CIDR networks that covers
+// exactly the ranges of the database in the given countries.
+func (gi *GeoIP) CountryNetworks(codes ...string) ([]*net.IPNet, error) {
+	return gi.Networks(func(gir *GeoIPRecord) bool {
+		return containsCode(codes, gir.CountryCode)
+	})
+}
+
+// appendRange appends r to ranges, which are sorted and do not overlap,
+// extending the last range instead if r directly follows it.
+func appendRange(ranges []*Range, r *Range) []*Range {
+	if n := len(ranges); n > 0 && bytes.Equal(nextIP(ranges[n-1].End), r.Start) {
+		ranges[n-1].End = r.End
+		return ranges
+	}
+	return append(ranges, &Range{Start: r.Start, End: r.End})
+}
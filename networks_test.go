@@ -0,0 +1,37 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import "testing"
+
+func TestAppendRange(t *testing.T) {
+	var ranges []*Range
+	walk := fakeWalk("ZA", "ZA", "", "NL")
+	walk(func(r *Range) error {
+		if r.Record != nil {
+			ranges = appendRange(ranges, r)
+		}
+		return nil
+	})
+	if len(ranges) != 2 {
+		t.Fatalf("%v", ranges)
+	}
+	if nets := ranges[0].Networks(); len(nets) != 1 || nets[0].String() != "0.0.0.0/1" {
+		t.Fatalf("%v", nets)
+	}
+	if nets := ranges[1].Networks(); len(nets) != 1 || nets[0].String() != "192.0.0.0/2" {
+		t.Fatalf("%v", nets)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"strings"
+)
+
+type Action int
+
+const (
+	Allow Action = iota
+	Deny
+)
+
+func (a Action) String() string {
+	if a == Deny {
+		return "deny"
+	}
+	return "allow"
+}
+
+// Policy decides whether addresses may connect based on their country.
+//
+// Country rules are more specific than continent rules and are checked
+// first, and deny rules win over allow rules at the same level.
+// Addresses that match no rule get the Default action, and addresses
+// whose country is unknown get the Unknown action, so setting Unknown
+// to Allow fails open and setting it to Deny fails closed.
+//
+// Addresses are looked up with a Resolver rather than with
+// CountryCodeByIPv4 and CountryCodeByIPv6, because continent rules need
+// the continent of the record too. A *GeoIP is a Resolver, so a country
+// database handle can be passed as it is: its Lookup makes the same
+// country lookup as CountryCodeByIPv4 and CountryCodeByIPv6, and also
+// fills in the continent.
+type Policy struct {
+	AllowCountries  []string
+	DenyCountries   []string
+	AllowContinents []string
+	DenyContinents  []string
+	Default         Action
+	Unknown         Action
+}
+
+// Decide returns the action for an address with the record gir, which can be nil.
+func (p *Policy) Decide(gir *GeoIPRecord) Action {
+	if gir == nil || len(gir.CountryCode) == 0 {
+		return p.Unknown
+	}
+	switch {
+	case containsCode(p.DenyCountries, gir.CountryCode):
+		return Deny
+	case containsCode(p.AllowCountries, gir.CountryCode):
+		return Allow
+	case len(gir.ContinentCode) == 0:
+	case containsCode(p.DenyContinents, gir.ContinentCode):
+		return Deny
+	case containsCode(p.AllowContinents, gir.ContinentCode):
+		return Allow
+	}
+	return p.Default
+}
+
+// Allowed reports whether ip, looked up with res, may connect.
+func (p *Policy) Allowed(res Resolver, ip net.IP) bool {
+	return p.Decide(res.Lookup(ip)) == Allow
+}
+
+// Listener returns a listener that closes the connections from l that the
+// policy denies, looking up their remote addresses with res. Connections
+// without an IP remote address get the Unknown action.
+func (p *Policy) Listener(l net.Listener, res Resolver) net.Listener {
+	return &policyListener{Listener: l, policy: p, res: res}
+}
+
+type policyListener struct {
+	net.Listener
+	policy *Policy
+	res    Resolver
+}
+
+func (l *policyListener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		ip := addrIP(c.RemoteAddr())
+		if ip == nil && l.policy.Unknown == Allow || ip != nil && l.policy.Allowed(l.res, ip) {
+			return c, nil
+		}
+		c.Close()
+	}
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	case *net.IPAddr:
+		return a.IP
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if strings.EqualFold(c, code) {
+			return true
+		}
+	}
+	return false
+}
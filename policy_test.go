@@ -0,0 +1,110 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"sync"
+	"testing"
+)
+
+func TestPolicyDecide(t *testing.T) {
+	p := &Policy{
+		AllowContinents: []string{"EU"},
+		DenyCountries:   []string{"by"},
+		AllowCountries:  []string{"ZA"},
+		DenyContinents:  []string{"AF"},
+		Default:         Deny,
+		Unknown:         Allow,
+	}
+	tests := []struct {
+		gir  *GeoIPRecord
+		want Action
+	}{
+		{&GeoIPRecord{CountryCode: "NL", ContinentCode: "EU"}, Allow},
+		{&GeoIPRecord{CountryCode: "BY", ContinentCode: "EU"}, Deny},
+		{&GeoIPRecord{CountryCode: "ZA", ContinentCode: "AF"}, Allow},
+		{&GeoIPRecord{CountryCode: "NG", ContinentCode: "AF"}, Deny},
+		{&GeoIPRecord{CountryCode: "US", ContinentCode: "NA"}, Deny},
+		{&GeoIPRecord{CountryCode: "US"}, Deny},
+		{&GeoIPRecord{}, Allow},
+		{nil, Allow},
+	}
+	for i, test := range tests {
+		if got := p.Decide(test.gir); got != test.want {
+			t.Fatalf("%v: got %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+// countryResolver maps addresses to country codes. The listener looks
+// addresses up from its own goroutine, so the map is guarded.
+type countryResolver struct {
+	mu    sync.Mutex
+	codes map[string]string
+}
+
+func (r *countryResolver) Lookup(ip net.IP) *GeoIPRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if code, ok := r.codes[ip.String()]; ok {
+		return &GeoIPRecord{CountryCode: code}
+	}
+	return nil
+}
+
+func (r *countryResolver) set(ip, code string) {
+	r.mu.Lock()
+	r.codes[ip] = code
+	r.mu.Unlock()
+}
+
+func TestPolicyListener(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	res := &countryResolver{codes: map[string]string{"127.0.0.1": "ZA"}}
+	pl := (&Policy{DenyCountries: []string{"ZA"}}).Listener(l, res)
+	defer pl.Close()
+
+	accepted := make(chan bool)
+	go func() {
+		c, err := pl.Accept()
+		if err == nil {
+			c.Close()
+		}
+		accepted <- err == nil
+	}()
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the policy listener closes the connection without handing it out
+	if n, err := c.Read(make([]byte, 1)); n != 0 || err == nil {
+		t.Fatalf("read %v %v", n, err)
+	}
+	c.Close()
+
+	res.set("127.0.0.1", "NL")
+	c, err = net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if !<-accepted {
+		t.Fatal("allowed connection was not accepted")
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Pool is a NetworkResolver that spreads lookups over several handles of
+// the same database, so that city lookups, which are serialized on a
+// single handle, can run in parallel. Every handle loads its own copy of
+// the database into memory.
+//
+// Reopen reloads the database file in place. A Cache in front of a Pool
+// is emptied when it does.
+type Pool struct {
+	filename string
+	opts     *Options
+	reloads  uint64 // accessed atomically
+
+	reopenMu sync.Mutex // serializes Reopen
+
+	mu      sync.RWMutex
+	handles *poolHandles
+	owners  map[*GeoIP]*poolHandles
+}
+
+// poolHandles are the handles of one opening of the database file.
+type poolHandles struct {
+	free chan *GeoIP
+	all  []*GeoIP
+}
+
+// OpenPool opens size handles of the named database, or GOMAXPROCS
+// handles if size is not positive.
+func OpenPool(filename string, size int) (*Pool, error) {
+	return OpenPoolWithOptions(filename, size, nil)
+}
+
+// OpenPoolWithOptions is like OpenPool, but opens the handles with
+// OpenWithOptions.
+func OpenPoolWithOptions(filename string, size int, opts *Options) (*Pool, error) {
+	if size <= 0 {
+		size = runtime.GOMAXPROCS(0)
+	}
+	handles, err := openPoolHandles(filename, size, opts)
+	if err != nil {
+		return nil, err
+	}
+	p := &Pool{filename: filename, opts: opts, owners: make(map[*GeoIP]*poolHandles)}
+	p.setHandles(handles)
+	return p, nil
+}
+
+func openPoolHandles(filename string, size int, opts *Options) (*poolHandles, error) {
+	h := &poolHandles{free: make(chan *GeoIP, size)}
+	for i := 0; i < size; i++ {
+		gi, err := OpenWithOptions(filename, opts)
+		if err != nil {
+			h.delete()
+			return nil, err
+		}
+		h.all = append(h.all, gi)
+		h.free <- gi
+	}
+	return h, nil
+}
+
+func (h *poolHandles) delete() {
+	for _, gi := range h.all {
+		gi.Delete()
+	}
+}
+
+func (p *Pool) setHandles(h *poolHandles) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handles = h
+	for _, gi := range h.all {
+		p.owners[gi] = h
+	}
+}
+
+// Get takes a handle from the pool, waiting until one is free.
+// It must be returned with Put.
+func (p *Pool) Get() *GeoIP {
+	p.mu.RLock()
+	h := p.handles
+	p.mu.RUnlock()
+	return <-h.free
+}
+
+func (p *Pool) Put(gi *GeoIP) {
+	p.mu.RLock()
+	h := p.owners[gi]
+	p.mu.RUnlock()
+	h.free <- gi
+}
+
+func (p *Pool) Lookup(ip net.IP) *GeoIPRecord {
+	gi := p.Get()
+	defer p.Put(gi)
+	return gi.Lookup(ip)
+}
+
+func (p *Pool) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	gi := p.Get()
+	defer p.Put(gi)
+	return gi.LookupNetwork(ip)
+}
+
+// Reopen opens the database file again, for example after it was
+// updated, and replaces the handles of the pool with the new ones. The
+// old handles are deleted once the lookups using them have finished. If
+// the file cannot be opened, the pool keeps its handles.
+func (p *Pool) Reopen() error {
+	p.reopenMu.Lock()
+	defer p.reopenMu.Unlock()
+	p.mu.RLock()
+	old := p.handles
+	p.mu.RUnlock()
+	handles, err := openPoolHandles(p.filename, len(old.all), p.opts)
+	if err != nil {
+		return err
+	}
+	p.setHandles(handles)
+	atomic.AddUint64(&p.reloads, 1)
+	for range old.all {
+		<-old.free
+	}
+	p.mu.Lock()
+	for _, gi := range old.all {
+		delete(p.owners, gi)
+	}
+	p.mu.Unlock()
+	old.delete()
+	return nil
+}
+
+// Reloads returns the number of times the pool was reopened.
+func (p *Pool) Reloads() uint64 {
+	return atomic.LoadUint64(&p.reloads)
+}
+
+// Delete deletes all the handles. The pool must not be used afterwards.
+func (p *Pool) Delete() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handles.delete()
+}
@@ -0,0 +1,117 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"math"
+	"net"
+)
+
+// Default prefix lengths that an Anonymizer truncates addresses to.
+const (
+	DefaultIPv4Prefix = 24
+	DefaultIPv6Prefix = 48
+)
+
+// Anonymizer is a Resolver that only returns coarse locations, and marks
+// the records it returns as anonymized.
+//
+// Addresses are truncated to IPv4Prefix or IPv6Prefix bits before the
+// lookup. If Grid is set, coordinates are rounded to the nearest multiple
+// of Grid degrees, or, if Snap is set, moved to the centre of the grid
+// cell that contains them. Latitudes stay within the poles, longitudes
+// wrap at the antimeridian, and a location that would round to 0,0, which
+// means no location, is moved to the centre of its cell. If MinPopulation
+// is set, City and PostalCode are dropped unless Population reports at
+// least MinPopulation people for the record. The databases have no
+// population data, so without a Population function they are always
+// dropped.
+type Anonymizer struct {
+	Resolver      Resolver
+	IPv4Prefix    int
+	IPv6Prefix    int
+	Grid          float64
+	Snap          bool
+	MinPopulation int
+	Population    func(gir *GeoIPRecord) int
+}
+
+// Truncate returns ip with all but the first IPv4Prefix or IPv6Prefix bits cleared.
+func (a *Anonymizer) Truncate(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(orDefaultPrefix(a.IPv4Prefix, DefaultIPv4Prefix), 8*net.IPv4len))
+	}
+	return ip.Mask(net.CIDRMask(orDefaultPrefix(a.IPv6Prefix, DefaultIPv6Prefix), 8*net.IPv6len))
+}
+
+func (a *Anonymizer) Lookup(ip net.IP) *GeoIPRecord {
+	ip = a.Truncate(ip)
+	if ip == nil {
+		return nil
+	}
+	gir := a.Resolver.Lookup(ip)
+	if gir == nil {
+		return nil
+	}
+	return a.Anonymize(gir)
+}
+
+// Anonymize returns a coarsened copy of gir.
+func (a *Anonymizer) Anonymize(gir *GeoIPRecord) *GeoIPRecord {
+	r := *gir
+	if a.Grid > 0 && r.HasLocation() {
+		r.Latitude, r.Longitude = a.coarsenLocation(r.Latitude, r.Longitude, a.Snap)
+		if !r.HasLocation() {
+			// 0,0 means no location, so use the centre of the cell
+			// instead, which is never on the equator and meridian.
+			r.Latitude, r.Longitude = a.coarsenLocation(gir.Latitude, gir.Longitude, true)
+		}
+	}
+	if a.MinPopulation > 0 && (a.Population == nil || a.Population(gir) < a.MinPopulation) {
+		r.City = ""
+		r.PostalCode = ""
+	}
+	r.Anonymized = true
+	return &r
+}
+
+// coarsenLocation coarsens a location to the grid, keeping the latitude
+// within the poles and the longitude within the antimeridian.
+func (a *Anonymizer) coarsenLocation(lat, lon float64, snap bool) (float64, float64) {
+	lat = math.Max(-90, math.Min(90, a.coarsen(lat, snap)))
+	lon = a.coarsen(lon, snap)
+	if lon < -180 || lon > 180 {
+		lon = math.Mod(lon+180, 360)
+		if lon < 0 {
+			lon += 360
+		}
+		lon -= 180
+	}
+	return lat, lon
+}
+
+func (a *Anonymizer) coarsen(deg float64, snap bool) float64 {
+	if snap {
+		return (math.Floor(deg/a.Grid) + 0.5) * a.Grid
+	}
+	return math.Floor(deg/a.Grid+0.5) * a.Grid
+}
+
+func orDefaultPrefix(ones, def int) int {
+	if ones <= 0 {
+		return def
+	}
+	return ones
+}
@@ -0,0 +1,98 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"testing"
+)
+
+func TestAnonymizerTruncate(t *testing.T) {
+	a := new(Anonymizer)
+	tests := []struct {
+		ip, want string
+	}{
+		{"192.0.2.123", "192.0.2.0"},
+		{"::ffff:192.0.2.123", "192.0.2.0"},
+		{"2001:db8:1:2:3::4", "2001:db8:1::"},
+	}
+	for _, test := range tests {
+		if got := a.Truncate(net.ParseIP(test.ip)); got.String() != test.want {
+			t.Fatalf("%v: got %v, want %v", test.ip, got, test.want)
+		}
+	}
+	a.IPv4Prefix, a.IPv6Prefix = 16, 32
+	if got := a.Truncate(net.ParseIP("2001:db8:1:2:3::4")).String(); got != "2001:db8::" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+type anonResolver struct {
+	lookups []string
+}
+
+func (r *anonResolver) Lookup(ip net.IP) *GeoIPRecord {
+	r.lookups = append(r.lookups, ip.String())
+	return &GeoIPRecord{CountryCode: "ZA", City: "Stellenbosch", PostalCode: "7600", Latitude: -33.934, Longitude: 18.861}
+}
+
+func TestAnonymizer(t *testing.T) {
+	res := new(anonResolver)
+	a := &Anonymizer{Resolver: res, Grid: 0.5, MinPopulation: 100000}
+	gir := a.Lookup(net.ParseIP("192.0.2.123"))
+	if res.lookups[0] != "192.0.2.0" {
+		t.Fatalf("looked up %v", res.lookups[0])
+	}
+	if !gir.Anonymized || gir.City != "" || gir.PostalCode != "" || gir.CountryCode != "ZA" {
+		t.Fatalf("%+v", gir)
+	}
+	if !near(gir.Latitude, -34, 1e-9) || !near(gir.Longitude, 19, 1e-9) {
+		t.Fatalf("%v,%v", gir.Latitude, gir.Longitude)
+	}
+
+	a.Snap = true
+	a.Population = func(gir *GeoIPRecord) int { return 180000 }
+	gir = a.Lookup(net.ParseIP("192.0.2.1"))
+	if gir.City != "Stellenbosch" || gir.PostalCode != "7600" {
+		t.Fatalf("%+v", gir)
+	}
+	if !near(gir.Latitude, -33.75, 1e-9) || !near(gir.Longitude, 18.75, 1e-9) {
+		t.Fatalf("%v,%v", gir.Latitude, gir.Longitude)
+	}
+}
+
+func TestAnonymizerBounds(t *testing.T) {
+	tests := []struct {
+		grid     float64
+		snap     bool
+		lat, lon float64
+		wlat     float64
+		wlon     float64
+	}{
+		{7, false, 89.9, 179.9, 90, -178},
+		{40, true, 89.9, -179.9, 90, -180},
+		{7, false, -30, -179.9, -28, 178},
+		{0.5, false, -89.9, 180, -90, 180},
+		{0.5, false, 0.1, -0.2, 0.25, -0.25},
+		{10, true, 0.1, -0.2, 5, -5},
+	}
+	for _, test := range tests {
+		a := &Anonymizer{Grid: test.grid, Snap: test.snap}
+		gir := a.Anonymize(&GeoIPRecord{Latitude: test.lat, Longitude: test.lon})
+		if !near(gir.Latitude, test.wlat, 1e-9) || !near(gir.Longitude, test.wlon, 1e-9) {
+			t.Fatalf("%+v: got %v,%v", test, gir.Latitude, gir.Longitude)
+		}
+	}
+}
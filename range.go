@@ -0,0 +1,160 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+)
+
+// Range is a contiguous block of addresses that share the same record.
+type Range struct {
+	Start  net.IP
+	End    net.IP
+	Record *GeoIPRecord
+}
+
+// Networks returns the smallest list of CIDR networks that exactly covers the range.
+func (r *Range) Networks() []*net.IPNet {
+	return rangeToCIDRs(r.Start, r.End)
+}
+
+// Adjacent reports whether s starts right after the end of r.
+func (r *Range) Adjacent(s *Range) bool {
+	return !isLastIP(r.End) && bytes.Equal(nextIP(r.End), s.Start)
+}
+
+// Walk calls fn for every range in the database in address order.
+// Adjacent ranges with identical records are merged and addresses
+// without data are skipped. Walk stops at the first error returned by fn
+// and returns it. Country, city, organization and ASN databases can be
+// walked, both IPv4 and IPv6 editions.
+func (gi *GeoIP) Walk(fn func(r *Range) error) error {
+	return gi.walk(func(r *Range) error {
+		if r.Record == nil {
+			return nil
+		}
+		return fn(r)
+	})
+}
+
+// walk is like Walk, but also reports the ranges without data, so that
+// the ranges passed to fn cover the whole address space.
+func (gi *GeoIP) walk(fn func(r *Range) error) error {
+	size := net.IPv4len
+	if gi.IsIPv6Database() {
+		size = net.IPv6len
+	}
+	ip := make(net.IP, size)
+	var cur *Range
+	for {
+		gir, netmask, err := gi.lookupRange(ip)
+		if err != nil {
+			return err
+		}
+		if netmask < 1 || netmask > size*8 {
+			return fmt.Errorf("invalid netmask %v for %v", netmask, ip)
+		}
+		end := lastIP(ip, netmask)
+		if cur != nil && equalRecords(cur.Record, gir) {
+			cur.End = end
+		} else {
+			if cur != nil {
+				if err := fn(cur); err != nil {
+					return err
+				}
+			}
+			cur = &Range{Start: ip, End: end, Record: gir}
+		}
+		if isLastIP(end) {
+			return fn(cur)
+		}
+		ip = nextIP(end)
+	}
+}
+
+func countryRecord(id int) *GeoIPRecord {
+	if id <= 0 {
+		return nil
+	}
+	return &GeoIPRecord{
+		CountryCode:   CodeByID(id),
+		CountryCode3:  Code3ByID(id),
+		CountryName:   NameByID(id),
+		ContinentCode: ContinentByID(id),
+	}
+}
+
+func equalRecords(a, b *GeoIPRecord) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// lastIP returns the last address in the network of ip with the given prefix length.
+func lastIP(ip net.IP, ones int) net.IP {
+	mask := net.CIDRMask(ones, len(ip)*8)
+	last := make(net.IP, len(ip))
+	for i := range ip {
+		last[i] = ip[i] | ^mask[i]
+	}
+	return last
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func isLastIP(ip net.IP) bool {
+	for _, b := range ip {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// rangeToCIDRs splits the inclusive range start-end into the fewest
+// CIDR networks. start and end must have the same length.
+func rangeToCIDRs(start, end net.IP) (nets []*net.IPNet) {
+	bits := len(start) * 8
+	for bytes.Compare(start, end) <= 0 {
+		ones := bits
+		for ones > 0 {
+			mask := net.CIDRMask(ones-1, bits)
+			if !bytes.Equal(start.Mask(mask), start) || bytes.Compare(lastIP(start, ones-1), end) > 0 {
+				break
+			}
+			ones--
+		}
+		nets = append(nets, &net.IPNet{IP: start, Mask: net.CIDRMask(ones, bits)})
+		last := lastIP(start, ones)
+		if isLastIP(last) {
+			break
+		}
+		start = nextIP(last)
+	}
+	return
+}
@@ -0,0 +1,49 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"testing"
+)
+
+func TestRangeAdjacent(t *testing.T) {
+	tests := []struct {
+		end, start string
+		want       bool
+	}{
+		{"10.0.0.255", "10.0.1.0", true},
+		{"10.0.0.255", "10.0.1.1", false},
+		{"10.0.0.255", "10.0.0.255", false},
+		{"255.255.255.255", "0.0.0.0", false},
+		{"::ffff", "::1:0", true},
+	}
+	for _, test := range tests {
+		r := &Range{End: parseIP(test.end)}
+		s := &Range{Start: parseIP(test.start)}
+		if got := r.Adjacent(s); got != test.want {
+			t.Fatalf("%v, %v: got %v", test.end, test.start, got)
+		}
+	}
+}
+
+// parseIP parses an address in the 4 or 16 byte form that walks use.
+func parseIP(s string) net.IP {
+	ip := net.ParseIP(s)
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
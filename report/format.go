@@ -0,0 +1,118 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+func (e *Entry) label() string {
+	if len(e.Key) == 0 {
+		return "(unknown)"
+	}
+	return e.Key
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+}
+
+// WriteText writes the top n entries of every dimension as aligned tables.
+func (r *Report) WriteText(w io.Writer, n int) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "requests:\t%v\nunique addresses:\t%v\nunresolved:\t%v (%v)\n",
+		r.Requests, r.IPs(), r.Unresolved, percent(r.Unresolved, r.Requests))
+	for _, d := range Dimensions {
+		fmt.Fprintf(tw, "\n%v\tname\trequests\t\tunique addresses\n", d)
+		for _, e := range r.Top(d, n) {
+			fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\n", e.label(), e.Name, e.Requests, percent(e.Requests, r.Requests), e.IPs)
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteCSV writes the top n entries of every dimension as CSV records
+// with the fields dimension, key, name, requests and unique addresses.
+func (r *Report) WriteCSV(w io.Writer, n int) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"dimension", "key", "name", "requests", "ips"})
+	for _, d := range Dimensions {
+		for _, e := range r.Top(d, n) {
+			cw.Write([]string{d.String(), e.Key, e.Name, strconv.Itoa(e.Requests), strconv.Itoa(e.IPs)})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{"percent": percent}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Traffic by location</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { padding: 0.2em 0.8em; border-bottom: 1px solid #ddd; }
+td.n { text-align: right; }
+</style>
+</head>
+<body>
+<h1>Traffic by location</h1>
+<p>{{.Report.Requests}} requests from {{.Report.IPs}} unique addresses, {{.Report.Unresolved}} ({{percent .Report.Unresolved .Report.Requests}}) unresolved.</p>
+{{range .Tables}}
+<h2>{{.Dimension}}</h2>
+<table>
+<tr><th></th><th>name</th><th>requests</th><th></th><th>unique addresses</th></tr>
+{{range .Entries}}<tr><td>{{.Label}}</td><td>{{.Name}}</td><td class="n">{{.Requests}}</td><td class="n">{{percent .Requests $.Report.Requests}}</td><td class="n">{{.IPs}}</td></tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
+
+type htmlEntry struct {
+	*Entry
+	Label string
+}
+
+type htmlTable struct {
+	Dimension Dimension
+	Entries   []htmlEntry
+}
+
+// WriteHTML writes the top n entries of every dimension as an HTML page.
+func (r *Report) WriteHTML(w io.Writer, n int) error {
+	data := struct {
+		Report *Report
+		Tables []htmlTable
+	}{Report: r}
+	for _, d := range Dimensions {
+		t := htmlTable{Dimension: d}
+		for _, e := range r.Top(d, n) {
+			t.Entries = append(t.Entries, htmlEntry{e, e.label()})
+		}
+		data.Tables = append(data.Tables, t)
+	}
+	return htmlTemplate.Execute(w, data)
+}
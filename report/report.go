@@ -0,0 +1,186 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report aggregates requests by country, city, continent and
+// ASN, counting both requests and unique client addresses.
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Dimension is what requests are grouped by.
+type Dimension int
+
+const (
+	Country Dimension = iota
+	City
+	Continent
+	ASN
+)
+
+// Dimensions are all the dimensions, in the order they are reported.
+var Dimensions = []Dimension{Country, City, Continent, ASN}
+
+var dimensionNames = []string{
+	Country:   "country",
+	City:      "city",
+	Continent: "continent",
+	ASN:       "asn",
+}
+
+func (d Dimension) String() string {
+	if d < 0 || int(d) >= len(dimensionNames) {
+		return fmt.Sprintf("Dimension(%d)", int(d))
+	}
+	return dimensionNames[d]
+}
+
+// Entry is the number of requests and unique addresses for one key.
+// An empty key groups the requests whose record has no value for the dimension.
+type Entry struct {
+	Key      string
+	Name     string
+	Requests int
+	IPs      int
+}
+
+// Report counts requests by their client address.
+type Report struct {
+	Resolver geoip.Resolver
+	// Requests is the number of requests added.
+	Requests int
+	// Unresolved is the number of requests without an address or
+	// whose address is not found.
+	Unresolved int
+
+	records map[string]*geoip.GeoIPRecord
+	entries [4]map[string]*Entry
+}
+
+// New returns an empty report that looks up addresses with res.
+func New(res geoip.Resolver) *Report {
+	r := &Report{Resolver: res, records: make(map[string]*geoip.GeoIPRecord)}
+	for i := range r.entries {
+		r.entries[i] = make(map[string]*Entry)
+	}
+	return r
+}
+
+// Add counts a request from ip, which can be nil.
+func (r *Report) Add(ip net.IP) {
+	r.Requests++
+	if ip == nil {
+		r.Unresolved++
+		return
+	}
+	key := ip.String()
+	gir, seen := r.records[key]
+	if !seen {
+		gir = r.Resolver.Lookup(ip)
+		r.records[key] = gir
+	}
+	if gir == nil {
+		r.Unresolved++
+		return
+	}
+	for _, d := range Dimensions {
+		k, name := d.key(gir)
+		e, ok := r.entries[d][k]
+		if !ok {
+			e = &Entry{Key: k, Name: name}
+			r.entries[d][k] = e
+		}
+		e.Requests++
+		if !seen {
+			e.IPs++
+		}
+	}
+}
+
+// Read counts a request for every line of rd, using address to find
+// the client address of a line.
+func (r *Report) Read(rd io.Reader, address func(line []byte) net.IP) error {
+	br := bufio.NewReader(rd)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			r.Add(address(line))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (d Dimension) key(gir *geoip.GeoIPRecord) (key, name string) {
+	switch d {
+	case Country:
+		return gir.CountryCode, gir.CountryName
+	case City:
+		if len(gir.City) == 0 {
+			return "", ""
+		}
+		return gir.CountryCode + "/" + gir.Region + "/" + gir.City, gir.City + ", " + gir.CountryCode
+	case Continent:
+		return gir.ContinentCode, geoip.ContinentName(gir.ContinentCode)
+	case ASN:
+		if gir.ASNumber == 0 {
+			return "", ""
+		}
+		return fmt.Sprintf("AS%d", gir.ASNumber), gir.Organization
+	}
+	return "", ""
+}
+
+// Top returns the n entries of d with the most requests, or all of them if n <= 0.
+func (r *Report) Top(d Dimension, n int) []*Entry {
+	entries := make([]*Entry, 0, len(r.entries[d]))
+	for _, e := range r.entries[d] {
+		entries = append(entries, e)
+	}
+	sort.Sort(byRequests(entries))
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+type byRequests []*Entry
+
+func (s byRequests) Len() int      { return len(s) }
+func (s byRequests) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byRequests) Less(i, j int) bool {
+	if s[i].Requests != s[j].Requests {
+		return s[i].Requests > s[j].Requests
+	}
+	if s[i].IPs != s[j].IPs {
+		return s[i].IPs > s[j].IPs
+	}
+	return s[i].Key < s[j].Key
+}
+
+// IPs returns the number of unique addresses added.
+func (r *Report) IPs() int {
+	return len(r.records)
+}
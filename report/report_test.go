@@ -0,0 +1,97 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+var testResolver = geoiptest.New(map[string]*geoip.GeoIPRecord{
+	"196.213.226.36": {CountryCode: "ZA", CountryName: "South Africa", City: "Cape Town", ContinentCode: "AF"},
+	"196.213.226.37": {CountryCode: "ZA", CountryName: "South Africa", City: "Cape Town", ContinentCode: "AF"},
+	"194.247.30.31":  {CountryCode: "NL", CountryName: "Netherlands", ContinentCode: "EU", ASNumber: 1136, Organization: "KPN"},
+})
+
+const testLog = `196.213.226.36 - - "GET /"
+196.213.226.36 - - "GET /a"
+196.213.226.37 - - "GET /b"
+194.247.30.31 - - "GET /"
+10.0.0.1 - - "GET /"
+garbage
+`
+
+func testReport(t *testing.T) *Report {
+	r := New(testResolver)
+	err := r.Read(strings.NewReader(testLog), func(line []byte) net.IP {
+		return net.ParseIP(string(bytes.Fields(line)[0]))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReport(t *testing.T) {
+	r := testReport(t)
+	if r.Requests != 6 || r.Unresolved != 2 || r.IPs() != 4 {
+		t.Fatalf("%v %v %v", r.Requests, r.Unresolved, r.IPs())
+	}
+	countries := r.Top(Country, 0)
+	if len(countries) != 2 || *countries[0] != (Entry{"ZA", "South Africa", 3, 2}) || *countries[1] != (Entry{"NL", "Netherlands", 1, 1}) {
+		t.Fatalf("%+v", countries)
+	}
+	cities := r.Top(City, 1)
+	if len(cities) != 1 || cities[0].Name != "Cape Town, ZA" {
+		t.Fatalf("%+v", cities)
+	}
+	asns := r.Top(ASN, 0)
+	if len(asns) != 2 || asns[0].Key != "" || asns[1].Key != "AS1136" || asns[1].Name != "KPN" {
+		t.Fatalf("%+v", asns)
+	}
+	if c := r.Top(Continent, 0); c[0].Name != "Africa" {
+		t.Fatalf("%+v", c)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	r := testReport(t)
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "country,ZA,South Africa,3,2\n") {
+		t.Fatalf("%v", buf.String())
+	}
+	buf.Reset()
+	if err := r.WriteText(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "50.0%") {
+		t.Fatalf("%v", buf.String())
+	}
+	buf.Reset()
+	if err := r.WriteHTML(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "<td>AS1136</td><td>KPN</td>") {
+		t.Fatalf("%v", buf.String())
+	}
+}
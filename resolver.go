@@ -0,0 +1,206 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Resolver looks up the record of an address.
+// Lookup returns nil if nothing is known about ip.
+type Resolver interface {
+	Lookup(ip net.IP) *GeoIPRecord
+}
+
+// Lookup returns the record for an IPv4 or IPv6 address in a country,
+// city, ASN, organization or ISP database. Country databases only fill
+// in the country and continent, and ASN, organization and ISP databases
+// only fill in ASNumber and Organization.
+func (gi *GeoIP) Lookup(ip net.IP) *GeoIPRecord {
+	if ip.To4() == nil && !gi.IsIPv6Database() {
+		return nil
+	}
+	gir, _, err := gi.lookupRange(ip)
+	if err != nil {
+		return nil
+	}
+	return gir
+}
+
+// NetworkResolver is a Resolver that also reports the network that the
+// record applies to. The network is returned even if there is no record,
+// and is nil only if the lookup failed.
+type NetworkResolver interface {
+	Resolver
+	LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet)
+}
+
+// LookupNetwork is like Lookup, but also returns the network of the
+// database entry that ip was found in. For IPv6 databases the network
+// of an IPv4 address is an IPv4-mapped IPv6 network.
+func (gi *GeoIP) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	size := net.IPv4len
+	if gi.IsIPv6Database() {
+		size = net.IPv6len
+	} else {
+		ip = ip.To4()
+	}
+	if ip == nil {
+		return nil, nil
+	}
+	gir, netmask, err := gi.lookupRange(ip)
+	if err != nil {
+		return nil, nil
+	}
+	mask := net.CIDRMask(netmask, size*8)
+	return gir, &net.IPNet{IP: ip.To16()[16-size:].Mask(mask), Mask: mask}
+}
+
+// Multi is a Resolver that merges the records of several databases,
+// for example a city database and an ASN database. Fields set by earlier
+// databases take precedence.
+type Multi []*GeoIP
+
+// OpenMulti opens all the named databases.
+func OpenMulti(filenames ...string) (Multi, error) {
+	return OpenMultiWithOptions(nil, filenames...)
+}
+
+// OpenMultiWithOptions is like OpenMulti, but opens the databases with
+// OpenWithOptions.
+func OpenMultiWithOptions(opts *Options, filenames ...string) (Multi, error) {
+	m := make(Multi, 0, len(filenames))
+	for _, filename := range filenames {
+		gi, err := OpenWithOptions(filename, opts)
+		if err != nil {
+			m.Delete()
+			return nil, err
+		}
+		m = append(m, gi)
+	}
+	return m, nil
+}
+
+func (m Multi) Lookup(ip net.IP) (gir *GeoIPRecord) {
+	for _, gi := range m {
+		if other := gi.Lookup(ip); other != nil {
+			if gir == nil {
+				gir = new(GeoIPRecord)
+			}
+			mergeRecord(gir, other)
+		}
+	}
+	return
+}
+
+// LookupNetwork merges the records like Lookup. The network returned is
+// the smallest of the networks of the databases, which all contain ip.
+// Databases of the other address family are skipped, and the network is
+// nil only if no database could look ip up.
+func (m Multi) LookupNetwork(ip net.IP) (*GeoIPRecord, *net.IPNet) {
+	dbs := make([]NetworkResolver, len(m))
+	for i, gi := range m {
+		dbs[i] = gi
+	}
+	return lookupNetworks(dbs, ip)
+}
+
+func lookupNetworks(dbs []NetworkResolver, ip net.IP) (gir *GeoIPRecord, network *net.IPNet) {
+	for _, db := range dbs {
+		other, n := db.LookupNetwork(ip)
+		if n == nil {
+			continue
+		}
+		if network == nil || prefixLen(n) > prefixLen(network) {
+			network = n
+		}
+		if other != nil {
+			if gir == nil {
+				gir = new(GeoIPRecord)
+			}
+			mergeRecord(gir, other)
+		}
+	}
+	return
+}
+
+// prefixLen returns the prefix length of n counted in IPv6 bits, so that
+// IPv4 networks and IPv4-mapped IPv6 networks compare equal.
+func prefixLen(n *net.IPNet) int {
+	ones, bits := n.Mask.Size()
+	return ones + 8*net.IPv6len - bits
+}
+
+// Delete deletes all the databases.
+func (m Multi) Delete() {
+	for _, gi := range m {
+		gi.Delete()
+	}
+}
+
+// mergeRecord sets the fields of dst that are empty to those of src.
+func mergeRecord(dst, src *GeoIPRecord) {
+	mergeString(&dst.CountryCode, src.CountryCode)
+	mergeString(&dst.CountryCode3, src.CountryCode3)
+	mergeString(&dst.CountryName, src.CountryName)
+	mergeString(&dst.Region, src.Region)
+	mergeString(&dst.SubdivisionCode, src.SubdivisionCode)
+	mergeString(&dst.SubdivisionName, src.SubdivisionName)
+	mergeString(&dst.City, src.City)
+	mergeString(&dst.PostalCode, src.PostalCode)
+	mergeString(&dst.ContinentCode, src.ContinentCode)
+	mergeString(&dst.Organization, src.Organization)
+	if dst.Latitude == 0 && dst.Longitude == 0 {
+		dst.Latitude = src.Latitude
+		dst.Longitude = src.Longitude
+	}
+	if dst.AreaCode == 0 {
+		dst.AreaCode = src.AreaCode
+	}
+	if dst.ASNumber == 0 {
+		dst.ASNumber = src.ASNumber
+	}
+	dst.Anonymized = dst.Anonymized || src.Anonymized
+}
+
+func mergeString(dst *string, src string) {
+	if len(*dst) == 0 {
+		*dst = src
+	}
+}
+
+// orgRecord returns the record for a name from an ASN, organization or
+// ISP database. ASN database names look like "AS15169 Google Inc.".
+func orgRecord(name string, asnum bool) *GeoIPRecord {
+	if len(name) == 0 {
+		return nil
+	}
+	gir := &GeoIPRecord{Organization: name}
+	if !asnum || !strings.HasPrefix(name, "AS") {
+		return gir
+	}
+	num := strings.TrimPrefix(name, "AS")
+	org := ""
+	if i := strings.Index(num, " "); i >= 0 {
+		num, org = num[:i], num[i+1:]
+	}
+	if n, err := strconv.Atoi(num); err == nil {
+		gir.ASNumber = n
+		gir.Organization = org
+	}
+	return gir
+}
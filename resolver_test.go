@@ -0,0 +1,45 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"testing"
+)
+
+func TestOrgRecord(t *testing.T) {
+	gir := orgRecord("AS15169 Google Inc.", true)
+	if gir.ASNumber != 15169 || gir.Organization != "Google Inc." {
+		t.Fatalf("%+v", gir)
+	}
+	gir = orgRecord("AS Telecom", true)
+	if gir.ASNumber != 0 || gir.Organization != "AS Telecom" {
+		t.Fatalf("%+v", gir)
+	}
+	gir = orgRecord("AS15169 Google Inc.", false)
+	if gir.ASNumber != 0 || gir.Organization != "AS15169 Google Inc." {
+		t.Fatalf("%+v", gir)
+	}
+	if orgRecord("", true) != nil {
+		t.Fatal("empty name")
+	}
+}
+
+func TestMergeRecord(t *testing.T) {
+	gir := &GeoIPRecord{CountryCode: "ZA", City: "Cape Town", Latitude: -33.9, Longitude: 18.4}
+	mergeRecord(gir, &GeoIPRecord{CountryCode: "NL", CountryName: "South Africa", Latitude: 1, ASNumber: 36937})
+	if gir.CountryCode != "ZA" || gir.CountryName != "South Africa" || gir.Latitude != -33.9 || gir.ASNumber != 36937 {
+		t.Fatalf("%+v", gir)
+	}
+}
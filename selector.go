@@ -0,0 +1,101 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"sort"
+	"strings"
+)
+
+// Endpoint is a server that clients can be sent to.
+type Endpoint struct {
+	Name          string
+	Latitude      float64
+	Longitude     float64
+	CountryCode   string
+	ContinentCode string
+}
+
+// Selector orders endpoints for clients, nearest first.
+//
+// If PreferCountry is set, the endpoints in the country of the client
+// come first, and if PreferContinent is set, the endpoints on the
+// continent of the client come before the remaining ones. Within these
+// groups endpoints are ordered by distance to the client. When the
+// client has no coordinates, or is not found at all, the order of
+// Endpoints is kept, so it should list the default choices first.
+type Selector struct {
+	Resolver        Resolver
+	Endpoints       []*Endpoint
+	PreferCountry   bool
+	PreferContinent bool
+}
+
+// Select returns the endpoints ordered for the client with address ip.
+func (s *Selector) Select(ip net.IP) []*Endpoint {
+	return s.Order(s.Resolver.Lookup(ip))
+}
+
+// Order returns the endpoints ordered for a client with the record gir,
+// which can be nil.
+func (s *Selector) Order(gir *GeoIPRecord) []*Endpoint {
+	ranked := make(rankedEndpoints, len(s.Endpoints))
+	for i, e := range s.Endpoints {
+		r := &ranked[i]
+		r.Endpoint, r.index = e, i
+		if gir == nil {
+			continue
+		}
+		switch {
+		case s.PreferCountry && len(gir.CountryCode) > 0 && strings.EqualFold(e.CountryCode, gir.CountryCode):
+			r.group = 0
+		case s.PreferContinent && len(gir.ContinentCode) > 0 && strings.EqualFold(e.ContinentCode, gir.ContinentCode):
+			r.group = 1
+		default:
+			r.group = 2
+		}
+		if gir.HasLocation() {
+			r.distance = Haversine(gir.Latitude, gir.Longitude, e.Latitude, e.Longitude)
+		}
+	}
+	sort.Sort(ranked)
+	endpoints := make([]*Endpoint, len(ranked))
+	for i := range ranked {
+		endpoints[i] = ranked[i].Endpoint
+	}
+	return endpoints
+}
+
+type rankedEndpoint struct {
+	*Endpoint
+	group    int
+	distance float64
+	index    int
+}
+
+type rankedEndpoints []rankedEndpoint
+
+func (s rankedEndpoints) Len() int      { return len(s) }
+func (s rankedEndpoints) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s rankedEndpoints) Less(i, j int) bool {
+	if s[i].group != s[j].group {
+		return s[i].group < s[j].group
+	}
+	if s[i].distance != s[j].distance {
+		return s[i].distance < s[j].distance
+	}
+	return s[i].index < s[j].index
+}
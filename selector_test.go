@@ -0,0 +1,64 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+var testEndpoints = []*Endpoint{
+	{Name: "ams", Latitude: 52.37, Longitude: 4.90, CountryCode: "NL", ContinentCode: "EU"},
+	{Name: "jnb", Latitude: -26.20, Longitude: 28.05, CountryCode: "ZA", ContinentCode: "AF"},
+	{Name: "lhr", Latitude: 51.47, Longitude: -0.45, CountryCode: "GB", ContinentCode: "EU"},
+	{Name: "nbo", Latitude: -1.29, Longitude: 36.82, CountryCode: "KE", ContinentCode: "AF"},
+}
+
+func names(endpoints []*Endpoint) string {
+	var s []string
+	for _, e := range endpoints {
+		s = append(s, e.Name)
+	}
+	return strings.Join(s, ",")
+}
+
+func TestSelector(t *testing.T) {
+	res := locationResolver{
+		// Cairo, closer to Europe than to Johannesburg
+		"1.1.1.1": {CountryCode: "EG", ContinentCode: "AF", Latitude: 30.04, Longitude: 31.24},
+		"2.2.2.2": {CountryCode: "GB", ContinentCode: "EU"},
+		"3.3.3.3": {CountryCode: "ZA", ContinentCode: "AF", Latitude: -33.92, Longitude: 18.42},
+	}
+	tests := []struct {
+		s    *Selector
+		ip   string
+		want string
+	}{
+		{&Selector{}, "1.1.1.1", "ams,lhr,nbo,jnb"},
+		{&Selector{PreferContinent: true}, "1.1.1.1", "nbo,jnb,ams,lhr"},
+		{&Selector{PreferCountry: true}, "3.3.3.3", "jnb,nbo,lhr,ams"},
+		{&Selector{PreferCountry: true}, "2.2.2.2", "lhr,ams,jnb,nbo"},
+		{&Selector{PreferCountry: true, PreferContinent: true}, "2.2.2.2", "lhr,ams,jnb,nbo"},
+		{&Selector{PreferCountry: true}, "4.4.4.4", "ams,jnb,lhr,nbo"},
+	}
+	for i, test := range tests {
+		test.s.Resolver = res
+		test.s.Endpoints = testEndpoints
+		if got := names(test.s.Select(net.ParseIP(test.ip))); got != test.want {
+			t.Fatalf("%v: got %v, want %v", i, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Subdivision is a subdivision of a country in ISO 3166-2.
+type Subdivision struct {
+	Code   string // for example "ZA-WC"
+	Name   string
+	Type   string // for example "Province"
+	Parent string // the code of the enclosing subdivision, if any
+}
+
+var (
+	subdivisionsByCode = make(map[string]*Subdivision)
+
+	regionMu           sync.RWMutex
+	regionSubdivisions = make(map[string]*Subdivision)
+
+	namesOnce           sync.Once
+	subdivisionsByNames map[string][]*Subdivision // keyed by country and folded name
+)
+
+func init() {
+	for i := range subdivisions {
+		subdivisionsByCode[subdivisions[i].Code] = &subdivisions[i]
+	}
+}
+
+// SubdivisionByCode returns the subdivision with the ISO 3166-2 code, or nil.
+func SubdivisionByCode(code string) *Subdivision {
+	return subdivisionsByCode[strings.ToUpper(code)]
+}
+
+// Subdivisions returns the subdivisions of the country with the alpha-2
+// code, sorted by code. The slice must not be modified.
+func Subdivisions(country string) []Subdivision {
+	prefix := strings.ToUpper(country) + "-"
+	i := sort.Search(len(subdivisions), func(i int) bool { return subdivisions[i].Code >= prefix })
+	j := i
+	for j < len(subdivisions) && strings.HasPrefix(subdivisions[j].Code, prefix) {
+		j++
+	}
+	return subdivisions[i:j]
+}
+
+// SubdivisionByRegion returns the ISO 3166-2 subdivision of a region code
+// as found in GeoIPRecord.Region, or nil if it is not known.
+//
+// Region codes are ISO codes in the United States and Canada, and FIPS
+// 10-4 codes elsewhere. FIPS codes are mapped by looking up the name of
+// the region in libGeoIP and matching it with the names of the ISO
+// subdivisions of the country, so they are only mapped with cgo, and not
+// when the names differ or the subdivisions have changed since FIPS 10-4
+// was withdrawn.
+func SubdivisionByRegion(country, region string) *Subdivision {
+	if len(country) == 0 || len(region) == 0 {
+		return nil
+	}
+	country = strings.ToUpper(country)
+	if country == "US" || country == "CA" {
+		return subdivisionsByCode[country+"-"+strings.ToUpper(region)]
+	}
+	key := country + "-" + region
+	regionMu.RLock()
+	s, ok := regionSubdivisions[key]
+	regionMu.RUnlock()
+	if ok {
+		return s
+	}
+	// regionName calls into libGeoIP, so it runs without the lock; two
+	// lookups of a new region may both map it, with the same result.
+	s = subdivisionByName(country, regionName(country, region))
+	regionMu.Lock()
+	regionSubdivisions[key] = s
+	regionMu.Unlock()
+	return s
+}
+
+// subdivisionByName returns the subdivision of the country with the
+// name, preferring the top level ones.
+func subdivisionByName(country, name string) *Subdivision {
+	key := foldName(name)
+	if len(key) == 0 {
+		return nil
+	}
+	namesOnce.Do(indexSubdivisionNames)
+	if matches := subdivisionsByNames[country+"/"+key]; len(matches) > 0 {
+		return matches[0]
+	}
+	return nil
+}
+
+func indexSubdivisionNames() {
+	subdivisionsByNames = make(map[string][]*Subdivision)
+	for i := range subdivisions {
+		s := &subdivisions[i]
+		name := foldName(s.Name)
+		if len(name) == 0 {
+			continue
+		}
+		key := s.Code[:strings.Index(s.Code, "-")] + "/" + name
+		if len(s.Parent) == 0 {
+			subdivisionsByNames[key] = append([]*Subdivision{s}, subdivisionsByNames[key]...)
+		} else {
+			subdivisionsByNames[key] = append(subdivisionsByNames[key], s)
+		}
+	}
+}
+
+// foldName returns the normalized key of name without spaces, so that
+// "Baden-Württemberg" matches "Baden-Wurttemberg".
+func foldName(name string) string {
+	return strings.Replace(NormalizeKey(name), " ", "", -1)
+}
+
+// setSubdivision sets the subdivision fields of gir from its region.
+func (gir *GeoIPRecord) setSubdivision() {
+	if s := SubdivisionByRegion(gir.CountryCode, gir.Region); s != nil {
+		gir.SubdivisionCode = s.Code
+		gir.SubdivisionName = s.Name
+	}
+}
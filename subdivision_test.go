@@ -0,0 +1,85 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSubdivisions(t *testing.T) {
+	za := Subdivisions("za")
+	if len(za) != 9 || za[0].Code != "ZA-EC" || za[8].Code != "ZA-WC" {
+		t.Fatalf("%v", za)
+	}
+	if s := SubdivisionByCode("gb-lnd"); s == nil || s.Parent != "GB-ENG" {
+		t.Fatalf("%+v", s)
+	}
+	if len(Subdivisions("XX")) != 0 || SubdivisionByCode("XX-01") != nil {
+		t.Fatal("unknown subdivisions found")
+	}
+}
+
+func TestSubdivisionByRegion(t *testing.T) {
+	if s := SubdivisionByRegion("US", "CA"); s == nil || s.Name != "California" {
+		t.Fatalf("%+v", s)
+	}
+	if s := SubdivisionByRegion("CA", "on"); s == nil || s.Code != "CA-ON" {
+		t.Fatalf("%+v", s)
+	}
+	if SubdivisionByRegion("US", "XX") != nil || SubdivisionByRegion("", "CA") != nil {
+		t.Fatal("unknown region found")
+	}
+	gir := &GeoIPRecord{CountryCode: "US", Region: "NY"}
+	gir.setSubdivision()
+	if gir.SubdivisionCode != "US-NY" || gir.SubdivisionName != "New York" {
+		t.Fatalf("%+v", gir)
+	}
+}
+
+func TestSubdivisionByName(t *testing.T) {
+	tests := []struct {
+		country, name, code string
+	}{
+		{"DE", "Berlin", "DE-BE"},
+		{"ZA", "Western Cape", "ZA-WC"},
+		{"ZA", "western-cape", "ZA-WC"},
+		{"DE", "Baden-Wurttemberg", "DE-BW"},
+		{"GB", "London, City of", "GB-LND"},
+		{"FR", "Atlantis", ""},
+		{"FR", "", ""},
+	}
+	for _, test := range tests {
+		s := subdivisionByName(test.country, test.name)
+		if (s == nil && test.code != "") || (s != nil && s.Code != test.code) {
+			t.Fatalf("%v %v: got %+v, want %v", test.country, test.name, s, test.code)
+		}
+	}
+}
+
+func TestSubdivisionByRegionConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, region := range []string{"01", "02", "11", "WC"} {
+				SubdivisionByRegion("ZA", region)
+				subdivisionByName("ZA", "Western Cape")
+			}
+		}()
+	}
+	wg.Wait()
+}
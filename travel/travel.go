@@ -0,0 +1,201 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package travel detects logins from places too far apart to travel
+// between in the time between them.
+package travel
+
+import (
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jdevilliers/geoip"
+)
+
+// Defaults for the Detector fields that are zero.
+const (
+	DefaultMaxSpeed      = 1000 // km/h, about the speed of an airliner
+	DefaultCityRadius    = 50   // km
+	DefaultCountryRadius = 500  // km
+)
+
+// Event is a login of a user from an address.
+type Event struct {
+	User string
+	IP   net.IP
+	Time time.Time
+}
+
+// Location is where and when an event happened. Radius is the accuracy
+// of the coordinates in kilometres.
+type Location struct {
+	IP          net.IP
+	Time        time.Time
+	Latitude    float64
+	Longitude   float64
+	Radius      float64
+	CountryCode string
+	City        string
+}
+
+// Alert reports two consecutive events of a user that imply travelling
+// faster than the maximum speed. Distance is the distance in kilometres
+// that must have been covered at least, taking the accuracy radii into
+// account, and Speed is the implied speed in km/h, which is infinite
+// for events at the same time.
+type Alert struct {
+	User     string
+	From     *Location
+	To       *Location
+	Distance float64
+	Elapsed  time.Duration
+	Speed    float64
+}
+
+// Store keeps the last location of every user.
+// Last returns nil if there is no location for the user.
+type Store interface {
+	Last(user string) (*Location, error)
+	Save(user string, loc *Location) error
+}
+
+// MemoryStore is a Store that keeps the locations in memory.
+type MemoryStore struct {
+	mu   sync.Mutex
+	last map[string]*Location
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{last: make(map[string]*Location)}
+}
+
+func (s *MemoryStore) Last(user string) (*Location, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last[user], nil
+}
+
+func (s *MemoryStore) Save(user string, loc *Location) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.last[user] = loc
+	return nil
+}
+
+// Detector checks events against the last location of their user.
+//
+// The records of city databases have no accuracy, so locations with a
+// city are assumed to be accurate to CityRadius and locations without
+// one, which are usually the centre of a country, to CountryRadius.
+// Events whose address has no coordinates are ignored.
+type Detector struct {
+	Resolver      geoip.Resolver
+	Store         Store
+	MaxSpeed      float64
+	CityRadius    float64
+	CountryRadius float64
+
+	mu sync.Mutex
+}
+
+// NewDetector returns a detector with an in-memory store and the default limits.
+func NewDetector(res geoip.Resolver) *Detector {
+	return &Detector{Resolver: res, Store: NewMemoryStore()}
+}
+
+// Locate returns the location of e, or nil if its address has no coordinates.
+func (d *Detector) Locate(e *Event) *Location {
+	gir := d.Resolver.Lookup(e.IP)
+	if !gir.HasLocation() {
+		return nil
+	}
+	loc := &Location{
+		IP:          e.IP,
+		Time:        e.Time,
+		Latitude:    gir.Latitude,
+		Longitude:   gir.Longitude,
+		Radius:      orDefault(d.CityRadius, DefaultCityRadius),
+		CountryCode: gir.CountryCode,
+		City:        gir.City,
+	}
+	if len(gir.City) == 0 {
+		loc.Radius = orDefault(d.CountryRadius, DefaultCountryRadius)
+	}
+	return loc
+}
+
+// Check records e and returns an Alert if it is impossibly far from the
+// previous event of the user, or nil. Events older than the last event
+// of the user are compared with it, but do not replace it.
+func (d *Detector) Check(e *Event) (*Alert, error) {
+	loc := d.Locate(e)
+	if loc == nil {
+		return nil, nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	last, err := d.Store.Last(e.User)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil || !e.Time.Before(last.Time) {
+		if err := d.Store.Save(e.User, loc); err != nil {
+			return nil, err
+		}
+	}
+	if last == nil {
+		return nil, nil
+	}
+	from, to := last, loc
+	if to.Time.Before(from.Time) {
+		from, to = to, from
+	}
+	distance := geoip.Haversine(from.Latitude, from.Longitude, to.Latitude, to.Longitude) - from.Radius - to.Radius
+	if distance <= 0 {
+		return nil, nil
+	}
+	elapsed := to.Time.Sub(from.Time)
+	speed := math.Inf(1)
+	if elapsed > 0 {
+		speed = distance / elapsed.Hours()
+	}
+	if speed <= orDefault(d.MaxSpeed, DefaultMaxSpeed) {
+		return nil, nil
+	}
+	return &Alert{User: e.User, From: from, To: to, Distance: distance, Elapsed: elapsed, Speed: speed}, nil
+}
+
+// Run checks the events received from events until it is closed, and
+// calls alert for every alert. It stops at the first error of the store.
+func (d *Detector) Run(events <-chan *Event, alert func(a *Alert)) error {
+	for e := range events {
+		a, err := d.Check(e)
+		if err != nil {
+			return err
+		}
+		if a != nil {
+			alert(a)
+		}
+	}
+	return nil
+}
+
+func orDefault(v, def float64) float64 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
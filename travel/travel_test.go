@@ -0,0 +1,102 @@
+// Copyright 2013 The Authors
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package travel
+
+import (
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jdevilliers/geoip"
+	"github.com/jdevilliers/geoip/internal/geoiptest"
+)
+
+var testResolver = geoiptest.New(map[string]*geoip.GeoIPRecord{
+	"1.0.0.1": {CountryCode: "ZA", City: "Cape Town", Latitude: -33.92, Longitude: 18.42},
+	"1.0.0.2": {CountryCode: "ZA", City: "Johannesburg", Latitude: -26.20, Longitude: 28.05},
+	"1.0.0.3": {CountryCode: "NL", City: "Amsterdam", Latitude: 52.37, Longitude: 4.90},
+	"1.0.0.4": {CountryCode: "ZA", City: "Stellenbosch", Latitude: -33.93, Longitude: 18.86},
+	"1.0.0.5": {CountryCode: "ZA", Latitude: -29.00, Longitude: 24.00},
+	"1.0.0.6": {CountryCode: "EU"},
+})
+
+var start = time.Date(2013, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func event(user, ip string, minutes int) *Event {
+	return &Event{User: user, IP: net.ParseIP(ip), Time: start.Add(time.Duration(minutes) * time.Minute)}
+}
+
+func TestDetector(t *testing.T) {
+	d := NewDetector(testResolver)
+	tests := []struct {
+		e     *Event
+		alert bool
+	}{
+		{event("alice", "1.0.0.1", 0), false},
+		// Cape Town to Johannesburg in 2 hours by plane
+		{event("alice", "1.0.0.2", 120), false},
+		// Johannesburg to Amsterdam in 1 hour
+		{event("alice", "1.0.0.3", 180), true},
+		{event("bob", "1.0.0.1", 0), false},
+		// Cape Town to Stellenbosch within the accuracy radius
+		{event("bob", "1.0.0.4", 1), false},
+		// country only location, 500 km radius
+		{event("bob", "1.0.0.5", 60), false},
+		// no coordinates, ignored
+		{event("bob", "1.0.0.6", 61), false},
+		{event("bob", "10.0.0.1", 62), false},
+		{event("bob", "1.0.0.3", 120), true},
+		// older than the last event, compared but not saved
+		{event("bob", "1.0.0.1", 119), true},
+		{event("bob", "1.0.0.3", 121), false},
+	}
+	for i, test := range tests {
+		a, err := d.Check(test.e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (a != nil) != test.alert {
+			t.Fatalf("%v: %+v", i, a)
+		}
+	}
+}
+
+func TestAlert(t *testing.T) {
+	d := NewDetector(testResolver)
+	d.Check(event("carol", "1.0.0.1", 0))
+	a, _ := d.Check(event("carol", "1.0.0.3", 0))
+	if a == nil || !math.IsInf(a.Speed, 1) || a.From.City != "Cape Town" || a.To.City != "Amsterdam" {
+		t.Fatalf("%+v", a)
+	}
+	if want := geoip.Haversine(-33.92, 18.42, 52.37, 4.90) - 100; math.Abs(a.Distance-want) > 1e-9 {
+		t.Fatalf("distance %v, want %v", a.Distance, want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	events := make(chan *Event, 3)
+	events <- event("dave", "1.0.0.1", 0)
+	events <- event("dave", "1.0.0.3", 10)
+	events <- event("dave", "1.0.0.3", 20)
+	close(events)
+	var alerts []*Alert
+	if err := NewDetector(testResolver).Run(events, func(a *Alert) { alerts = append(alerts, a) }); err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Elapsed != 10*time.Minute {
+		t.Fatalf("%+v", alerts)
+	}
+}